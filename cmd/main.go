@@ -4,8 +4,16 @@ import (
 	"Service/internal/models"
 	"Service/internal/server"
 	"Service/pkg/logger"
+	"flag"
+	"fmt"
 	"github.com/joho/godotenv"
 	"os"
+	"strconv"
+	"strings"
+	"time"
+	// Opening hours are computed in the time zone of each service, the
+	// runtime image has no zoneinfo of its own.
+	_ "time/tzdata"
 )
 
 func main() {
@@ -16,15 +24,24 @@ func main() {
 
 	logger.InfoLogger.Printf("Successfully loaded environment variables")
 
-	cloudConfig := &models.CloudConfig{
-		EndPoint: os.Getenv("AWS_ENDPOINT"),
-		Region:   os.Getenv("AWS_REGION"),
-		Bucket:   os.Getenv("AWS_S3_BUCKET"),
-		Key:      os.Getenv("AWS_KEY"),
-		Secret:   os.Getenv("AWS_SECRET"),
+	appConfig := loadAppConfig()
+
+	if len(os.Args) > 1 && os.Args[1] == "reconcile" {
+		runReconcile(appConfig, os.Args[2:])
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "photo-gc" {
+		runPhotoGC(appConfig, os.Args[2:])
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "certification-check" {
+		runCertificationCheck()
+		return
 	}
 
-	appGRPC, err := server.NewAppGRPC(cloudConfig)
+	appGRPC, err := server.NewAppGRPC(appConfig)
 	if err != nil {
 		logger.FatalLogger.Fatalf("Error initializing app: %s", err)
 	}
@@ -34,3 +51,191 @@ func main() {
 		logger.FatalLogger.Fatalf("Error running server")
 	}
 }
+
+// runReconcile handles "Service reconcile [-dry-run] [-batch-size N] [-rps N]".
+func runReconcile(appConfig *models.AppConfig, args []string) {
+	flags := flag.NewFlagSet("reconcile", flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", true, "only report orphaned links")
+	batchSize := flags.Int("batch-size", appConfig.Reconcile.Options.BatchSize, "owner ids verified per batch")
+	callsPerSecond := flags.Int("rps", appConfig.Reconcile.Options.CallsPerSecond, "maximum calls per second to peer services")
+	_ = flags.Parse(args)
+
+	report, err := server.RunReconciliation(appConfig, &models.ReconcileOptions{
+		DryRun:         *dryRun,
+		BatchSize:      *batchSize,
+		CallsPerSecond: *callsPerSecond,
+	})
+	if err != nil {
+		logger.FatalLogger.Fatalf("Reconciliation failed: %s", err)
+	}
+
+	for _, id := range report.OrphanCoachIds {
+		fmt.Printf("orphan coach %s\n", id)
+	}
+	for _, id := range report.OrphanAbonementIds {
+		fmt.Printf("orphan abonement %s\n", id)
+	}
+}
+
+// runPhotoGC handles "Service photo-gc [-dry-run] [-grace-period D]".
+func runPhotoGC(appConfig *models.AppConfig, args []string) {
+	flags := flag.NewFlagSet("photo-gc", flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", true, "only report orphaned photos")
+	gracePeriod := flags.Duration("grace-period", appConfig.PhotoGC.Options.GracePeriod, "minimum age of a deleted photo")
+	_ = flags.Parse(args)
+
+	report, err := server.RunPhotoGC(appConfig, &models.PhotoGCOptions{
+		DryRun:      *dryRun,
+		GracePeriod: *gracePeriod,
+	})
+	if err != nil {
+		logger.FatalLogger.Fatalf("Photo GC failed: %s", err)
+	}
+
+	for _, key := range report.OrphanKeys {
+		fmt.Printf("orphan photo %s\n", key)
+	}
+}
+
+// runCertificationCheck handles "Service certification-check".
+func runCertificationCheck() {
+	report, err := server.RunCertificationCheck()
+	if err != nil {
+		logger.FatalLogger.Fatalf("Certification check failed: %s", err)
+	}
+
+	for _, link := range report.FlaggedLinks {
+		fmt.Printf("expired certification coach %s service %s\n", link.CoachId, link.ServiceId)
+	}
+}
+
+func loadAppConfig() *models.AppConfig {
+	return &models.AppConfig{
+		Cloud: &models.CloudConfig{
+			EndPoint: os.Getenv("AWS_ENDPOINT"),
+			Region:   os.Getenv("AWS_REGION"),
+			Bucket:   os.Getenv("AWS_S3_BUCKET"),
+			Key:      os.Getenv("AWS_KEY"),
+			Secret:   os.Getenv("AWS_SECRET"),
+
+			PhotoURLMode:  os.Getenv("PHOTO_URL_MODE"),
+			PublicBaseURL: os.Getenv("PHOTO_PUBLIC_BASE_URL"),
+			PresignTTL:    parseDuration(os.Getenv("PHOTO_PRESIGN_TTL"), 15*time.Minute),
+		},
+		ServerTLS: &models.TLSConfig{
+			CertFile:       os.Getenv("APP_TLS_CERT_FILE"),
+			KeyFile:        os.Getenv("APP_TLS_KEY_FILE"),
+			CAFile:         os.Getenv("APP_TLS_CLIENT_CA_FILE"),
+			ReloadInterval: parseDuration(os.Getenv("TLS_RELOAD_INTERVAL"), 0),
+		},
+		ClientTLS: &models.TLSConfig{
+			CertFile:       os.Getenv("CLIENT_TLS_CERT_FILE"),
+			KeyFile:        os.Getenv("CLIENT_TLS_KEY_FILE"),
+			CAFile:         os.Getenv("CLIENT_TLS_CA_FILE"),
+			ReloadInterval: parseDuration(os.Getenv("TLS_RELOAD_INTERVAL"), 0),
+		},
+		Gateway: &models.GatewayConfig{
+			Addr:      os.Getenv("APP_HTTP_PORT"),
+			AuthToken: os.Getenv("APP_HTTP_AUTH_TOKEN"),
+			DebugAddr: os.Getenv("APP_DEBUG_HTTP_PORT"),
+		},
+		Resilience: &models.ResilienceConfig{
+			CallTimeout:     parseDuration(os.Getenv("PEER_CALL_TIMEOUT"), 2*time.Second),
+			MaxAttempts:     parsePositiveInt(os.Getenv("PEER_MAX_ATTEMPTS"), 3),
+			BaseBackoff:     parseDuration(os.Getenv("PEER_BASE_BACKOFF"), 100*time.Millisecond),
+			MaxBackoff:      parseDuration(os.Getenv("PEER_MAX_BACKOFF"), 2*time.Second),
+			FailureLimit:    parsePositiveInt(os.Getenv("PEER_BREAKER_FAILURES"), 5),
+			BreakerCooldown: parseDuration(os.Getenv("PEER_BREAKER_COOLDOWN"), 30*time.Second),
+		},
+		PeerCache: &models.PeerCacheConfig{
+			Capacity:    parseInt(os.Getenv("PEER_CACHE_SIZE"), 10000),
+			PositiveTTL: parseDuration(os.Getenv("PEER_CACHE_POSITIVE_TTL"), 5*time.Minute),
+			NegativeTTL: parseDuration(os.Getenv("PEER_CACHE_NEGATIVE_TTL"), 30*time.Second),
+		},
+		Reconcile: &models.ReconcileConfig{
+			Interval: parseDuration(os.Getenv("RECONCILE_INTERVAL"), 0),
+			Options: models.ReconcileOptions{
+				DryRun:         parseBool(os.Getenv("RECONCILE_DRY_RUN"), true),
+				BatchSize:      parseInt(os.Getenv("RECONCILE_BATCH_SIZE"), 100),
+				CallsPerSecond: parseInt(os.Getenv("RECONCILE_CALLS_PER_SECOND"), 20),
+			},
+		},
+		PhotoGC: &models.PhotoGCConfig{
+			Interval: parseDuration(os.Getenv("PHOTO_GC_INTERVAL"), 0),
+			Options: models.PhotoGCOptions{
+				DryRun:      parseBool(os.Getenv("PHOTO_GC_DRY_RUN"), true),
+				GracePeriod: parseDuration(os.Getenv("PHOTO_GC_GRACE_PERIOD"), 24*time.Hour),
+			},
+		},
+		PhotoUpload: &models.PhotoUploadConfig{
+			TTL:             parseDuration(os.Getenv("PHOTO_UPLOAD_TTL"), 15*time.Minute),
+			CleanupInterval: parseDuration(os.Getenv("PHOTO_UPLOAD_CLEANUP_INTERVAL"), 10*time.Minute),
+		},
+		CertificationCheck: &models.CertificationCheckConfig{
+			Interval: parseDuration(os.Getenv("CERTIFICATION_CHECK_INTERVAL"), time.Hour),
+		},
+		Events: &models.EventsConfig{
+			WebhookURLs: parseList(os.Getenv("EVENTS_WEBHOOK_URLS")),
+			Timeout:     parseDuration(os.Getenv("EVENTS_WEBHOOK_TIMEOUT"), 5*time.Second),
+		},
+	}
+}
+
+func parseDuration(value string, fallback time.Duration) time.Duration {
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		logger.FatalLogger.Fatalf("Invalid duration %q: %s", value, err)
+	}
+
+	return duration
+}
+
+func parseInt(value string, fallback int) int {
+	if value == "" {
+		return fallback
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		logger.FatalLogger.Fatalf("Invalid number %q: %s", value, err)
+	}
+
+	return number
+}
+
+func parsePositiveInt(value string, fallback int) int {
+	number := parseInt(value, fallback)
+	if number < 1 {
+		logger.FatalLogger.Fatalf("Invalid number %q: must be at least 1", value)
+	}
+
+	return number
+}
+
+func parseBool(value string, fallback bool) bool {
+	if value == "" {
+		return fallback
+	}
+
+	flagValue, err := strconv.ParseBool(value)
+	if err != nil {
+		logger.FatalLogger.Fatalf("Invalid boolean %q: %s", value, err)
+	}
+
+	return flagValue
+}
+
+func parseList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
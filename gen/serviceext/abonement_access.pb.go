@@ -0,0 +1,1262 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: abonement_access.proto
+
+package serviceext
+
+import (
+	FitnessCenter_protobuf_service "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AbonementServiceTermsObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AbonementId string `protobuf:"bytes,1,opt,name=abonementId,proto3" json:"abonementId,omitempty"`
+	ServiceId   string `protobuf:"bytes,2,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+	// Visits per period, unset when unlimited.
+	VisitQuota *int32 `protobuf:"varint,3,opt,name=visitQuota,proto3,oneof" json:"visitQuota,omitempty"`
+	// day, week, month or total.
+	QuotaPeriod string `protobuf:"bytes,4,opt,name=quotaPeriod,proto3" json:"quotaPeriod,omitempty"`
+	// Guest visits per period.
+	GuestPasses int32 `protobuf:"varint,5,opt,name=guestPasses,proto3" json:"guestPasses,omitempty"`
+	// In the local time of the service, empty when any time is allowed.
+	Windows     []*OpeningIntervalObject `protobuf:"bytes,6,rep,name=windows,proto3" json:"windows,omitempty"`
+	UpdatedTime string                   `protobuf:"bytes,7,opt,name=updatedTime,proto3" json:"updatedTime,omitempty"`
+}
+
+func (x *AbonementServiceTermsObject) Reset() {
+	*x = AbonementServiceTermsObject{}
+	mi := &file_abonement_access_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbonementServiceTermsObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbonementServiceTermsObject) ProtoMessage() {}
+
+func (x *AbonementServiceTermsObject) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbonementServiceTermsObject.ProtoReflect.Descriptor instead.
+func (*AbonementServiceTermsObject) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AbonementServiceTermsObject) GetAbonementId() string {
+	if x != nil {
+		return x.AbonementId
+	}
+	return ""
+}
+
+func (x *AbonementServiceTermsObject) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *AbonementServiceTermsObject) GetVisitQuota() int32 {
+	if x != nil && x.VisitQuota != nil {
+		return *x.VisitQuota
+	}
+	return 0
+}
+
+func (x *AbonementServiceTermsObject) GetQuotaPeriod() string {
+	if x != nil {
+		return x.QuotaPeriod
+	}
+	return ""
+}
+
+func (x *AbonementServiceTermsObject) GetGuestPasses() int32 {
+	if x != nil {
+		return x.GuestPasses
+	}
+	return 0
+}
+
+func (x *AbonementServiceTermsObject) GetWindows() []*OpeningIntervalObject {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *AbonementServiceTermsObject) GetUpdatedTime() string {
+	if x != nil {
+		return x.UpdatedTime
+	}
+	return ""
+}
+
+type GetAbonementServiceTermsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AbonementId string `protobuf:"bytes,1,opt,name=abonementId,proto3" json:"abonementId,omitempty"`
+	ServiceId   string `protobuf:"bytes,2,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+}
+
+func (x *GetAbonementServiceTermsRequest) Reset() {
+	*x = GetAbonementServiceTermsRequest{}
+	mi := &file_abonement_access_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAbonementServiceTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAbonementServiceTermsRequest) ProtoMessage() {}
+
+func (x *GetAbonementServiceTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAbonementServiceTermsRequest.ProtoReflect.Descriptor instead.
+func (*GetAbonementServiceTermsRequest) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAbonementServiceTermsRequest) GetAbonementId() string {
+	if x != nil {
+		return x.AbonementId
+	}
+	return ""
+}
+
+func (x *GetAbonementServiceTermsRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type SetAbonementServiceTermsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AbonementId string `protobuf:"bytes,1,opt,name=abonementId,proto3" json:"abonementId,omitempty"`
+	ServiceId   string `protobuf:"bytes,2,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+	VisitQuota  *int32 `protobuf:"varint,3,opt,name=visitQuota,proto3,oneof" json:"visitQuota,omitempty"`
+	// month when empty.
+	QuotaPeriod string                   `protobuf:"bytes,4,opt,name=quotaPeriod,proto3" json:"quotaPeriod,omitempty"`
+	GuestPasses int32                    `protobuf:"varint,5,opt,name=guestPasses,proto3" json:"guestPasses,omitempty"`
+	Windows     []*OpeningIntervalObject `protobuf:"bytes,6,rep,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *SetAbonementServiceTermsRequest) Reset() {
+	*x = SetAbonementServiceTermsRequest{}
+	mi := &file_abonement_access_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAbonementServiceTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAbonementServiceTermsRequest) ProtoMessage() {}
+
+func (x *SetAbonementServiceTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAbonementServiceTermsRequest.ProtoReflect.Descriptor instead.
+func (*SetAbonementServiceTermsRequest) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetAbonementServiceTermsRequest) GetAbonementId() string {
+	if x != nil {
+		return x.AbonementId
+	}
+	return ""
+}
+
+func (x *SetAbonementServiceTermsRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *SetAbonementServiceTermsRequest) GetVisitQuota() int32 {
+	if x != nil && x.VisitQuota != nil {
+		return *x.VisitQuota
+	}
+	return 0
+}
+
+func (x *SetAbonementServiceTermsRequest) GetQuotaPeriod() string {
+	if x != nil {
+		return x.QuotaPeriod
+	}
+	return ""
+}
+
+func (x *SetAbonementServiceTermsRequest) GetGuestPasses() int32 {
+	if x != nil {
+		return x.GuestPasses
+	}
+	return 0
+}
+
+func (x *SetAbonementServiceTermsRequest) GetWindows() []*OpeningIntervalObject {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type ServiceWithTerms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceObject *FitnessCenter_protobuf_service.ServiceObject `protobuf:"bytes,1,opt,name=serviceObject,proto3" json:"serviceObject,omitempty"`
+	// The terms of the linked bundle for flattened components.
+	Terms *AbonementServiceTermsObject `protobuf:"bytes,2,opt,name=terms,proto3" json:"terms,omitempty"`
+	// Direct components of a bundle.
+	ComponentIds []string `protobuf:"bytes,3,rep,name=componentIds,proto3" json:"componentIds,omitempty"`
+	// The linked bundle a flattened component comes from.
+	BundleId string `protobuf:"bytes,4,opt,name=bundleId,proto3" json:"bundleId,omitempty"`
+}
+
+func (x *ServiceWithTerms) Reset() {
+	*x = ServiceWithTerms{}
+	mi := &file_abonement_access_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceWithTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceWithTerms) ProtoMessage() {}
+
+func (x *ServiceWithTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceWithTerms.ProtoReflect.Descriptor instead.
+func (*ServiceWithTerms) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ServiceWithTerms) GetServiceObject() *FitnessCenter_protobuf_service.ServiceObject {
+	if x != nil {
+		return x.ServiceObject
+	}
+	return nil
+}
+
+func (x *ServiceWithTerms) GetTerms() *AbonementServiceTermsObject {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *ServiceWithTerms) GetComponentIds() []string {
+	if x != nil {
+		return x.ComponentIds
+	}
+	return nil
+}
+
+func (x *ServiceWithTerms) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+type AbonementServicesWithTerms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AbonementId string              `protobuf:"bytes,1,opt,name=abonementId,proto3" json:"abonementId,omitempty"`
+	Services    []*ServiceWithTerms `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+}
+
+func (x *AbonementServicesWithTerms) Reset() {
+	*x = AbonementServicesWithTerms{}
+	mi := &file_abonement_access_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbonementServicesWithTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbonementServicesWithTerms) ProtoMessage() {}
+
+func (x *AbonementServicesWithTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbonementServicesWithTerms.ProtoReflect.Descriptor instead.
+func (*AbonementServicesWithTerms) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AbonementServicesWithTerms) GetAbonementId() string {
+	if x != nil {
+		return x.AbonementId
+	}
+	return ""
+}
+
+func (x *AbonementServicesWithTerms) GetServices() []*ServiceWithTerms {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type AbonementsServicesWithTermsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Abonements []*AbonementServicesWithTerms `protobuf:"bytes,1,rep,name=abonements,proto3" json:"abonements,omitempty"`
+}
+
+func (x *AbonementsServicesWithTermsResponse) Reset() {
+	*x = AbonementsServicesWithTermsResponse{}
+	mi := &file_abonement_access_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbonementsServicesWithTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbonementsServicesWithTermsResponse) ProtoMessage() {}
+
+func (x *AbonementsServicesWithTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbonementsServicesWithTermsResponse.ProtoReflect.Descriptor instead.
+func (*AbonementsServicesWithTermsResponse) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AbonementsServicesWithTermsResponse) GetAbonements() []*AbonementServicesWithTerms {
+	if x != nil {
+		return x.Abonements
+	}
+	return nil
+}
+
+type CheckAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AbonementId string `protobuf:"bytes,1,opt,name=abonementId,proto3" json:"abonementId,omitempty"`
+	ServiceId   string `protobuf:"bytes,2,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+	// RFC 3339 timestamp, now when empty.
+	At string `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *CheckAccessRequest) Reset() {
+	*x = CheckAccessRequest{}
+	mi := &file_abonement_access_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessRequest) ProtoMessage() {}
+
+func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckAccessRequest) GetAbonementId() string {
+	if x != nil {
+		return x.AbonementId
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+type AccessDecisionObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Granted bool `protobuf:"varint,1,opt,name=granted,proto3" json:"granted,omitempty"`
+	// not_included, outside_allowed_hours or quota_used_up, empty when granted.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	At     string `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	// Unset when the abonement does not include the service.
+	Terms *AbonementServiceTermsObject `protobuf:"bytes,4,opt,name=terms,proto3" json:"terms,omitempty"`
+	// Left in the quota period of at, unset when visits are unlimited.
+	RemainingVisits      *int32 `protobuf:"varint,5,opt,name=remainingVisits,proto3,oneof" json:"remainingVisits,omitempty"`
+	RemainingGuestPasses int32  `protobuf:"varint,6,opt,name=remainingGuestPasses,proto3" json:"remainingGuestPasses,omitempty"`
+}
+
+func (x *AccessDecisionObject) Reset() {
+	*x = AccessDecisionObject{}
+	mi := &file_abonement_access_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessDecisionObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessDecisionObject) ProtoMessage() {}
+
+func (x *AccessDecisionObject) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessDecisionObject.ProtoReflect.Descriptor instead.
+func (*AccessDecisionObject) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AccessDecisionObject) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+func (x *AccessDecisionObject) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AccessDecisionObject) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *AccessDecisionObject) GetTerms() *AbonementServiceTermsObject {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *AccessDecisionObject) GetRemainingVisits() int32 {
+	if x != nil && x.RemainingVisits != nil {
+		return *x.RemainingVisits
+	}
+	return 0
+}
+
+func (x *AccessDecisionObject) GetRemainingGuestPasses() int32 {
+	if x != nil {
+		return x.RemainingGuestPasses
+	}
+	return 0
+}
+
+type RecordVisitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AbonementId string `protobuf:"bytes,1,opt,name=abonementId,proto3" json:"abonementId,omitempty"`
+	ServiceId   string `protobuf:"bytes,2,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+	MemberId    string `protobuf:"bytes,3,opt,name=memberId,proto3" json:"memberId,omitempty"`
+	// Id of the turnstile event.
+	EventId string `protobuf:"bytes,4,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	// RFC 3339 timestamp, now when empty.
+	At    string `protobuf:"bytes,5,opt,name=at,proto3" json:"at,omitempty"`
+	Guest bool   `protobuf:"varint,6,opt,name=guest,proto3" json:"guest,omitempty"`
+}
+
+func (x *RecordVisitRequest) Reset() {
+	*x = RecordVisitRequest{}
+	mi := &file_abonement_access_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordVisitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordVisitRequest) ProtoMessage() {}
+
+func (x *RecordVisitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordVisitRequest.ProtoReflect.Descriptor instead.
+func (*RecordVisitRequest) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RecordVisitRequest) GetAbonementId() string {
+	if x != nil {
+		return x.AbonementId
+	}
+	return ""
+}
+
+func (x *RecordVisitRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *RecordVisitRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *RecordVisitRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RecordVisitRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *RecordVisitRequest) GetGuest() bool {
+	if x != nil {
+		return x.Guest
+	}
+	return false
+}
+
+type VisitObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId      string `protobuf:"bytes,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	AbonementId  string `protobuf:"bytes,3,opt,name=abonementId,proto3" json:"abonementId,omitempty"`
+	ServiceId    string `protobuf:"bytes,4,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+	MemberId     string `protobuf:"bytes,5,opt,name=memberId,proto3" json:"memberId,omitempty"`
+	Guest        bool   `protobuf:"varint,6,opt,name=guest,proto3" json:"guest,omitempty"`
+	VisitedTime  string `protobuf:"bytes,7,opt,name=visitedTime,proto3" json:"visitedTime,omitempty"`
+	RecordedTime string `protobuf:"bytes,8,opt,name=recordedTime,proto3" json:"recordedTime,omitempty"`
+}
+
+func (x *VisitObject) Reset() {
+	*x = VisitObject{}
+	mi := &file_abonement_access_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisitObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitObject) ProtoMessage() {}
+
+func (x *VisitObject) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitObject.ProtoReflect.Descriptor instead.
+func (*VisitObject) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VisitObject) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VisitObject) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *VisitObject) GetAbonementId() string {
+	if x != nil {
+		return x.AbonementId
+	}
+	return ""
+}
+
+func (x *VisitObject) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *VisitObject) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *VisitObject) GetGuest() bool {
+	if x != nil {
+		return x.Guest
+	}
+	return false
+}
+
+func (x *VisitObject) GetVisitedTime() string {
+	if x != nil {
+		return x.VisitedTime
+	}
+	return ""
+}
+
+func (x *VisitObject) GetRecordedTime() string {
+	if x != nil {
+		return x.RecordedTime
+	}
+	return ""
+}
+
+type VisitReceiptObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Visit *VisitObject `protobuf:"bytes,1,opt,name=visit,proto3" json:"visit,omitempty"`
+	// Set when the event was recorded before.
+	Duplicate            bool   `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	RemainingVisits      *int32 `protobuf:"varint,3,opt,name=remainingVisits,proto3,oneof" json:"remainingVisits,omitempty"`
+	RemainingGuestPasses int32  `protobuf:"varint,4,opt,name=remainingGuestPasses,proto3" json:"remainingGuestPasses,omitempty"`
+}
+
+func (x *VisitReceiptObject) Reset() {
+	*x = VisitReceiptObject{}
+	mi := &file_abonement_access_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisitReceiptObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitReceiptObject) ProtoMessage() {}
+
+func (x *VisitReceiptObject) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitReceiptObject.ProtoReflect.Descriptor instead.
+func (*VisitReceiptObject) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VisitReceiptObject) GetVisit() *VisitObject {
+	if x != nil {
+		return x.Visit
+	}
+	return nil
+}
+
+func (x *VisitReceiptObject) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *VisitReceiptObject) GetRemainingVisits() int32 {
+	if x != nil && x.RemainingVisits != nil {
+		return *x.RemainingVisits
+	}
+	return 0
+}
+
+func (x *VisitReceiptObject) GetRemainingGuestPasses() int32 {
+	if x != nil {
+		return x.RemainingGuestPasses
+	}
+	return 0
+}
+
+type GetVisitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AbonementId string `protobuf:"bytes,1,opt,name=abonementId,proto3" json:"abonementId,omitempty"`
+	// Every service of the abonement when empty.
+	ServiceId string `protobuf:"bytes,2,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+	// RFC 3339 timestamps, at most 366 days apart.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *GetVisitsRequest) Reset() {
+	*x = GetVisitsRequest{}
+	mi := &file_abonement_access_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisitsRequest) ProtoMessage() {}
+
+func (x *GetVisitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVisitsRequest.ProtoReflect.Descriptor instead.
+func (*GetVisitsRequest) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetVisitsRequest) GetAbonementId() string {
+	if x != nil {
+		return x.AbonementId
+	}
+	return ""
+}
+
+func (x *GetVisitsRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *GetVisitsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetVisitsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type VisitList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Visits []*VisitObject `protobuf:"bytes,1,rep,name=visits,proto3" json:"visits,omitempty"`
+}
+
+func (x *VisitList) Reset() {
+	*x = VisitList{}
+	mi := &file_abonement_access_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisitList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitList) ProtoMessage() {}
+
+func (x *VisitList) ProtoReflect() protoreflect.Message {
+	mi := &file_abonement_access_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitList.ProtoReflect.Descriptor instead.
+func (*VisitList) Descriptor() ([]byte, []int) {
+	return file_abonement_access_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VisitList) GetVisits() []*VisitObject {
+	if x != nil {
+		return x.Visits
+	}
+	return nil
+}
+
+var File_abonement_access_proto protoreflect.FileDescriptor
+
+var file_abonement_access_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x65, 0x78, 0x74, 0x1a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xc4, 0x02, 0x0a, 0x1b, 0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x74, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x67, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69,
+	0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x61, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x62, 0x6f,
+	0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x65, 0x72,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x6f,
+	0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0xa6, 0x02, 0x0a, 0x1f, 0x53, 0x65,
+	0x74, 0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x88,
+	0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x22, 0xee, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x4b, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74,
+	0x2e, 0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x05, 0x74, 0x65,
+	0x72, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x49, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x1a, 0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54, 0x65, 0x72,
+	0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x54,
+	0x65, 0x72, 0x6d, 0x73, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x7d,
+	0x0a, 0x23, 0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0a, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54, 0x65, 0x72, 0x6d,
+	0x73, 0x52, 0x0a, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x64, 0x0a,
+	0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x61, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x44, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74, 0x12, 0x4d,
+	0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x62, 0x6f, 0x6e, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x73,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x2d, 0x0a,
+	0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x14,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x65, 0x73,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x56, 0x69,
+	0x73, 0x69, 0x74, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x56,
+	0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x67, 0x75, 0x65, 0x73, 0x74, 0x22, 0xef, 0x01, 0x0a, 0x0b, 0x56, 0x69, 0x73, 0x69,
+	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x67, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x67, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x69, 0x73, 0x69, 0x74, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x12, 0x56, 0x69,
+	0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x3d, 0x0a, 0x05, 0x76, 0x69, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x56, 0x69, 0x73,
+	0x69, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x05, 0x76, 0x69, 0x73, 0x69, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a,
+	0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x14,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x65, 0x73,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x56, 0x69,
+	0x73, 0x69, 0x74, 0x73, 0x22, 0x76, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x6f, 0x6e,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x4c, 0x0a, 0x09,
+	0x56, 0x69, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x76, 0x69, 0x73,
+	0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x06, 0x76, 0x69, 0x73, 0x69, 0x74, 0x73, 0x32, 0x93, 0x06, 0x0a, 0x0f, 0x41,
+	0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x90,
+	0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x3b, 0x2e, 0x66, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x62, 0x6f, 0x6e,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x65, 0x72, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x90, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x3b,
+	0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x41,
+	0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54,
+	0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x66, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x97, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x62, 0x6f, 0x6e,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x3d,
+	0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e,
+	0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x62, 0x6f, 0x6e, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f,
+	0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x2e,
+	0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x6d, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x2e,
+	0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x56, 0x69, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x60,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x66, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x18, 0x5a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_abonement_access_proto_rawDescOnce sync.Once
+	file_abonement_access_proto_rawDescData = file_abonement_access_proto_rawDesc
+)
+
+func file_abonement_access_proto_rawDescGZIP() []byte {
+	file_abonement_access_proto_rawDescOnce.Do(func() {
+		file_abonement_access_proto_rawDescData = protoimpl.X.CompressGZIP(file_abonement_access_proto_rawDescData)
+	})
+	return file_abonement_access_proto_rawDescData
+}
+
+var file_abonement_access_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_abonement_access_proto_goTypes = []any{
+	(*AbonementServiceTermsObject)(nil),                  // 0: fitness_center.service_ext.AbonementServiceTermsObject
+	(*GetAbonementServiceTermsRequest)(nil),              // 1: fitness_center.service_ext.GetAbonementServiceTermsRequest
+	(*SetAbonementServiceTermsRequest)(nil),              // 2: fitness_center.service_ext.SetAbonementServiceTermsRequest
+	(*ServiceWithTerms)(nil),                             // 3: fitness_center.service_ext.ServiceWithTerms
+	(*AbonementServicesWithTerms)(nil),                   // 4: fitness_center.service_ext.AbonementServicesWithTerms
+	(*AbonementsServicesWithTermsResponse)(nil),          // 5: fitness_center.service_ext.AbonementsServicesWithTermsResponse
+	(*CheckAccessRequest)(nil),                           // 6: fitness_center.service_ext.CheckAccessRequest
+	(*AccessDecisionObject)(nil),                         // 7: fitness_center.service_ext.AccessDecisionObject
+	(*RecordVisitRequest)(nil),                           // 8: fitness_center.service_ext.RecordVisitRequest
+	(*VisitObject)(nil),                                  // 9: fitness_center.service_ext.VisitObject
+	(*VisitReceiptObject)(nil),                           // 10: fitness_center.service_ext.VisitReceiptObject
+	(*GetVisitsRequest)(nil),                             // 11: fitness_center.service_ext.GetVisitsRequest
+	(*VisitList)(nil),                                    // 12: fitness_center.service_ext.VisitList
+	(*OpeningIntervalObject)(nil),                        // 13: fitness_center.service_ext.OpeningIntervalObject
+	(*FitnessCenter_protobuf_service.ServiceObject)(nil), // 14: fitness_center.service.ServiceObject
+	(*FilteredAbonementsServicesRequest)(nil),            // 15: fitness_center.service_ext.FilteredAbonementsServicesRequest
+}
+var file_abonement_access_proto_depIdxs = []int32{
+	13, // 0: fitness_center.service_ext.AbonementServiceTermsObject.windows:type_name -> fitness_center.service_ext.OpeningIntervalObject
+	13, // 1: fitness_center.service_ext.SetAbonementServiceTermsRequest.windows:type_name -> fitness_center.service_ext.OpeningIntervalObject
+	14, // 2: fitness_center.service_ext.ServiceWithTerms.serviceObject:type_name -> fitness_center.service.ServiceObject
+	0,  // 3: fitness_center.service_ext.ServiceWithTerms.terms:type_name -> fitness_center.service_ext.AbonementServiceTermsObject
+	3,  // 4: fitness_center.service_ext.AbonementServicesWithTerms.services:type_name -> fitness_center.service_ext.ServiceWithTerms
+	4,  // 5: fitness_center.service_ext.AbonementsServicesWithTermsResponse.abonements:type_name -> fitness_center.service_ext.AbonementServicesWithTerms
+	0,  // 6: fitness_center.service_ext.AccessDecisionObject.terms:type_name -> fitness_center.service_ext.AbonementServiceTermsObject
+	9,  // 7: fitness_center.service_ext.VisitReceiptObject.visit:type_name -> fitness_center.service_ext.VisitObject
+	9,  // 8: fitness_center.service_ext.VisitList.visits:type_name -> fitness_center.service_ext.VisitObject
+	1,  // 9: fitness_center.service_ext.AbonementAccess.GetAbonementServiceTerms:input_type -> fitness_center.service_ext.GetAbonementServiceTermsRequest
+	2,  // 10: fitness_center.service_ext.AbonementAccess.SetAbonementServiceTerms:input_type -> fitness_center.service_ext.SetAbonementServiceTermsRequest
+	15, // 11: fitness_center.service_ext.AbonementAccess.GetAbonementsServices:input_type -> fitness_center.service_ext.FilteredAbonementsServicesRequest
+	6,  // 12: fitness_center.service_ext.AbonementAccess.CheckAccess:input_type -> fitness_center.service_ext.CheckAccessRequest
+	8,  // 13: fitness_center.service_ext.AbonementAccess.RecordVisit:input_type -> fitness_center.service_ext.RecordVisitRequest
+	11, // 14: fitness_center.service_ext.AbonementAccess.GetVisits:input_type -> fitness_center.service_ext.GetVisitsRequest
+	0,  // 15: fitness_center.service_ext.AbonementAccess.GetAbonementServiceTerms:output_type -> fitness_center.service_ext.AbonementServiceTermsObject
+	0,  // 16: fitness_center.service_ext.AbonementAccess.SetAbonementServiceTerms:output_type -> fitness_center.service_ext.AbonementServiceTermsObject
+	5,  // 17: fitness_center.service_ext.AbonementAccess.GetAbonementsServices:output_type -> fitness_center.service_ext.AbonementsServicesWithTermsResponse
+	7,  // 18: fitness_center.service_ext.AbonementAccess.CheckAccess:output_type -> fitness_center.service_ext.AccessDecisionObject
+	10, // 19: fitness_center.service_ext.AbonementAccess.RecordVisit:output_type -> fitness_center.service_ext.VisitReceiptObject
+	12, // 20: fitness_center.service_ext.AbonementAccess.GetVisits:output_type -> fitness_center.service_ext.VisitList
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_abonement_access_proto_init() }
+func file_abonement_access_proto_init() {
+	if File_abonement_access_proto != nil {
+		return
+	}
+	file_service_catalog_proto_init()
+	file_service_hours_proto_init()
+	file_abonement_access_proto_msgTypes[0].OneofWrappers = []any{}
+	file_abonement_access_proto_msgTypes[2].OneofWrappers = []any{}
+	file_abonement_access_proto_msgTypes[7].OneofWrappers = []any{}
+	file_abonement_access_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_abonement_access_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_abonement_access_proto_goTypes,
+		DependencyIndexes: file_abonement_access_proto_depIdxs,
+		MessageInfos:      file_abonement_access_proto_msgTypes,
+	}.Build()
+	File_abonement_access_proto = out.File
+	file_abonement_access_proto_rawDesc = nil
+	file_abonement_access_proto_goTypes = nil
+	file_abonement_access_proto_depIdxs = nil
+}
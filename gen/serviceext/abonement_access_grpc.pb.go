@@ -0,0 +1,337 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: abonement_access.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AbonementAccess_GetAbonementServiceTerms_FullMethodName = "/fitness_center.service_ext.AbonementAccess/GetAbonementServiceTerms"
+	AbonementAccess_SetAbonementServiceTerms_FullMethodName = "/fitness_center.service_ext.AbonementAccess/SetAbonementServiceTerms"
+	AbonementAccess_GetAbonementsServices_FullMethodName    = "/fitness_center.service_ext.AbonementAccess/GetAbonementsServices"
+	AbonementAccess_CheckAccess_FullMethodName              = "/fitness_center.service_ext.AbonementAccess/CheckAccess"
+	AbonementAccess_RecordVisit_FullMethodName              = "/fitness_center.service_ext.AbonementAccess/RecordVisit"
+	AbonementAccess_GetVisits_FullMethodName                = "/fitness_center.service_ext.AbonementAccess/GetVisits"
+)
+
+// AbonementAccessClient is the client API for AbonementAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AbonementAccess manages the terms under which an abonement grants each of
+// its services, and checks access against them.
+type AbonementAccessClient interface {
+	GetAbonementServiceTerms(ctx context.Context, in *GetAbonementServiceTermsRequest, opts ...grpc.CallOption) (*AbonementServiceTermsObject, error)
+	// Replaces every term of the link. The abonement must already include the
+	// service.
+	SetAbonementServiceTerms(ctx context.Context, in *SetAbonementServiceTermsRequest, opts ...grpc.CallOption) (*AbonementServiceTermsObject, error)
+	// GetAbonementsServices lists the services of the abonements together with
+	// their terms.
+	GetAbonementsServices(ctx context.Context, in *FilteredAbonementsServicesRequest, opts ...grpc.CallOption) (*AbonementsServicesWithTermsResponse, error)
+	// CheckAccess tells whether the abonement grants the service at the time. A
+	// service included through a bundle is checked against the terms of the
+	// bundle link and shares its quota.
+	CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*AccessDecisionObject, error)
+	// RecordVisit counts a turnstile pass against the quota of its period. A
+	// service included through a bundle is counted against the bundle link.
+	// Reporting the same eventId again returns the original visit.
+	RecordVisit(ctx context.Context, in *RecordVisitRequest, opts ...grpc.CallOption) (*VisitReceiptObject, error)
+	GetVisits(ctx context.Context, in *GetVisitsRequest, opts ...grpc.CallOption) (*VisitList, error)
+}
+
+type abonementAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAbonementAccessClient(cc grpc.ClientConnInterface) AbonementAccessClient {
+	return &abonementAccessClient{cc}
+}
+
+func (c *abonementAccessClient) GetAbonementServiceTerms(ctx context.Context, in *GetAbonementServiceTermsRequest, opts ...grpc.CallOption) (*AbonementServiceTermsObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbonementServiceTermsObject)
+	err := c.cc.Invoke(ctx, AbonementAccess_GetAbonementServiceTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abonementAccessClient) SetAbonementServiceTerms(ctx context.Context, in *SetAbonementServiceTermsRequest, opts ...grpc.CallOption) (*AbonementServiceTermsObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbonementServiceTermsObject)
+	err := c.cc.Invoke(ctx, AbonementAccess_SetAbonementServiceTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abonementAccessClient) GetAbonementsServices(ctx context.Context, in *FilteredAbonementsServicesRequest, opts ...grpc.CallOption) (*AbonementsServicesWithTermsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbonementsServicesWithTermsResponse)
+	err := c.cc.Invoke(ctx, AbonementAccess_GetAbonementsServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abonementAccessClient) CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*AccessDecisionObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessDecisionObject)
+	err := c.cc.Invoke(ctx, AbonementAccess_CheckAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abonementAccessClient) RecordVisit(ctx context.Context, in *RecordVisitRequest, opts ...grpc.CallOption) (*VisitReceiptObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VisitReceiptObject)
+	err := c.cc.Invoke(ctx, AbonementAccess_RecordVisit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abonementAccessClient) GetVisits(ctx context.Context, in *GetVisitsRequest, opts ...grpc.CallOption) (*VisitList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VisitList)
+	err := c.cc.Invoke(ctx, AbonementAccess_GetVisits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AbonementAccessServer is the server API for AbonementAccess service.
+// All implementations must embed UnimplementedAbonementAccessServer
+// for forward compatibility.
+//
+// AbonementAccess manages the terms under which an abonement grants each of
+// its services, and checks access against them.
+type AbonementAccessServer interface {
+	GetAbonementServiceTerms(context.Context, *GetAbonementServiceTermsRequest) (*AbonementServiceTermsObject, error)
+	// Replaces every term of the link. The abonement must already include the
+	// service.
+	SetAbonementServiceTerms(context.Context, *SetAbonementServiceTermsRequest) (*AbonementServiceTermsObject, error)
+	// GetAbonementsServices lists the services of the abonements together with
+	// their terms.
+	GetAbonementsServices(context.Context, *FilteredAbonementsServicesRequest) (*AbonementsServicesWithTermsResponse, error)
+	// CheckAccess tells whether the abonement grants the service at the time. A
+	// service included through a bundle is checked against the terms of the
+	// bundle link and shares its quota.
+	CheckAccess(context.Context, *CheckAccessRequest) (*AccessDecisionObject, error)
+	// RecordVisit counts a turnstile pass against the quota of its period. A
+	// service included through a bundle is counted against the bundle link.
+	// Reporting the same eventId again returns the original visit.
+	RecordVisit(context.Context, *RecordVisitRequest) (*VisitReceiptObject, error)
+	GetVisits(context.Context, *GetVisitsRequest) (*VisitList, error)
+	mustEmbedUnimplementedAbonementAccessServer()
+}
+
+// UnimplementedAbonementAccessServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAbonementAccessServer struct{}
+
+func (UnimplementedAbonementAccessServer) GetAbonementServiceTerms(context.Context, *GetAbonementServiceTermsRequest) (*AbonementServiceTermsObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAbonementServiceTerms not implemented")
+}
+func (UnimplementedAbonementAccessServer) SetAbonementServiceTerms(context.Context, *SetAbonementServiceTermsRequest) (*AbonementServiceTermsObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAbonementServiceTerms not implemented")
+}
+func (UnimplementedAbonementAccessServer) GetAbonementsServices(context.Context, *FilteredAbonementsServicesRequest) (*AbonementsServicesWithTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAbonementsServices not implemented")
+}
+func (UnimplementedAbonementAccessServer) CheckAccess(context.Context, *CheckAccessRequest) (*AccessDecisionObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
+}
+func (UnimplementedAbonementAccessServer) RecordVisit(context.Context, *RecordVisitRequest) (*VisitReceiptObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordVisit not implemented")
+}
+func (UnimplementedAbonementAccessServer) GetVisits(context.Context, *GetVisitsRequest) (*VisitList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVisits not implemented")
+}
+func (UnimplementedAbonementAccessServer) mustEmbedUnimplementedAbonementAccessServer() {}
+func (UnimplementedAbonementAccessServer) testEmbeddedByValue()                         {}
+
+// UnsafeAbonementAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AbonementAccessServer will
+// result in compilation errors.
+type UnsafeAbonementAccessServer interface {
+	mustEmbedUnimplementedAbonementAccessServer()
+}
+
+func RegisterAbonementAccessServer(s grpc.ServiceRegistrar, srv AbonementAccessServer) {
+	// If the following call pancis, it indicates UnimplementedAbonementAccessServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AbonementAccess_ServiceDesc, srv)
+}
+
+func _AbonementAccess_GetAbonementServiceTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAbonementServiceTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbonementAccessServer).GetAbonementServiceTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AbonementAccess_GetAbonementServiceTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbonementAccessServer).GetAbonementServiceTerms(ctx, req.(*GetAbonementServiceTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AbonementAccess_SetAbonementServiceTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAbonementServiceTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbonementAccessServer).SetAbonementServiceTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AbonementAccess_SetAbonementServiceTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbonementAccessServer).SetAbonementServiceTerms(ctx, req.(*SetAbonementServiceTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AbonementAccess_GetAbonementsServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilteredAbonementsServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbonementAccessServer).GetAbonementsServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AbonementAccess_GetAbonementsServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbonementAccessServer).GetAbonementsServices(ctx, req.(*FilteredAbonementsServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AbonementAccess_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbonementAccessServer).CheckAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AbonementAccess_CheckAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbonementAccessServer).CheckAccess(ctx, req.(*CheckAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AbonementAccess_RecordVisit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordVisitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbonementAccessServer).RecordVisit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AbonementAccess_RecordVisit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbonementAccessServer).RecordVisit(ctx, req.(*RecordVisitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AbonementAccess_GetVisits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVisitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbonementAccessServer).GetVisits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AbonementAccess_GetVisits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbonementAccessServer).GetVisits(ctx, req.(*GetVisitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AbonementAccess_ServiceDesc is the grpc.ServiceDesc for AbonementAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AbonementAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.AbonementAccess",
+	HandlerType: (*AbonementAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAbonementServiceTerms",
+			Handler:    _AbonementAccess_GetAbonementServiceTerms_Handler,
+		},
+		{
+			MethodName: "SetAbonementServiceTerms",
+			Handler:    _AbonementAccess_SetAbonementServiceTerms_Handler,
+		},
+		{
+			MethodName: "GetAbonementsServices",
+			Handler:    _AbonementAccess_GetAbonementsServices_Handler,
+		},
+		{
+			MethodName: "CheckAccess",
+			Handler:    _AbonementAccess_CheckAccess_Handler,
+		},
+		{
+			MethodName: "RecordVisit",
+			Handler:    _AbonementAccess_RecordVisit_Handler,
+		},
+		{
+			MethodName: "GetVisits",
+			Handler:    _AbonementAccess_GetVisits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "abonement_access.proto",
+}
@@ -0,0 +1,595 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: coach_service_link.proto
+
+package serviceext
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CoachServiceLinkObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId   string `protobuf:"bytes,1,opt,name=coachId,proto3" json:"coachId,omitempty"`
+	ServiceId string `protobuf:"bytes,2,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+	// assistant, instructor, senior_instructor or master.
+	QualificationLevel string `protobuf:"bytes,3,opt,name=qualificationLevel,proto3" json:"qualificationLevel,omitempty"`
+	// Last day the certification is valid as YYYY-MM-DD, empty when it does
+	// not expire.
+	CertificationExpiry  string `protobuf:"bytes,4,opt,name=certificationExpiry,proto3" json:"certificationExpiry,omitempty"`
+	CertificationExpired bool   `protobuf:"varint,5,opt,name=certificationExpired,proto3" json:"certificationExpired,omitempty"`
+	// In minor currency units, unset when the usual price applies.
+	PriceOverride *int64 `protobuf:"varint,6,opt,name=priceOverride,proto3,oneof" json:"priceOverride,omitempty"`
+	UpdatedTime   string `protobuf:"bytes,7,opt,name=updatedTime,proto3" json:"updatedTime,omitempty"`
+}
+
+func (x *CoachServiceLinkObject) Reset() {
+	*x = CoachServiceLinkObject{}
+	mi := &file_coach_service_link_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoachServiceLinkObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachServiceLinkObject) ProtoMessage() {}
+
+func (x *CoachServiceLinkObject) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_service_link_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachServiceLinkObject.ProtoReflect.Descriptor instead.
+func (*CoachServiceLinkObject) Descriptor() ([]byte, []int) {
+	return file_coach_service_link_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CoachServiceLinkObject) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *CoachServiceLinkObject) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *CoachServiceLinkObject) GetQualificationLevel() string {
+	if x != nil {
+		return x.QualificationLevel
+	}
+	return ""
+}
+
+func (x *CoachServiceLinkObject) GetCertificationExpiry() string {
+	if x != nil {
+		return x.CertificationExpiry
+	}
+	return ""
+}
+
+func (x *CoachServiceLinkObject) GetCertificationExpired() bool {
+	if x != nil {
+		return x.CertificationExpired
+	}
+	return false
+}
+
+func (x *CoachServiceLinkObject) GetPriceOverride() int64 {
+	if x != nil && x.PriceOverride != nil {
+		return *x.PriceOverride
+	}
+	return 0
+}
+
+func (x *CoachServiceLinkObject) GetUpdatedTime() string {
+	if x != nil {
+		return x.UpdatedTime
+	}
+	return ""
+}
+
+type CoachServiceLinkList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Links []*CoachServiceLinkObject `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+}
+
+func (x *CoachServiceLinkList) Reset() {
+	*x = CoachServiceLinkList{}
+	mi := &file_coach_service_link_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoachServiceLinkList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachServiceLinkList) ProtoMessage() {}
+
+func (x *CoachServiceLinkList) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_service_link_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachServiceLinkList.ProtoReflect.Descriptor instead.
+func (*CoachServiceLinkList) Descriptor() ([]byte, []int) {
+	return file_coach_service_link_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CoachServiceLinkList) GetLinks() []*CoachServiceLinkObject {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type CreateCoachServiceLinkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId   string `protobuf:"bytes,1,opt,name=coachId,proto3" json:"coachId,omitempty"`
+	ServiceId string `protobuf:"bytes,2,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+	// instructor when empty.
+	QualificationLevel  string `protobuf:"bytes,3,opt,name=qualificationLevel,proto3" json:"qualificationLevel,omitempty"`
+	CertificationExpiry string `protobuf:"bytes,4,opt,name=certificationExpiry,proto3" json:"certificationExpiry,omitempty"`
+	PriceOverride       *int64 `protobuf:"varint,5,opt,name=priceOverride,proto3,oneof" json:"priceOverride,omitempty"`
+}
+
+func (x *CreateCoachServiceLinkRequest) Reset() {
+	*x = CreateCoachServiceLinkRequest{}
+	mi := &file_coach_service_link_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCoachServiceLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCoachServiceLinkRequest) ProtoMessage() {}
+
+func (x *CreateCoachServiceLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_service_link_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCoachServiceLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateCoachServiceLinkRequest) Descriptor() ([]byte, []int) {
+	return file_coach_service_link_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCoachServiceLinkRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *CreateCoachServiceLinkRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *CreateCoachServiceLinkRequest) GetQualificationLevel() string {
+	if x != nil {
+		return x.QualificationLevel
+	}
+	return ""
+}
+
+func (x *CreateCoachServiceLinkRequest) GetCertificationExpiry() string {
+	if x != nil {
+		return x.CertificationExpiry
+	}
+	return ""
+}
+
+func (x *CreateCoachServiceLinkRequest) GetPriceOverride() int64 {
+	if x != nil && x.PriceOverride != nil {
+		return *x.PriceOverride
+	}
+	return 0
+}
+
+type UpdateCoachServiceLinkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId             string `protobuf:"bytes,1,opt,name=coachId,proto3" json:"coachId,omitempty"`
+	ServiceId           string `protobuf:"bytes,2,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+	QualificationLevel  string `protobuf:"bytes,3,opt,name=qualificationLevel,proto3" json:"qualificationLevel,omitempty"`
+	CertificationExpiry string `protobuf:"bytes,4,opt,name=certificationExpiry,proto3" json:"certificationExpiry,omitempty"`
+	PriceOverride       *int64 `protobuf:"varint,5,opt,name=priceOverride,proto3,oneof" json:"priceOverride,omitempty"`
+}
+
+func (x *UpdateCoachServiceLinkRequest) Reset() {
+	*x = UpdateCoachServiceLinkRequest{}
+	mi := &file_coach_service_link_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCoachServiceLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCoachServiceLinkRequest) ProtoMessage() {}
+
+func (x *UpdateCoachServiceLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_service_link_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCoachServiceLinkRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCoachServiceLinkRequest) Descriptor() ([]byte, []int) {
+	return file_coach_service_link_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateCoachServiceLinkRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *UpdateCoachServiceLinkRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *UpdateCoachServiceLinkRequest) GetQualificationLevel() string {
+	if x != nil {
+		return x.QualificationLevel
+	}
+	return ""
+}
+
+func (x *UpdateCoachServiceLinkRequest) GetCertificationExpiry() string {
+	if x != nil {
+		return x.CertificationExpiry
+	}
+	return ""
+}
+
+func (x *UpdateCoachServiceLinkRequest) GetPriceOverride() int64 {
+	if x != nil && x.PriceOverride != nil {
+		return *x.PriceOverride
+	}
+	return 0
+}
+
+type GetCoachServiceLinkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId   string `protobuf:"bytes,1,opt,name=coachId,proto3" json:"coachId,omitempty"`
+	ServiceId string `protobuf:"bytes,2,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+}
+
+func (x *GetCoachServiceLinkRequest) Reset() {
+	*x = GetCoachServiceLinkRequest{}
+	mi := &file_coach_service_link_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachServiceLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachServiceLinkRequest) ProtoMessage() {}
+
+func (x *GetCoachServiceLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_service_link_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachServiceLinkRequest.ProtoReflect.Descriptor instead.
+func (*GetCoachServiceLinkRequest) Descriptor() ([]byte, []int) {
+	return file_coach_service_link_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCoachServiceLinkRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *GetCoachServiceLinkRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type GetCoachServiceLinksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string `protobuf:"bytes,1,opt,name=coachId,proto3" json:"coachId,omitempty"`
+}
+
+func (x *GetCoachServiceLinksRequest) Reset() {
+	*x = GetCoachServiceLinksRequest{}
+	mi := &file_coach_service_link_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachServiceLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachServiceLinksRequest) ProtoMessage() {}
+
+func (x *GetCoachServiceLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_service_link_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachServiceLinksRequest.ProtoReflect.Descriptor instead.
+func (*GetCoachServiceLinksRequest) Descriptor() ([]byte, []int) {
+	return file_coach_service_link_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCoachServiceLinksRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+var File_coach_service_link_proto protoreflect.FileDescriptor
+
+var file_coach_service_link_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x22, 0xc5, 0x02, 0x0a, 0x16, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x30, 0x0a, 0x13, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x14, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x29, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x22, 0x60,
+	0x0a, 0x14, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65,
+	0x78, 0x74, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73,
+	0x22, 0xf6, 0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x30, 0x0a, 0x13, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0d,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x1d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x61, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x30, 0x0a, 0x13, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0d,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x22, 0x54, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49,
+	0x64, 0x32, 0xaf, 0x04, 0x0a, 0x11, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x39, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x87, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x39, 0x2e, 0x66,
+	0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x12, 0x36, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x66, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x81, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x37, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x18, 0x5a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_coach_service_link_proto_rawDescOnce sync.Once
+	file_coach_service_link_proto_rawDescData = file_coach_service_link_proto_rawDesc
+)
+
+func file_coach_service_link_proto_rawDescGZIP() []byte {
+	file_coach_service_link_proto_rawDescOnce.Do(func() {
+		file_coach_service_link_proto_rawDescData = protoimpl.X.CompressGZIP(file_coach_service_link_proto_rawDescData)
+	})
+	return file_coach_service_link_proto_rawDescData
+}
+
+var file_coach_service_link_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_coach_service_link_proto_goTypes = []any{
+	(*CoachServiceLinkObject)(nil),        // 0: fitness_center.service_ext.CoachServiceLinkObject
+	(*CoachServiceLinkList)(nil),          // 1: fitness_center.service_ext.CoachServiceLinkList
+	(*CreateCoachServiceLinkRequest)(nil), // 2: fitness_center.service_ext.CreateCoachServiceLinkRequest
+	(*UpdateCoachServiceLinkRequest)(nil), // 3: fitness_center.service_ext.UpdateCoachServiceLinkRequest
+	(*GetCoachServiceLinkRequest)(nil),    // 4: fitness_center.service_ext.GetCoachServiceLinkRequest
+	(*GetCoachServiceLinksRequest)(nil),   // 5: fitness_center.service_ext.GetCoachServiceLinksRequest
+}
+var file_coach_service_link_proto_depIdxs = []int32{
+	0, // 0: fitness_center.service_ext.CoachServiceLinkList.links:type_name -> fitness_center.service_ext.CoachServiceLinkObject
+	2, // 1: fitness_center.service_ext.CoachServiceLinks.CreateCoachServiceLink:input_type -> fitness_center.service_ext.CreateCoachServiceLinkRequest
+	3, // 2: fitness_center.service_ext.CoachServiceLinks.UpdateCoachServiceLink:input_type -> fitness_center.service_ext.UpdateCoachServiceLinkRequest
+	4, // 3: fitness_center.service_ext.CoachServiceLinks.GetCoachServiceLink:input_type -> fitness_center.service_ext.GetCoachServiceLinkRequest
+	5, // 4: fitness_center.service_ext.CoachServiceLinks.GetCoachServiceLinks:input_type -> fitness_center.service_ext.GetCoachServiceLinksRequest
+	0, // 5: fitness_center.service_ext.CoachServiceLinks.CreateCoachServiceLink:output_type -> fitness_center.service_ext.CoachServiceLinkObject
+	0, // 6: fitness_center.service_ext.CoachServiceLinks.UpdateCoachServiceLink:output_type -> fitness_center.service_ext.CoachServiceLinkObject
+	0, // 7: fitness_center.service_ext.CoachServiceLinks.GetCoachServiceLink:output_type -> fitness_center.service_ext.CoachServiceLinkObject
+	1, // 8: fitness_center.service_ext.CoachServiceLinks.GetCoachServiceLinks:output_type -> fitness_center.service_ext.CoachServiceLinkList
+	5, // [5:9] is the sub-list for method output_type
+	1, // [1:5] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_coach_service_link_proto_init() }
+func file_coach_service_link_proto_init() {
+	if File_coach_service_link_proto != nil {
+		return
+	}
+	file_coach_service_link_proto_msgTypes[0].OneofWrappers = []any{}
+	file_coach_service_link_proto_msgTypes[2].OneofWrappers = []any{}
+	file_coach_service_link_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_coach_service_link_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_coach_service_link_proto_goTypes,
+		DependencyIndexes: file_coach_service_link_proto_depIdxs,
+		MessageInfos:      file_coach_service_link_proto_msgTypes,
+	}.Build()
+	File_coach_service_link_proto = out.File
+	file_coach_service_link_proto_rawDesc = nil
+	file_coach_service_link_proto_goTypes = nil
+	file_coach_service_link_proto_depIdxs = nil
+}
@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: coach_service_link.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CoachServiceLinks_CreateCoachServiceLink_FullMethodName = "/fitness_center.service_ext.CoachServiceLinks/CreateCoachServiceLink"
+	CoachServiceLinks_UpdateCoachServiceLink_FullMethodName = "/fitness_center.service_ext.CoachServiceLinks/UpdateCoachServiceLink"
+	CoachServiceLinks_GetCoachServiceLink_FullMethodName    = "/fitness_center.service_ext.CoachServiceLinks/GetCoachServiceLink"
+	CoachServiceLinks_GetCoachServiceLinks_FullMethodName   = "/fitness_center.service_ext.CoachServiceLinks/GetCoachServiceLinks"
+)
+
+// CoachServiceLinksClient is the client API for CoachServiceLinks service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CoachServiceLinks manages how a coach offers a service: the qualification
+// of the coach, the expiry of the certification and a price of their own.
+type CoachServiceLinksClient interface {
+	CreateCoachServiceLink(ctx context.Context, in *CreateCoachServiceLinkRequest, opts ...grpc.CallOption) (*CoachServiceLinkObject, error)
+	// Replaces every attribute of the link.
+	UpdateCoachServiceLink(ctx context.Context, in *UpdateCoachServiceLinkRequest, opts ...grpc.CallOption) (*CoachServiceLinkObject, error)
+	GetCoachServiceLink(ctx context.Context, in *GetCoachServiceLinkRequest, opts ...grpc.CallOption) (*CoachServiceLinkObject, error)
+	GetCoachServiceLinks(ctx context.Context, in *GetCoachServiceLinksRequest, opts ...grpc.CallOption) (*CoachServiceLinkList, error)
+}
+
+type coachServiceLinksClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoachServiceLinksClient(cc grpc.ClientConnInterface) CoachServiceLinksClient {
+	return &coachServiceLinksClient{cc}
+}
+
+func (c *coachServiceLinksClient) CreateCoachServiceLink(ctx context.Context, in *CreateCoachServiceLinkRequest, opts ...grpc.CallOption) (*CoachServiceLinkObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoachServiceLinkObject)
+	err := c.cc.Invoke(ctx, CoachServiceLinks_CreateCoachServiceLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachServiceLinksClient) UpdateCoachServiceLink(ctx context.Context, in *UpdateCoachServiceLinkRequest, opts ...grpc.CallOption) (*CoachServiceLinkObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoachServiceLinkObject)
+	err := c.cc.Invoke(ctx, CoachServiceLinks_UpdateCoachServiceLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachServiceLinksClient) GetCoachServiceLink(ctx context.Context, in *GetCoachServiceLinkRequest, opts ...grpc.CallOption) (*CoachServiceLinkObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoachServiceLinkObject)
+	err := c.cc.Invoke(ctx, CoachServiceLinks_GetCoachServiceLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachServiceLinksClient) GetCoachServiceLinks(ctx context.Context, in *GetCoachServiceLinksRequest, opts ...grpc.CallOption) (*CoachServiceLinkList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoachServiceLinkList)
+	err := c.cc.Invoke(ctx, CoachServiceLinks_GetCoachServiceLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoachServiceLinksServer is the server API for CoachServiceLinks service.
+// All implementations must embed UnimplementedCoachServiceLinksServer
+// for forward compatibility.
+//
+// CoachServiceLinks manages how a coach offers a service: the qualification
+// of the coach, the expiry of the certification and a price of their own.
+type CoachServiceLinksServer interface {
+	CreateCoachServiceLink(context.Context, *CreateCoachServiceLinkRequest) (*CoachServiceLinkObject, error)
+	// Replaces every attribute of the link.
+	UpdateCoachServiceLink(context.Context, *UpdateCoachServiceLinkRequest) (*CoachServiceLinkObject, error)
+	GetCoachServiceLink(context.Context, *GetCoachServiceLinkRequest) (*CoachServiceLinkObject, error)
+	GetCoachServiceLinks(context.Context, *GetCoachServiceLinksRequest) (*CoachServiceLinkList, error)
+	mustEmbedUnimplementedCoachServiceLinksServer()
+}
+
+// UnimplementedCoachServiceLinksServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoachServiceLinksServer struct{}
+
+func (UnimplementedCoachServiceLinksServer) CreateCoachServiceLink(context.Context, *CreateCoachServiceLinkRequest) (*CoachServiceLinkObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCoachServiceLink not implemented")
+}
+func (UnimplementedCoachServiceLinksServer) UpdateCoachServiceLink(context.Context, *UpdateCoachServiceLinkRequest) (*CoachServiceLinkObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCoachServiceLink not implemented")
+}
+func (UnimplementedCoachServiceLinksServer) GetCoachServiceLink(context.Context, *GetCoachServiceLinkRequest) (*CoachServiceLinkObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachServiceLink not implemented")
+}
+func (UnimplementedCoachServiceLinksServer) GetCoachServiceLinks(context.Context, *GetCoachServiceLinksRequest) (*CoachServiceLinkList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachServiceLinks not implemented")
+}
+func (UnimplementedCoachServiceLinksServer) mustEmbedUnimplementedCoachServiceLinksServer() {}
+func (UnimplementedCoachServiceLinksServer) testEmbeddedByValue()                           {}
+
+// UnsafeCoachServiceLinksServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoachServiceLinksServer will
+// result in compilation errors.
+type UnsafeCoachServiceLinksServer interface {
+	mustEmbedUnimplementedCoachServiceLinksServer()
+}
+
+func RegisterCoachServiceLinksServer(s grpc.ServiceRegistrar, srv CoachServiceLinksServer) {
+	// If the following call pancis, it indicates UnimplementedCoachServiceLinksServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoachServiceLinks_ServiceDesc, srv)
+}
+
+func _CoachServiceLinks_CreateCoachServiceLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCoachServiceLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachServiceLinksServer).CreateCoachServiceLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachServiceLinks_CreateCoachServiceLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachServiceLinksServer).CreateCoachServiceLink(ctx, req.(*CreateCoachServiceLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachServiceLinks_UpdateCoachServiceLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCoachServiceLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachServiceLinksServer).UpdateCoachServiceLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachServiceLinks_UpdateCoachServiceLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachServiceLinksServer).UpdateCoachServiceLink(ctx, req.(*UpdateCoachServiceLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachServiceLinks_GetCoachServiceLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoachServiceLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachServiceLinksServer).GetCoachServiceLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachServiceLinks_GetCoachServiceLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachServiceLinksServer).GetCoachServiceLink(ctx, req.(*GetCoachServiceLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachServiceLinks_GetCoachServiceLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoachServiceLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachServiceLinksServer).GetCoachServiceLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachServiceLinks_GetCoachServiceLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachServiceLinksServer).GetCoachServiceLinks(ctx, req.(*GetCoachServiceLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CoachServiceLinks_ServiceDesc is the grpc.ServiceDesc for CoachServiceLinks service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoachServiceLinks_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.CoachServiceLinks",
+	HandlerType: (*CoachServiceLinksServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCoachServiceLink",
+			Handler:    _CoachServiceLinks_CreateCoachServiceLink_Handler,
+		},
+		{
+			MethodName: "UpdateCoachServiceLink",
+			Handler:    _CoachServiceLinks_UpdateCoachServiceLink_Handler,
+		},
+		{
+			MethodName: "GetCoachServiceLink",
+			Handler:    _CoachServiceLinks_GetCoachServiceLink_Handler,
+		},
+		{
+			MethodName: "GetCoachServiceLinks",
+			Handler:    _CoachServiceLinks_GetCoachServiceLinks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coach_service_link.proto",
+}
@@ -0,0 +1,5 @@
+// Package serviceext contains the Service API RPCs that are not part of the
+// shared FitnessCenter-Protobuf module yet. Code is generated from ../../proto.
+package serviceext
+
+//go:generate sh -c "protoc -I ../../proto -I $(go env GOMODCACHE)/github.com/!dan!ko-code/!fitness!center-!protobuf@v0.6.27/proto --go_out=. --go_opt=paths=source_relative,Mservice.proto=github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service --go-grpc_out=. --go-grpc_opt=paths=source_relative,Mservice.proto=github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service ../../proto/*.proto"
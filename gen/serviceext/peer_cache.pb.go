@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: peer_cache.proto
+
+package serviceext
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InvalidatePeerCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachIds     []string `protobuf:"bytes,1,rep,name=coachIds,proto3" json:"coachIds,omitempty"`
+	AbonementIds []string `protobuf:"bytes,2,rep,name=abonementIds,proto3" json:"abonementIds,omitempty"`
+	// Drops every cached coach and abonement existence result.
+	All bool `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+}
+
+func (x *InvalidatePeerCacheRequest) Reset() {
+	*x = InvalidatePeerCacheRequest{}
+	mi := &file_peer_cache_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidatePeerCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidatePeerCacheRequest) ProtoMessage() {}
+
+func (x *InvalidatePeerCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_cache_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidatePeerCacheRequest.ProtoReflect.Descriptor instead.
+func (*InvalidatePeerCacheRequest) Descriptor() ([]byte, []int) {
+	return file_peer_cache_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InvalidatePeerCacheRequest) GetCoachIds() []string {
+	if x != nil {
+		return x.CoachIds
+	}
+	return nil
+}
+
+func (x *InvalidatePeerCacheRequest) GetAbonementIds() []string {
+	if x != nil {
+		return x.AbonementIds
+	}
+	return nil
+}
+
+func (x *InvalidatePeerCacheRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+var File_peer_cache_proto protoreflect.FileDescriptor
+
+var file_peer_cache_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x1a, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x1a, 0x1b,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6e, 0x0a, 0x1a, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x49, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x62, 0x6f,
+	0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x32, 0x72, 0x0a, 0x09, 0x50,
+	0x65, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x65, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12,
+	0x36, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
+	0x18, 0x5a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_peer_cache_proto_rawDescOnce sync.Once
+	file_peer_cache_proto_rawDescData = file_peer_cache_proto_rawDesc
+)
+
+func file_peer_cache_proto_rawDescGZIP() []byte {
+	file_peer_cache_proto_rawDescOnce.Do(func() {
+		file_peer_cache_proto_rawDescData = protoimpl.X.CompressGZIP(file_peer_cache_proto_rawDescData)
+	})
+	return file_peer_cache_proto_rawDescData
+}
+
+var file_peer_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_peer_cache_proto_goTypes = []any{
+	(*InvalidatePeerCacheRequest)(nil), // 0: fitness_center.service_ext.InvalidatePeerCacheRequest
+	(*emptypb.Empty)(nil),              // 1: google.protobuf.Empty
+}
+var file_peer_cache_proto_depIdxs = []int32{
+	0, // 0: fitness_center.service_ext.PeerCache.InvalidatePeerCache:input_type -> fitness_center.service_ext.InvalidatePeerCacheRequest
+	1, // 1: fitness_center.service_ext.PeerCache.InvalidatePeerCache:output_type -> google.protobuf.Empty
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_peer_cache_proto_init() }
+func file_peer_cache_proto_init() {
+	if File_peer_cache_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_peer_cache_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_peer_cache_proto_goTypes,
+		DependencyIndexes: file_peer_cache_proto_depIdxs,
+		MessageInfos:      file_peer_cache_proto_msgTypes,
+	}.Build()
+	File_peer_cache_proto = out.File
+	file_peer_cache_proto_rawDesc = nil
+	file_peer_cache_proto_goTypes = nil
+	file_peer_cache_proto_depIdxs = nil
+}
@@ -0,0 +1,130 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: photo_download.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PhotoDownload_DownloadPhoto_FullMethodName = "/fitness_center.service_ext.PhotoDownload/DownloadPhoto"
+)
+
+// PhotoDownloadClient is the client API for PhotoDownload service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PhotoDownload serves service photos to clients that cannot reach the
+// storage endpoint.
+type PhotoDownloadClient interface {
+	DownloadPhoto(ctx context.Context, in *DownloadPhotoRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadPhotoResponse], error)
+}
+
+type photoDownloadClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPhotoDownloadClient(cc grpc.ClientConnInterface) PhotoDownloadClient {
+	return &photoDownloadClient{cc}
+}
+
+func (c *photoDownloadClient) DownloadPhoto(ctx context.Context, in *DownloadPhotoRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadPhotoResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PhotoDownload_ServiceDesc.Streams[0], PhotoDownload_DownloadPhoto_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadPhotoRequest, DownloadPhotoResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PhotoDownload_DownloadPhotoClient = grpc.ServerStreamingClient[DownloadPhotoResponse]
+
+// PhotoDownloadServer is the server API for PhotoDownload service.
+// All implementations must embed UnimplementedPhotoDownloadServer
+// for forward compatibility.
+//
+// PhotoDownload serves service photos to clients that cannot reach the
+// storage endpoint.
+type PhotoDownloadServer interface {
+	DownloadPhoto(*DownloadPhotoRequest, grpc.ServerStreamingServer[DownloadPhotoResponse]) error
+	mustEmbedUnimplementedPhotoDownloadServer()
+}
+
+// UnimplementedPhotoDownloadServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPhotoDownloadServer struct{}
+
+func (UnimplementedPhotoDownloadServer) DownloadPhoto(*DownloadPhotoRequest, grpc.ServerStreamingServer[DownloadPhotoResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadPhoto not implemented")
+}
+func (UnimplementedPhotoDownloadServer) mustEmbedUnimplementedPhotoDownloadServer() {}
+func (UnimplementedPhotoDownloadServer) testEmbeddedByValue()                       {}
+
+// UnsafePhotoDownloadServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PhotoDownloadServer will
+// result in compilation errors.
+type UnsafePhotoDownloadServer interface {
+	mustEmbedUnimplementedPhotoDownloadServer()
+}
+
+func RegisterPhotoDownloadServer(s grpc.ServiceRegistrar, srv PhotoDownloadServer) {
+	// If the following call pancis, it indicates UnimplementedPhotoDownloadServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PhotoDownload_ServiceDesc, srv)
+}
+
+func _PhotoDownload_DownloadPhoto_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadPhotoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PhotoDownloadServer).DownloadPhoto(m, &grpc.GenericServerStream[DownloadPhotoRequest, DownloadPhotoResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PhotoDownload_DownloadPhotoServer = grpc.ServerStreamingServer[DownloadPhotoResponse]
+
+// PhotoDownload_ServiceDesc is the grpc.ServiceDesc for PhotoDownload service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PhotoDownload_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.PhotoDownload",
+	HandlerType: (*PhotoDownloadServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadPhoto",
+			Handler:       _PhotoDownload_DownloadPhoto_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "photo_download.proto",
+}
@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: photo_upload.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PhotoUpload_CreatePhotoUpload_FullMethodName  = "/fitness_center.service_ext.PhotoUpload/CreatePhotoUpload"
+	PhotoUpload_ConfirmPhotoUpload_FullMethodName = "/fitness_center.service_ext.PhotoUpload/ConfirmPhotoUpload"
+)
+
+// PhotoUploadClient is the client API for PhotoUpload service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PhotoUpload lets clients put photos straight into storage instead of
+// streaming them through the service.
+type PhotoUploadClient interface {
+	CreatePhotoUpload(ctx context.Context, in *CreatePhotoUploadRequest, opts ...grpc.CallOption) (*CreatePhotoUploadResponse, error)
+	ConfirmPhotoUpload(ctx context.Context, in *ConfirmPhotoUploadRequest, opts ...grpc.CallOption) (*ConfirmPhotoUploadResponse, error)
+}
+
+type photoUploadClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPhotoUploadClient(cc grpc.ClientConnInterface) PhotoUploadClient {
+	return &photoUploadClient{cc}
+}
+
+func (c *photoUploadClient) CreatePhotoUpload(ctx context.Context, in *CreatePhotoUploadRequest, opts ...grpc.CallOption) (*CreatePhotoUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePhotoUploadResponse)
+	err := c.cc.Invoke(ctx, PhotoUpload_CreatePhotoUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoUploadClient) ConfirmPhotoUpload(ctx context.Context, in *ConfirmPhotoUploadRequest, opts ...grpc.CallOption) (*ConfirmPhotoUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmPhotoUploadResponse)
+	err := c.cc.Invoke(ctx, PhotoUpload_ConfirmPhotoUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PhotoUploadServer is the server API for PhotoUpload service.
+// All implementations must embed UnimplementedPhotoUploadServer
+// for forward compatibility.
+//
+// PhotoUpload lets clients put photos straight into storage instead of
+// streaming them through the service.
+type PhotoUploadServer interface {
+	CreatePhotoUpload(context.Context, *CreatePhotoUploadRequest) (*CreatePhotoUploadResponse, error)
+	ConfirmPhotoUpload(context.Context, *ConfirmPhotoUploadRequest) (*ConfirmPhotoUploadResponse, error)
+	mustEmbedUnimplementedPhotoUploadServer()
+}
+
+// UnimplementedPhotoUploadServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPhotoUploadServer struct{}
+
+func (UnimplementedPhotoUploadServer) CreatePhotoUpload(context.Context, *CreatePhotoUploadRequest) (*CreatePhotoUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePhotoUpload not implemented")
+}
+func (UnimplementedPhotoUploadServer) ConfirmPhotoUpload(context.Context, *ConfirmPhotoUploadRequest) (*ConfirmPhotoUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPhotoUpload not implemented")
+}
+func (UnimplementedPhotoUploadServer) mustEmbedUnimplementedPhotoUploadServer() {}
+func (UnimplementedPhotoUploadServer) testEmbeddedByValue()                     {}
+
+// UnsafePhotoUploadServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PhotoUploadServer will
+// result in compilation errors.
+type UnsafePhotoUploadServer interface {
+	mustEmbedUnimplementedPhotoUploadServer()
+}
+
+func RegisterPhotoUploadServer(s grpc.ServiceRegistrar, srv PhotoUploadServer) {
+	// If the following call pancis, it indicates UnimplementedPhotoUploadServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PhotoUpload_ServiceDesc, srv)
+}
+
+func _PhotoUpload_CreatePhotoUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePhotoUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoUploadServer).CreatePhotoUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoUpload_CreatePhotoUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoUploadServer).CreatePhotoUpload(ctx, req.(*CreatePhotoUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoUpload_ConfirmPhotoUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPhotoUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoUploadServer).ConfirmPhotoUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoUpload_ConfirmPhotoUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoUploadServer).ConfirmPhotoUpload(ctx, req.(*ConfirmPhotoUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PhotoUpload_ServiceDesc is the grpc.ServiceDesc for PhotoUpload service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PhotoUpload_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.PhotoUpload",
+	HandlerType: (*PhotoUploadServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePhotoUpload",
+			Handler:    _PhotoUpload_CreatePhotoUpload_Handler,
+		},
+		{
+			MethodName: "ConfirmPhotoUpload",
+			Handler:    _PhotoUpload_ConfirmPhotoUpload_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "photo_upload.proto",
+}
@@ -0,0 +1,294 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: reconciler.proto
+
+package serviceext
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReconcileLinksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchSize      int32 `protobuf:"varint,2,opt,name=batchSize,proto3" json:"batchSize,omitempty"`
+	CallsPerSecond int32 `protobuf:"varint,3,opt,name=callsPerSecond,proto3" json:"callsPerSecond,omitempty"`
+	// Remove the links of orphans. Orphans are only reported unless set.
+	Apply bool `protobuf:"varint,4,opt,name=apply,proto3" json:"apply,omitempty"`
+}
+
+func (x *ReconcileLinksRequest) Reset() {
+	*x = ReconcileLinksRequest{}
+	mi := &file_reconciler_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileLinksRequest) ProtoMessage() {}
+
+func (x *ReconcileLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reconciler_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileLinksRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileLinksRequest) Descriptor() ([]byte, []int) {
+	return file_reconciler_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReconcileLinksRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *ReconcileLinksRequest) GetCallsPerSecond() int32 {
+	if x != nil {
+		return x.CallsPerSecond
+	}
+	return 0
+}
+
+func (x *ReconcileLinksRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+type ReconcileLinksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun              bool     `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	StartedTime         string   `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
+	DurationMs          int64    `protobuf:"varint,3,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
+	CoachIdsChecked     int32    `protobuf:"varint,4,opt,name=coachIdsChecked,proto3" json:"coachIdsChecked,omitempty"`
+	AbonementIdsChecked int32    `protobuf:"varint,5,opt,name=abonementIdsChecked,proto3" json:"abonementIdsChecked,omitempty"`
+	OrphanCoachIds      []string `protobuf:"bytes,6,rep,name=orphanCoachIds,proto3" json:"orphanCoachIds,omitempty"`
+	OrphanAbonementIds  []string `protobuf:"bytes,7,rep,name=orphanAbonementIds,proto3" json:"orphanAbonementIds,omitempty"`
+	Unverified          int32    `protobuf:"varint,8,opt,name=unverified,proto3" json:"unverified,omitempty"`
+	RemovedLinks        int64    `protobuf:"varint,9,opt,name=removedLinks,proto3" json:"removedLinks,omitempty"`
+}
+
+func (x *ReconcileLinksResponse) Reset() {
+	*x = ReconcileLinksResponse{}
+	mi := &file_reconciler_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileLinksResponse) ProtoMessage() {}
+
+func (x *ReconcileLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reconciler_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileLinksResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileLinksResponse) Descriptor() ([]byte, []int) {
+	return file_reconciler_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReconcileLinksResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ReconcileLinksResponse) GetStartedTime() string {
+	if x != nil {
+		return x.StartedTime
+	}
+	return ""
+}
+
+func (x *ReconcileLinksResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ReconcileLinksResponse) GetCoachIdsChecked() int32 {
+	if x != nil {
+		return x.CoachIdsChecked
+	}
+	return 0
+}
+
+func (x *ReconcileLinksResponse) GetAbonementIdsChecked() int32 {
+	if x != nil {
+		return x.AbonementIdsChecked
+	}
+	return 0
+}
+
+func (x *ReconcileLinksResponse) GetOrphanCoachIds() []string {
+	if x != nil {
+		return x.OrphanCoachIds
+	}
+	return nil
+}
+
+func (x *ReconcileLinksResponse) GetOrphanAbonementIds() []string {
+	if x != nil {
+		return x.OrphanAbonementIds
+	}
+	return nil
+}
+
+func (x *ReconcileLinksResponse) GetUnverified() int32 {
+	if x != nil {
+		return x.Unverified
+	}
+	return 0
+}
+
+func (x *ReconcileLinksResponse) GetRemovedLinks() int64 {
+	if x != nil {
+		return x.RemovedLinks
+	}
+	return 0
+}
+
+var File_reconciler_proto protoreflect.FileDescriptor
+
+var file_reconciler_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x1a, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x22, 0x81,
+	0x01, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x6c, 0x79, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x22, 0xeb, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x61, 0x63,
+	0x68, 0x49, 0x64, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x13, 0x61, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x49, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72,
+	0x70, 0x68, 0x61, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x12,
+	0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x41, 0x62, 0x6f, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
+	0x32, 0x85, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x12,
+	0x77, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x73, 0x12, 0x31, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78,
+	0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x18, 0x5a, 0x16, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x65,
+	0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_reconciler_proto_rawDescOnce sync.Once
+	file_reconciler_proto_rawDescData = file_reconciler_proto_rawDesc
+)
+
+func file_reconciler_proto_rawDescGZIP() []byte {
+	file_reconciler_proto_rawDescOnce.Do(func() {
+		file_reconciler_proto_rawDescData = protoimpl.X.CompressGZIP(file_reconciler_proto_rawDescData)
+	})
+	return file_reconciler_proto_rawDescData
+}
+
+var file_reconciler_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_reconciler_proto_goTypes = []any{
+	(*ReconcileLinksRequest)(nil),  // 0: fitness_center.service_ext.ReconcileLinksRequest
+	(*ReconcileLinksResponse)(nil), // 1: fitness_center.service_ext.ReconcileLinksResponse
+}
+var file_reconciler_proto_depIdxs = []int32{
+	0, // 0: fitness_center.service_ext.Reconciler.ReconcileLinks:input_type -> fitness_center.service_ext.ReconcileLinksRequest
+	1, // 1: fitness_center.service_ext.Reconciler.ReconcileLinks:output_type -> fitness_center.service_ext.ReconcileLinksResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_reconciler_proto_init() }
+func file_reconciler_proto_init() {
+	if File_reconciler_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_reconciler_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reconciler_proto_goTypes,
+		DependencyIndexes: file_reconciler_proto_depIdxs,
+		MessageInfos:      file_reconciler_proto_msgTypes,
+	}.Build()
+	File_reconciler_proto = out.File
+	file_reconciler_proto_rawDesc = nil
+	file_reconciler_proto_goTypes = nil
+	file_reconciler_proto_depIdxs = nil
+}
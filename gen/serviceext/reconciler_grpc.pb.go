@@ -0,0 +1,121 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: reconciler.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Reconciler_ReconcileLinks_FullMethodName = "/fitness_center.service_ext.Reconciler/ReconcileLinks"
+)
+
+// ReconcilerClient is the client API for Reconciler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReconcilerClient interface {
+	ReconcileLinks(ctx context.Context, in *ReconcileLinksRequest, opts ...grpc.CallOption) (*ReconcileLinksResponse, error)
+}
+
+type reconcilerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReconcilerClient(cc grpc.ClientConnInterface) ReconcilerClient {
+	return &reconcilerClient{cc}
+}
+
+func (c *reconcilerClient) ReconcileLinks(ctx context.Context, in *ReconcileLinksRequest, opts ...grpc.CallOption) (*ReconcileLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileLinksResponse)
+	err := c.cc.Invoke(ctx, Reconciler_ReconcileLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReconcilerServer is the server API for Reconciler service.
+// All implementations must embed UnimplementedReconcilerServer
+// for forward compatibility.
+type ReconcilerServer interface {
+	ReconcileLinks(context.Context, *ReconcileLinksRequest) (*ReconcileLinksResponse, error)
+	mustEmbedUnimplementedReconcilerServer()
+}
+
+// UnimplementedReconcilerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReconcilerServer struct{}
+
+func (UnimplementedReconcilerServer) ReconcileLinks(context.Context, *ReconcileLinksRequest) (*ReconcileLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileLinks not implemented")
+}
+func (UnimplementedReconcilerServer) mustEmbedUnimplementedReconcilerServer() {}
+func (UnimplementedReconcilerServer) testEmbeddedByValue()                    {}
+
+// UnsafeReconcilerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReconcilerServer will
+// result in compilation errors.
+type UnsafeReconcilerServer interface {
+	mustEmbedUnimplementedReconcilerServer()
+}
+
+func RegisterReconcilerServer(s grpc.ServiceRegistrar, srv ReconcilerServer) {
+	// If the following call pancis, it indicates UnimplementedReconcilerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Reconciler_ServiceDesc, srv)
+}
+
+func _Reconciler_ReconcileLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReconcilerServer).ReconcileLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reconciler_ReconcileLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReconcilerServer).ReconcileLinks(ctx, req.(*ReconcileLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Reconciler_ServiceDesc is the grpc.ServiceDesc for Reconciler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Reconciler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.Reconciler",
+	HandlerType: (*ReconcilerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReconcileLinks",
+			Handler:    _Reconciler_ReconcileLinks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reconciler.proto",
+}
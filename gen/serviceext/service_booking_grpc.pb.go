@@ -0,0 +1,319 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service_booking.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ServiceBooking_GetBookingSettings_FullMethodName = "/fitness_center.service_ext.ServiceBooking/GetBookingSettings"
+	ServiceBooking_SetBookingSettings_FullMethodName = "/fitness_center.service_ext.ServiceBooking/SetBookingSettings"
+	ServiceBooking_GetAvailability_FullMethodName    = "/fitness_center.service_ext.ServiceBooking/GetAvailability"
+	ServiceBooking_BookSlot_FullMethodName           = "/fitness_center.service_ext.ServiceBooking/BookSlot"
+	ServiceBooking_CancelBooking_FullMethodName      = "/fitness_center.service_ext.ServiceBooking/CancelBooking"
+	ServiceBooking_GetBooking_FullMethodName         = "/fitness_center.service_ext.ServiceBooking/GetBooking"
+)
+
+// ServiceBookingClient is the client API for ServiceBooking service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServiceBooking cuts the opening windows of a service into slots and books
+// members into them without going over the capacity of a slot.
+type ServiceBookingClient interface {
+	GetBookingSettings(ctx context.Context, in *GetBookingSettingsRequest, opts ...grpc.CallOption) (*BookingSettingsObject, error)
+	SetBookingSettings(ctx context.Context, in *SetBookingSettingsRequest, opts ...grpc.CallOption) (*BookingSettingsObject, error)
+	GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*SlotList, error)
+	BookSlot(ctx context.Context, in *BookSlotRequest, opts ...grpc.CallOption) (*BookingObject, error)
+	// Cancelling a cancelled booking returns it unchanged.
+	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*BookingObject, error)
+	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*BookingObject, error)
+}
+
+type serviceBookingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceBookingClient(cc grpc.ClientConnInterface) ServiceBookingClient {
+	return &serviceBookingClient{cc}
+}
+
+func (c *serviceBookingClient) GetBookingSettings(ctx context.Context, in *GetBookingSettingsRequest, opts ...grpc.CallOption) (*BookingSettingsObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingSettingsObject)
+	err := c.cc.Invoke(ctx, ServiceBooking_GetBookingSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceBookingClient) SetBookingSettings(ctx context.Context, in *SetBookingSettingsRequest, opts ...grpc.CallOption) (*BookingSettingsObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingSettingsObject)
+	err := c.cc.Invoke(ctx, ServiceBooking_SetBookingSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceBookingClient) GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*SlotList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotList)
+	err := c.cc.Invoke(ctx, ServiceBooking_GetAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceBookingClient) BookSlot(ctx context.Context, in *BookSlotRequest, opts ...grpc.CallOption) (*BookingObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingObject)
+	err := c.cc.Invoke(ctx, ServiceBooking_BookSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceBookingClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*BookingObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingObject)
+	err := c.cc.Invoke(ctx, ServiceBooking_CancelBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceBookingClient) GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*BookingObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingObject)
+	err := c.cc.Invoke(ctx, ServiceBooking_GetBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceBookingServer is the server API for ServiceBooking service.
+// All implementations must embed UnimplementedServiceBookingServer
+// for forward compatibility.
+//
+// ServiceBooking cuts the opening windows of a service into slots and books
+// members into them without going over the capacity of a slot.
+type ServiceBookingServer interface {
+	GetBookingSettings(context.Context, *GetBookingSettingsRequest) (*BookingSettingsObject, error)
+	SetBookingSettings(context.Context, *SetBookingSettingsRequest) (*BookingSettingsObject, error)
+	GetAvailability(context.Context, *GetAvailabilityRequest) (*SlotList, error)
+	BookSlot(context.Context, *BookSlotRequest) (*BookingObject, error)
+	// Cancelling a cancelled booking returns it unchanged.
+	CancelBooking(context.Context, *CancelBookingRequest) (*BookingObject, error)
+	GetBooking(context.Context, *GetBookingRequest) (*BookingObject, error)
+	mustEmbedUnimplementedServiceBookingServer()
+}
+
+// UnimplementedServiceBookingServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceBookingServer struct{}
+
+func (UnimplementedServiceBookingServer) GetBookingSettings(context.Context, *GetBookingSettingsRequest) (*BookingSettingsObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingSettings not implemented")
+}
+func (UnimplementedServiceBookingServer) SetBookingSettings(context.Context, *SetBookingSettingsRequest) (*BookingSettingsObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBookingSettings not implemented")
+}
+func (UnimplementedServiceBookingServer) GetAvailability(context.Context, *GetAvailabilityRequest) (*SlotList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailability not implemented")
+}
+func (UnimplementedServiceBookingServer) BookSlot(context.Context, *BookSlotRequest) (*BookingObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookSlot not implemented")
+}
+func (UnimplementedServiceBookingServer) CancelBooking(context.Context, *CancelBookingRequest) (*BookingObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
+}
+func (UnimplementedServiceBookingServer) GetBooking(context.Context, *GetBookingRequest) (*BookingObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBooking not implemented")
+}
+func (UnimplementedServiceBookingServer) mustEmbedUnimplementedServiceBookingServer() {}
+func (UnimplementedServiceBookingServer) testEmbeddedByValue()                        {}
+
+// UnsafeServiceBookingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceBookingServer will
+// result in compilation errors.
+type UnsafeServiceBookingServer interface {
+	mustEmbedUnimplementedServiceBookingServer()
+}
+
+func RegisterServiceBookingServer(s grpc.ServiceRegistrar, srv ServiceBookingServer) {
+	// If the following call pancis, it indicates UnimplementedServiceBookingServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceBooking_ServiceDesc, srv)
+}
+
+func _ServiceBooking_GetBookingSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceBookingServer).GetBookingSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceBooking_GetBookingSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceBookingServer).GetBookingSettings(ctx, req.(*GetBookingSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceBooking_SetBookingSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBookingSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceBookingServer).SetBookingSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceBooking_SetBookingSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceBookingServer).SetBookingSettings(ctx, req.(*SetBookingSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceBooking_GetAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceBookingServer).GetAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceBooking_GetAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceBookingServer).GetAvailability(ctx, req.(*GetAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceBooking_BookSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceBookingServer).BookSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceBooking_BookSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceBookingServer).BookSlot(ctx, req.(*BookSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceBooking_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceBookingServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceBooking_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceBookingServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceBooking_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceBookingServer).GetBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceBooking_GetBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceBookingServer).GetBooking(ctx, req.(*GetBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceBooking_ServiceDesc is the grpc.ServiceDesc for ServiceBooking service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceBooking_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.ServiceBooking",
+	HandlerType: (*ServiceBookingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBookingSettings",
+			Handler:    _ServiceBooking_GetBookingSettings_Handler,
+		},
+		{
+			MethodName: "SetBookingSettings",
+			Handler:    _ServiceBooking_SetBookingSettings_Handler,
+		},
+		{
+			MethodName: "GetAvailability",
+			Handler:    _ServiceBooking_GetAvailability_Handler,
+		},
+		{
+			MethodName: "BookSlot",
+			Handler:    _ServiceBooking_BookSlot_Handler,
+		},
+		{
+			MethodName: "CancelBooking",
+			Handler:    _ServiceBooking_CancelBooking_Handler,
+		},
+		{
+			MethodName: "GetBooking",
+			Handler:    _ServiceBooking_GetBooking_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_booking.proto",
+}
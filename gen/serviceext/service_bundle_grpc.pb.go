@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service_bundle.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ServiceBundles_GetBundleComponents_FullMethodName = "/fitness_center.service_ext.ServiceBundles/GetBundleComponents"
+	ServiceBundles_SetBundleComponents_FullMethodName = "/fitness_center.service_ext.ServiceBundles/SetBundleComponents"
+)
+
+// ServiceBundlesClient is the client API for ServiceBundles service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServiceBundles manages composite services. A service with components is a
+// bundle; bundles may contain other bundles but never themselves.
+type ServiceBundlesClient interface {
+	GetBundleComponents(ctx context.Context, in *GetBundleComponentsRequest, opts ...grpc.CallOption) (*BundleComponents, error)
+	// Replaces every component. Fails with INVALID_ARGUMENT when the bundle
+	// would contain itself.
+	SetBundleComponents(ctx context.Context, in *SetBundleComponentsRequest, opts ...grpc.CallOption) (*BundleComponents, error)
+}
+
+type serviceBundlesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceBundlesClient(cc grpc.ClientConnInterface) ServiceBundlesClient {
+	return &serviceBundlesClient{cc}
+}
+
+func (c *serviceBundlesClient) GetBundleComponents(ctx context.Context, in *GetBundleComponentsRequest, opts ...grpc.CallOption) (*BundleComponents, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleComponents)
+	err := c.cc.Invoke(ctx, ServiceBundles_GetBundleComponents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceBundlesClient) SetBundleComponents(ctx context.Context, in *SetBundleComponentsRequest, opts ...grpc.CallOption) (*BundleComponents, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleComponents)
+	err := c.cc.Invoke(ctx, ServiceBundles_SetBundleComponents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceBundlesServer is the server API for ServiceBundles service.
+// All implementations must embed UnimplementedServiceBundlesServer
+// for forward compatibility.
+//
+// ServiceBundles manages composite services. A service with components is a
+// bundle; bundles may contain other bundles but never themselves.
+type ServiceBundlesServer interface {
+	GetBundleComponents(context.Context, *GetBundleComponentsRequest) (*BundleComponents, error)
+	// Replaces every component. Fails with INVALID_ARGUMENT when the bundle
+	// would contain itself.
+	SetBundleComponents(context.Context, *SetBundleComponentsRequest) (*BundleComponents, error)
+	mustEmbedUnimplementedServiceBundlesServer()
+}
+
+// UnimplementedServiceBundlesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceBundlesServer struct{}
+
+func (UnimplementedServiceBundlesServer) GetBundleComponents(context.Context, *GetBundleComponentsRequest) (*BundleComponents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundleComponents not implemented")
+}
+func (UnimplementedServiceBundlesServer) SetBundleComponents(context.Context, *SetBundleComponentsRequest) (*BundleComponents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBundleComponents not implemented")
+}
+func (UnimplementedServiceBundlesServer) mustEmbedUnimplementedServiceBundlesServer() {}
+func (UnimplementedServiceBundlesServer) testEmbeddedByValue()                        {}
+
+// UnsafeServiceBundlesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceBundlesServer will
+// result in compilation errors.
+type UnsafeServiceBundlesServer interface {
+	mustEmbedUnimplementedServiceBundlesServer()
+}
+
+func RegisterServiceBundlesServer(s grpc.ServiceRegistrar, srv ServiceBundlesServer) {
+	// If the following call pancis, it indicates UnimplementedServiceBundlesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceBundles_ServiceDesc, srv)
+}
+
+func _ServiceBundles_GetBundleComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundleComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceBundlesServer).GetBundleComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceBundles_GetBundleComponents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceBundlesServer).GetBundleComponents(ctx, req.(*GetBundleComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceBundles_SetBundleComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBundleComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceBundlesServer).SetBundleComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceBundles_SetBundleComponents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceBundlesServer).SetBundleComponents(ctx, req.(*SetBundleComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceBundles_ServiceDesc is the grpc.ServiceDesc for ServiceBundles service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceBundles_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.ServiceBundles",
+	HandlerType: (*ServiceBundlesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBundleComponents",
+			Handler:    _ServiceBundles_GetBundleComponents_Handler,
+		},
+		{
+			MethodName: "SetBundleComponents",
+			Handler:    _ServiceBundles_SetBundleComponents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_bundle.proto",
+}
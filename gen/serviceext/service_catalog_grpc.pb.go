@@ -0,0 +1,475 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service_catalog.proto
+
+package serviceext
+
+import (
+	context "context"
+	FitnessCenter_protobuf_service "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ServiceCatalog_CreateCategory_FullMethodName        = "/fitness_center.service_ext.ServiceCatalog/CreateCategory"
+	ServiceCatalog_GetCategory_FullMethodName           = "/fitness_center.service_ext.ServiceCatalog/GetCategory"
+	ServiceCatalog_GetCategories_FullMethodName         = "/fitness_center.service_ext.ServiceCatalog/GetCategories"
+	ServiceCatalog_UpdateCategory_FullMethodName        = "/fitness_center.service_ext.ServiceCatalog/UpdateCategory"
+	ServiceCatalog_DeleteCategory_FullMethodName        = "/fitness_center.service_ext.ServiceCatalog/DeleteCategory"
+	ServiceCatalog_SetServiceCategory_FullMethodName    = "/fitness_center.service_ext.ServiceCatalog/SetServiceCategory"
+	ServiceCatalog_SetServiceTags_FullMethodName        = "/fitness_center.service_ext.ServiceCatalog/SetServiceTags"
+	ServiceCatalog_ListServices_FullMethodName          = "/fitness_center.service_ext.ServiceCatalog/ListServices"
+	ServiceCatalog_GetAbonementsServices_FullMethodName = "/fitness_center.service_ext.ServiceCatalog/GetAbonementsServices"
+	ServiceCatalog_GetCoachesServices_FullMethodName    = "/fitness_center.service_ext.ServiceCatalog/GetCoachesServices"
+)
+
+// ServiceCatalogClient is the client API for ServiceCatalog service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServiceCatalog manages the category tree and the tags of services, and
+// lists services narrowed by them.
+type ServiceCatalogClient interface {
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CategoryObject, error)
+	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*CategoryObject, error)
+	GetCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoryList, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*CategoryObject, error)
+	// Fails with FAILED_PRECONDITION while the category has subcategories or
+	// services.
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetServiceCategory(ctx context.Context, in *SetServiceCategoryRequest, opts ...grpc.CallOption) (*ServiceClassification, error)
+	SetServiceTags(ctx context.Context, in *SetServiceTagsRequest, opts ...grpc.CallOption) (*ServiceClassification, error)
+	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error)
+	GetAbonementsServices(ctx context.Context, in *FilteredAbonementsServicesRequest, opts ...grpc.CallOption) (*FitnessCenter_protobuf_service.GetAbonementsServicesResponse, error)
+	GetCoachesServices(ctx context.Context, in *FilteredCoachesServicesRequest, opts ...grpc.CallOption) (*FitnessCenter_protobuf_service.GetCoachesServicesResponse, error)
+}
+
+type serviceCatalogClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceCatalogClient(cc grpc.ClientConnInterface) ServiceCatalogClient {
+	return &serviceCatalogClient{cc}
+}
+
+func (c *serviceCatalogClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CategoryObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryObject)
+	err := c.cc.Invoke(ctx, ServiceCatalog_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceCatalogClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*CategoryObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryObject)
+	err := c.cc.Invoke(ctx, ServiceCatalog_GetCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceCatalogClient) GetCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoryList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryList)
+	err := c.cc.Invoke(ctx, ServiceCatalog_GetCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceCatalogClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*CategoryObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryObject)
+	err := c.cc.Invoke(ctx, ServiceCatalog_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceCatalogClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ServiceCatalog_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceCatalogClient) SetServiceCategory(ctx context.Context, in *SetServiceCategoryRequest, opts ...grpc.CallOption) (*ServiceClassification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceClassification)
+	err := c.cc.Invoke(ctx, ServiceCatalog_SetServiceCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceCatalogClient) SetServiceTags(ctx context.Context, in *SetServiceTagsRequest, opts ...grpc.CallOption) (*ServiceClassification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceClassification)
+	err := c.cc.Invoke(ctx, ServiceCatalog_SetServiceTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceCatalogClient) ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServicesResponse)
+	err := c.cc.Invoke(ctx, ServiceCatalog_ListServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceCatalogClient) GetAbonementsServices(ctx context.Context, in *FilteredAbonementsServicesRequest, opts ...grpc.CallOption) (*FitnessCenter_protobuf_service.GetAbonementsServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FitnessCenter_protobuf_service.GetAbonementsServicesResponse)
+	err := c.cc.Invoke(ctx, ServiceCatalog_GetAbonementsServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceCatalogClient) GetCoachesServices(ctx context.Context, in *FilteredCoachesServicesRequest, opts ...grpc.CallOption) (*FitnessCenter_protobuf_service.GetCoachesServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FitnessCenter_protobuf_service.GetCoachesServicesResponse)
+	err := c.cc.Invoke(ctx, ServiceCatalog_GetCoachesServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceCatalogServer is the server API for ServiceCatalog service.
+// All implementations must embed UnimplementedServiceCatalogServer
+// for forward compatibility.
+//
+// ServiceCatalog manages the category tree and the tags of services, and
+// lists services narrowed by them.
+type ServiceCatalogServer interface {
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CategoryObject, error)
+	GetCategory(context.Context, *GetCategoryRequest) (*CategoryObject, error)
+	GetCategories(context.Context, *emptypb.Empty) (*CategoryList, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryObject, error)
+	// Fails with FAILED_PRECONDITION while the category has subcategories or
+	// services.
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
+	SetServiceCategory(context.Context, *SetServiceCategoryRequest) (*ServiceClassification, error)
+	SetServiceTags(context.Context, *SetServiceTagsRequest) (*ServiceClassification, error)
+	ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error)
+	GetAbonementsServices(context.Context, *FilteredAbonementsServicesRequest) (*FitnessCenter_protobuf_service.GetAbonementsServicesResponse, error)
+	GetCoachesServices(context.Context, *FilteredCoachesServicesRequest) (*FitnessCenter_protobuf_service.GetCoachesServicesResponse, error)
+	mustEmbedUnimplementedServiceCatalogServer()
+}
+
+// UnimplementedServiceCatalogServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceCatalogServer struct{}
+
+func (UnimplementedServiceCatalogServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CategoryObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedServiceCatalogServer) GetCategory(context.Context, *GetCategoryRequest) (*CategoryObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedServiceCatalogServer) GetCategories(context.Context, *emptypb.Empty) (*CategoryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategories not implemented")
+}
+func (UnimplementedServiceCatalogServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedServiceCatalogServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedServiceCatalogServer) SetServiceCategory(context.Context, *SetServiceCategoryRequest) (*ServiceClassification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServiceCategory not implemented")
+}
+func (UnimplementedServiceCatalogServer) SetServiceTags(context.Context, *SetServiceTagsRequest) (*ServiceClassification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServiceTags not implemented")
+}
+func (UnimplementedServiceCatalogServer) ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServices not implemented")
+}
+func (UnimplementedServiceCatalogServer) GetAbonementsServices(context.Context, *FilteredAbonementsServicesRequest) (*FitnessCenter_protobuf_service.GetAbonementsServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAbonementsServices not implemented")
+}
+func (UnimplementedServiceCatalogServer) GetCoachesServices(context.Context, *FilteredCoachesServicesRequest) (*FitnessCenter_protobuf_service.GetCoachesServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachesServices not implemented")
+}
+func (UnimplementedServiceCatalogServer) mustEmbedUnimplementedServiceCatalogServer() {}
+func (UnimplementedServiceCatalogServer) testEmbeddedByValue()                        {}
+
+// UnsafeServiceCatalogServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceCatalogServer will
+// result in compilation errors.
+type UnsafeServiceCatalogServer interface {
+	mustEmbedUnimplementedServiceCatalogServer()
+}
+
+func RegisterServiceCatalogServer(s grpc.ServiceRegistrar, srv ServiceCatalogServer) {
+	// If the following call pancis, it indicates UnimplementedServiceCatalogServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceCatalog_ServiceDesc, srv)
+}
+
+func _ServiceCatalog_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceCatalogServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceCatalog_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceCatalogServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceCatalog_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceCatalogServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceCatalog_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceCatalogServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceCatalog_GetCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceCatalogServer).GetCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceCatalog_GetCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceCatalogServer).GetCategories(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceCatalog_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceCatalogServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceCatalog_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceCatalogServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceCatalog_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceCatalogServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceCatalog_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceCatalogServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceCatalog_SetServiceCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetServiceCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceCatalogServer).SetServiceCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceCatalog_SetServiceCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceCatalogServer).SetServiceCategory(ctx, req.(*SetServiceCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceCatalog_SetServiceTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetServiceTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceCatalogServer).SetServiceTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceCatalog_SetServiceTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceCatalogServer).SetServiceTags(ctx, req.(*SetServiceTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceCatalog_ListServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceCatalogServer).ListServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceCatalog_ListServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceCatalogServer).ListServices(ctx, req.(*ListServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceCatalog_GetAbonementsServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilteredAbonementsServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceCatalogServer).GetAbonementsServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceCatalog_GetAbonementsServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceCatalogServer).GetAbonementsServices(ctx, req.(*FilteredAbonementsServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceCatalog_GetCoachesServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilteredCoachesServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceCatalogServer).GetCoachesServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceCatalog_GetCoachesServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceCatalogServer).GetCoachesServices(ctx, req.(*FilteredCoachesServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceCatalog_ServiceDesc is the grpc.ServiceDesc for ServiceCatalog service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceCatalog_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.ServiceCatalog",
+	HandlerType: (*ServiceCatalogServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCategory",
+			Handler:    _ServiceCatalog_CreateCategory_Handler,
+		},
+		{
+			MethodName: "GetCategory",
+			Handler:    _ServiceCatalog_GetCategory_Handler,
+		},
+		{
+			MethodName: "GetCategories",
+			Handler:    _ServiceCatalog_GetCategories_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _ServiceCatalog_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _ServiceCatalog_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "SetServiceCategory",
+			Handler:    _ServiceCatalog_SetServiceCategory_Handler,
+		},
+		{
+			MethodName: "SetServiceTags",
+			Handler:    _ServiceCatalog_SetServiceTags_Handler,
+		},
+		{
+			MethodName: "ListServices",
+			Handler:    _ServiceCatalog_ListServices_Handler,
+		},
+		{
+			MethodName: "GetAbonementsServices",
+			Handler:    _ServiceCatalog_GetAbonementsServices_Handler,
+		},
+		{
+			MethodName: "GetCoachesServices",
+			Handler:    _ServiceCatalog_GetCoachesServices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_catalog.proto",
+}
@@ -0,0 +1,121 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service_deletion.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ServiceDeletion_DeleteService_FullMethodName = "/fitness_center.service_ext.ServiceDeletion/DeleteService"
+)
+
+// ServiceDeletionClient is the client API for ServiceDeletion service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceDeletionClient interface {
+	DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceResponse, error)
+}
+
+type serviceDeletionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceDeletionClient(cc grpc.ClientConnInterface) ServiceDeletionClient {
+	return &serviceDeletionClient{cc}
+}
+
+func (c *serviceDeletionClient) DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteServiceResponse)
+	err := c.cc.Invoke(ctx, ServiceDeletion_DeleteService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceDeletionServer is the server API for ServiceDeletion service.
+// All implementations must embed UnimplementedServiceDeletionServer
+// for forward compatibility.
+type ServiceDeletionServer interface {
+	DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error)
+	mustEmbedUnimplementedServiceDeletionServer()
+}
+
+// UnimplementedServiceDeletionServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceDeletionServer struct{}
+
+func (UnimplementedServiceDeletionServer) DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteService not implemented")
+}
+func (UnimplementedServiceDeletionServer) mustEmbedUnimplementedServiceDeletionServer() {}
+func (UnimplementedServiceDeletionServer) testEmbeddedByValue()                         {}
+
+// UnsafeServiceDeletionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceDeletionServer will
+// result in compilation errors.
+type UnsafeServiceDeletionServer interface {
+	mustEmbedUnimplementedServiceDeletionServer()
+}
+
+func RegisterServiceDeletionServer(s grpc.ServiceRegistrar, srv ServiceDeletionServer) {
+	// If the following call pancis, it indicates UnimplementedServiceDeletionServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceDeletion_ServiceDesc, srv)
+}
+
+func _ServiceDeletion_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceDeletionServer).DeleteService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceDeletion_DeleteService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceDeletionServer).DeleteService(ctx, req.(*DeleteServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceDeletion_ServiceDesc is the grpc.ServiceDesc for ServiceDeletion service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceDeletion_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.ServiceDeletion",
+	HandlerType: (*ServiceDeletionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteService",
+			Handler:    _ServiceDeletion_DeleteService_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_deletion.proto",
+}
@@ -0,0 +1,358 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service_hours.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ServiceHours_GetServiceHours_FullMethodName      = "/fitness_center.service_ext.ServiceHours/GetServiceHours"
+	ServiceHours_SetServiceHours_FullMethodName      = "/fitness_center.service_ext.ServiceHours/SetServiceHours"
+	ServiceHours_AddServiceClosure_FullMethodName    = "/fitness_center.service_ext.ServiceHours/AddServiceClosure"
+	ServiceHours_RemoveServiceClosure_FullMethodName = "/fitness_center.service_ext.ServiceHours/RemoveServiceClosure"
+	ServiceHours_GetServiceClosures_FullMethodName   = "/fitness_center.service_ext.ServiceHours/GetServiceClosures"
+	ServiceHours_IsServiceOpen_FullMethodName        = "/fitness_center.service_ext.ServiceHours/IsServiceOpen"
+	ServiceHours_GetOpeningWindows_FullMethodName    = "/fitness_center.service_ext.ServiceHours/GetOpeningWindows"
+)
+
+// ServiceHoursClient is the client API for ServiceHours service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServiceHours manages weekly opening hours and closure days of services and
+// answers when a service is open.
+type ServiceHoursClient interface {
+	GetServiceHours(ctx context.Context, in *GetServiceHoursRequest, opts ...grpc.CallOption) (*ServiceHoursObject, error)
+	// Replaces the time zone and every interval of the service.
+	SetServiceHours(ctx context.Context, in *SetServiceHoursRequest, opts ...grpc.CallOption) (*ServiceHoursObject, error)
+	AddServiceClosure(ctx context.Context, in *AddServiceClosureRequest, opts ...grpc.CallOption) (*ServiceClosureObject, error)
+	RemoveServiceClosure(ctx context.Context, in *RemoveServiceClosureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetServiceClosures(ctx context.Context, in *GetServiceClosuresRequest, opts ...grpc.CallOption) (*ServiceClosureList, error)
+	IsServiceOpen(ctx context.Context, in *IsServiceOpenRequest, opts ...grpc.CallOption) (*IsServiceOpenResponse, error)
+	GetOpeningWindows(ctx context.Context, in *GetOpeningWindowsRequest, opts ...grpc.CallOption) (*OpeningWindowList, error)
+}
+
+type serviceHoursClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceHoursClient(cc grpc.ClientConnInterface) ServiceHoursClient {
+	return &serviceHoursClient{cc}
+}
+
+func (c *serviceHoursClient) GetServiceHours(ctx context.Context, in *GetServiceHoursRequest, opts ...grpc.CallOption) (*ServiceHoursObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceHoursObject)
+	err := c.cc.Invoke(ctx, ServiceHours_GetServiceHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceHoursClient) SetServiceHours(ctx context.Context, in *SetServiceHoursRequest, opts ...grpc.CallOption) (*ServiceHoursObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceHoursObject)
+	err := c.cc.Invoke(ctx, ServiceHours_SetServiceHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceHoursClient) AddServiceClosure(ctx context.Context, in *AddServiceClosureRequest, opts ...grpc.CallOption) (*ServiceClosureObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceClosureObject)
+	err := c.cc.Invoke(ctx, ServiceHours_AddServiceClosure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceHoursClient) RemoveServiceClosure(ctx context.Context, in *RemoveServiceClosureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ServiceHours_RemoveServiceClosure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceHoursClient) GetServiceClosures(ctx context.Context, in *GetServiceClosuresRequest, opts ...grpc.CallOption) (*ServiceClosureList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceClosureList)
+	err := c.cc.Invoke(ctx, ServiceHours_GetServiceClosures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceHoursClient) IsServiceOpen(ctx context.Context, in *IsServiceOpenRequest, opts ...grpc.CallOption) (*IsServiceOpenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsServiceOpenResponse)
+	err := c.cc.Invoke(ctx, ServiceHours_IsServiceOpen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceHoursClient) GetOpeningWindows(ctx context.Context, in *GetOpeningWindowsRequest, opts ...grpc.CallOption) (*OpeningWindowList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpeningWindowList)
+	err := c.cc.Invoke(ctx, ServiceHours_GetOpeningWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceHoursServer is the server API for ServiceHours service.
+// All implementations must embed UnimplementedServiceHoursServer
+// for forward compatibility.
+//
+// ServiceHours manages weekly opening hours and closure days of services and
+// answers when a service is open.
+type ServiceHoursServer interface {
+	GetServiceHours(context.Context, *GetServiceHoursRequest) (*ServiceHoursObject, error)
+	// Replaces the time zone and every interval of the service.
+	SetServiceHours(context.Context, *SetServiceHoursRequest) (*ServiceHoursObject, error)
+	AddServiceClosure(context.Context, *AddServiceClosureRequest) (*ServiceClosureObject, error)
+	RemoveServiceClosure(context.Context, *RemoveServiceClosureRequest) (*emptypb.Empty, error)
+	GetServiceClosures(context.Context, *GetServiceClosuresRequest) (*ServiceClosureList, error)
+	IsServiceOpen(context.Context, *IsServiceOpenRequest) (*IsServiceOpenResponse, error)
+	GetOpeningWindows(context.Context, *GetOpeningWindowsRequest) (*OpeningWindowList, error)
+	mustEmbedUnimplementedServiceHoursServer()
+}
+
+// UnimplementedServiceHoursServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceHoursServer struct{}
+
+func (UnimplementedServiceHoursServer) GetServiceHours(context.Context, *GetServiceHoursRequest) (*ServiceHoursObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceHours not implemented")
+}
+func (UnimplementedServiceHoursServer) SetServiceHours(context.Context, *SetServiceHoursRequest) (*ServiceHoursObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServiceHours not implemented")
+}
+func (UnimplementedServiceHoursServer) AddServiceClosure(context.Context, *AddServiceClosureRequest) (*ServiceClosureObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddServiceClosure not implemented")
+}
+func (UnimplementedServiceHoursServer) RemoveServiceClosure(context.Context, *RemoveServiceClosureRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveServiceClosure not implemented")
+}
+func (UnimplementedServiceHoursServer) GetServiceClosures(context.Context, *GetServiceClosuresRequest) (*ServiceClosureList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceClosures not implemented")
+}
+func (UnimplementedServiceHoursServer) IsServiceOpen(context.Context, *IsServiceOpenRequest) (*IsServiceOpenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsServiceOpen not implemented")
+}
+func (UnimplementedServiceHoursServer) GetOpeningWindows(context.Context, *GetOpeningWindowsRequest) (*OpeningWindowList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpeningWindows not implemented")
+}
+func (UnimplementedServiceHoursServer) mustEmbedUnimplementedServiceHoursServer() {}
+func (UnimplementedServiceHoursServer) testEmbeddedByValue()                      {}
+
+// UnsafeServiceHoursServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceHoursServer will
+// result in compilation errors.
+type UnsafeServiceHoursServer interface {
+	mustEmbedUnimplementedServiceHoursServer()
+}
+
+func RegisterServiceHoursServer(s grpc.ServiceRegistrar, srv ServiceHoursServer) {
+	// If the following call pancis, it indicates UnimplementedServiceHoursServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceHours_ServiceDesc, srv)
+}
+
+func _ServiceHours_GetServiceHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceHoursServer).GetServiceHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceHours_GetServiceHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceHoursServer).GetServiceHours(ctx, req.(*GetServiceHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceHours_SetServiceHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetServiceHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceHoursServer).SetServiceHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceHours_SetServiceHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceHoursServer).SetServiceHours(ctx, req.(*SetServiceHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceHours_AddServiceClosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddServiceClosureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceHoursServer).AddServiceClosure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceHours_AddServiceClosure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceHoursServer).AddServiceClosure(ctx, req.(*AddServiceClosureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceHours_RemoveServiceClosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveServiceClosureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceHoursServer).RemoveServiceClosure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceHours_RemoveServiceClosure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceHoursServer).RemoveServiceClosure(ctx, req.(*RemoveServiceClosureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceHours_GetServiceClosures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceClosuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceHoursServer).GetServiceClosures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceHours_GetServiceClosures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceHoursServer).GetServiceClosures(ctx, req.(*GetServiceClosuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceHours_IsServiceOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsServiceOpenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceHoursServer).IsServiceOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceHours_IsServiceOpen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceHoursServer).IsServiceOpen(ctx, req.(*IsServiceOpenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceHours_GetOpeningWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpeningWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceHoursServer).GetOpeningWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceHours_GetOpeningWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceHoursServer).GetOpeningWindows(ctx, req.(*GetOpeningWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceHours_ServiceDesc is the grpc.ServiceDesc for ServiceHours service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceHours_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.ServiceHours",
+	HandlerType: (*ServiceHoursServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServiceHours",
+			Handler:    _ServiceHours_GetServiceHours_Handler,
+		},
+		{
+			MethodName: "SetServiceHours",
+			Handler:    _ServiceHours_SetServiceHours_Handler,
+		},
+		{
+			MethodName: "AddServiceClosure",
+			Handler:    _ServiceHours_AddServiceClosure_Handler,
+		},
+		{
+			MethodName: "RemoveServiceClosure",
+			Handler:    _ServiceHours_RemoveServiceClosure_Handler,
+		},
+		{
+			MethodName: "GetServiceClosures",
+			Handler:    _ServiceHours_GetServiceClosures_Handler,
+		},
+		{
+			MethodName: "IsServiceOpen",
+			Handler:    _ServiceHours_IsServiceOpen_Handler,
+		},
+		{
+			MethodName: "GetOpeningWindows",
+			Handler:    _ServiceHours_GetOpeningWindows_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_hours.proto",
+}
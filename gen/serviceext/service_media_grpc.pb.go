@@ -0,0 +1,276 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service_media.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ServiceMedia_GetServiceMedia_FullMethodName      = "/fitness_center.service_ext.ServiceMedia/GetServiceMedia"
+	ServiceMedia_AddServiceMedia_FullMethodName      = "/fitness_center.service_ext.ServiceMedia/AddServiceMedia"
+	ServiceMedia_RemoveServiceMedia_FullMethodName   = "/fitness_center.service_ext.ServiceMedia/RemoveServiceMedia"
+	ServiceMedia_ReorderServiceMedia_FullMethodName  = "/fitness_center.service_ext.ServiceMedia/ReorderServiceMedia"
+	ServiceMedia_SetServiceMediaCover_FullMethodName = "/fitness_center.service_ext.ServiceMedia/SetServiceMediaCover"
+)
+
+// ServiceMediaClient is the client API for ServiceMedia service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServiceMedia manages the gallery of a service. The cover is also reported
+// as the photo of the service.
+type ServiceMediaClient interface {
+	GetServiceMedia(ctx context.Context, in *GetServiceMediaRequest, opts ...grpc.CallOption) (*ServiceMediaList, error)
+	// The first message carries the data, the following ones the content.
+	AddServiceMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddServiceMediaRequest, ServiceMediaObject], error)
+	RemoveServiceMedia(ctx context.Context, in *RemoveServiceMediaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReorderServiceMedia(ctx context.Context, in *ReorderServiceMediaRequest, opts ...grpc.CallOption) (*ServiceMediaList, error)
+	SetServiceMediaCover(ctx context.Context, in *SetServiceMediaCoverRequest, opts ...grpc.CallOption) (*ServiceMediaList, error)
+}
+
+type serviceMediaClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceMediaClient(cc grpc.ClientConnInterface) ServiceMediaClient {
+	return &serviceMediaClient{cc}
+}
+
+func (c *serviceMediaClient) GetServiceMedia(ctx context.Context, in *GetServiceMediaRequest, opts ...grpc.CallOption) (*ServiceMediaList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceMediaList)
+	err := c.cc.Invoke(ctx, ServiceMedia_GetServiceMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceMediaClient) AddServiceMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddServiceMediaRequest, ServiceMediaObject], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ServiceMedia_ServiceDesc.Streams[0], ServiceMedia_AddServiceMedia_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AddServiceMediaRequest, ServiceMediaObject]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ServiceMedia_AddServiceMediaClient = grpc.ClientStreamingClient[AddServiceMediaRequest, ServiceMediaObject]
+
+func (c *serviceMediaClient) RemoveServiceMedia(ctx context.Context, in *RemoveServiceMediaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ServiceMedia_RemoveServiceMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceMediaClient) ReorderServiceMedia(ctx context.Context, in *ReorderServiceMediaRequest, opts ...grpc.CallOption) (*ServiceMediaList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceMediaList)
+	err := c.cc.Invoke(ctx, ServiceMedia_ReorderServiceMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceMediaClient) SetServiceMediaCover(ctx context.Context, in *SetServiceMediaCoverRequest, opts ...grpc.CallOption) (*ServiceMediaList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceMediaList)
+	err := c.cc.Invoke(ctx, ServiceMedia_SetServiceMediaCover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceMediaServer is the server API for ServiceMedia service.
+// All implementations must embed UnimplementedServiceMediaServer
+// for forward compatibility.
+//
+// ServiceMedia manages the gallery of a service. The cover is also reported
+// as the photo of the service.
+type ServiceMediaServer interface {
+	GetServiceMedia(context.Context, *GetServiceMediaRequest) (*ServiceMediaList, error)
+	// The first message carries the data, the following ones the content.
+	AddServiceMedia(grpc.ClientStreamingServer[AddServiceMediaRequest, ServiceMediaObject]) error
+	RemoveServiceMedia(context.Context, *RemoveServiceMediaRequest) (*emptypb.Empty, error)
+	ReorderServiceMedia(context.Context, *ReorderServiceMediaRequest) (*ServiceMediaList, error)
+	SetServiceMediaCover(context.Context, *SetServiceMediaCoverRequest) (*ServiceMediaList, error)
+	mustEmbedUnimplementedServiceMediaServer()
+}
+
+// UnimplementedServiceMediaServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceMediaServer struct{}
+
+func (UnimplementedServiceMediaServer) GetServiceMedia(context.Context, *GetServiceMediaRequest) (*ServiceMediaList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceMedia not implemented")
+}
+func (UnimplementedServiceMediaServer) AddServiceMedia(grpc.ClientStreamingServer[AddServiceMediaRequest, ServiceMediaObject]) error {
+	return status.Errorf(codes.Unimplemented, "method AddServiceMedia not implemented")
+}
+func (UnimplementedServiceMediaServer) RemoveServiceMedia(context.Context, *RemoveServiceMediaRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveServiceMedia not implemented")
+}
+func (UnimplementedServiceMediaServer) ReorderServiceMedia(context.Context, *ReorderServiceMediaRequest) (*ServiceMediaList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderServiceMedia not implemented")
+}
+func (UnimplementedServiceMediaServer) SetServiceMediaCover(context.Context, *SetServiceMediaCoverRequest) (*ServiceMediaList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServiceMediaCover not implemented")
+}
+func (UnimplementedServiceMediaServer) mustEmbedUnimplementedServiceMediaServer() {}
+func (UnimplementedServiceMediaServer) testEmbeddedByValue()                      {}
+
+// UnsafeServiceMediaServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceMediaServer will
+// result in compilation errors.
+type UnsafeServiceMediaServer interface {
+	mustEmbedUnimplementedServiceMediaServer()
+}
+
+func RegisterServiceMediaServer(s grpc.ServiceRegistrar, srv ServiceMediaServer) {
+	// If the following call pancis, it indicates UnimplementedServiceMediaServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceMedia_ServiceDesc, srv)
+}
+
+func _ServiceMedia_GetServiceMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceMediaServer).GetServiceMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceMedia_GetServiceMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceMediaServer).GetServiceMedia(ctx, req.(*GetServiceMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceMedia_AddServiceMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServiceMediaServer).AddServiceMedia(&grpc.GenericServerStream[AddServiceMediaRequest, ServiceMediaObject]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ServiceMedia_AddServiceMediaServer = grpc.ClientStreamingServer[AddServiceMediaRequest, ServiceMediaObject]
+
+func _ServiceMedia_RemoveServiceMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveServiceMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceMediaServer).RemoveServiceMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceMedia_RemoveServiceMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceMediaServer).RemoveServiceMedia(ctx, req.(*RemoveServiceMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceMedia_ReorderServiceMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderServiceMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceMediaServer).ReorderServiceMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceMedia_ReorderServiceMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceMediaServer).ReorderServiceMedia(ctx, req.(*ReorderServiceMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceMedia_SetServiceMediaCover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetServiceMediaCoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceMediaServer).SetServiceMediaCover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceMedia_SetServiceMediaCover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceMediaServer).SetServiceMediaCover(ctx, req.(*SetServiceMediaCoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceMedia_ServiceDesc is the grpc.ServiceDesc for ServiceMedia service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceMedia_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.ServiceMedia",
+	HandlerType: (*ServiceMediaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServiceMedia",
+			Handler:    _ServiceMedia_GetServiceMedia_Handler,
+		},
+		{
+			MethodName: "RemoveServiceMedia",
+			Handler:    _ServiceMedia_RemoveServiceMedia_Handler,
+		},
+		{
+			MethodName: "ReorderServiceMedia",
+			Handler:    _ServiceMedia_ReorderServiceMedia_Handler,
+		},
+		{
+			MethodName: "SetServiceMediaCover",
+			Handler:    _ServiceMedia_SetServiceMediaCover_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AddServiceMedia",
+			Handler:       _ServiceMedia_AddServiceMedia_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "service_media.proto",
+}
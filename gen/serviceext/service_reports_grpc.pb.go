@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service_reports.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ServiceReports_GetServiceUsage_FullMethodName = "/fitness_center.service_ext.ServiceReports/GetServiceUsage"
+	ServiceReports_GetCoverageGaps_FullMethodName = "/fitness_center.service_ext.ServiceReports/GetCoverageGaps"
+	ServiceReports_GetVisitTrend_FullMethodName   = "/fitness_center.service_ext.ServiceReports/GetVisitTrend"
+	ServiceReports_ExportReport_FullMethodName    = "/fitness_center.service_ext.ServiceReports/ExportReport"
+)
+
+// ServiceReportsClient is the client API for ServiceReports service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServiceReports sums up how services are covered by coaches, included in
+// abonements and visited.
+type ServiceReportsClient interface {
+	GetServiceUsage(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ServiceUsageList, error)
+	// GetCoverageGaps lists the services no coach offers.
+	GetCoverageGaps(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ServiceUsageList, error)
+	GetVisitTrend(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*VisitTrend, error)
+	// ExportReport streams a report as CSV with a header row.
+	ExportReport(ctx context.Context, in *ExportReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CsvChunk], error)
+}
+
+type serviceReportsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceReportsClient(cc grpc.ClientConnInterface) ServiceReportsClient {
+	return &serviceReportsClient{cc}
+}
+
+func (c *serviceReportsClient) GetServiceUsage(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ServiceUsageList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceUsageList)
+	err := c.cc.Invoke(ctx, ServiceReports_GetServiceUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceReportsClient) GetCoverageGaps(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ServiceUsageList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceUsageList)
+	err := c.cc.Invoke(ctx, ServiceReports_GetCoverageGaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceReportsClient) GetVisitTrend(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*VisitTrend, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VisitTrend)
+	err := c.cc.Invoke(ctx, ServiceReports_GetVisitTrend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceReportsClient) ExportReport(ctx context.Context, in *ExportReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CsvChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ServiceReports_ServiceDesc.Streams[0], ServiceReports_ExportReport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportReportRequest, CsvChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ServiceReports_ExportReportClient = grpc.ServerStreamingClient[CsvChunk]
+
+// ServiceReportsServer is the server API for ServiceReports service.
+// All implementations must embed UnimplementedServiceReportsServer
+// for forward compatibility.
+//
+// ServiceReports sums up how services are covered by coaches, included in
+// abonements and visited.
+type ServiceReportsServer interface {
+	GetServiceUsage(context.Context, *ReportRequest) (*ServiceUsageList, error)
+	// GetCoverageGaps lists the services no coach offers.
+	GetCoverageGaps(context.Context, *ReportRequest) (*ServiceUsageList, error)
+	GetVisitTrend(context.Context, *ReportRequest) (*VisitTrend, error)
+	// ExportReport streams a report as CSV with a header row.
+	ExportReport(*ExportReportRequest, grpc.ServerStreamingServer[CsvChunk]) error
+	mustEmbedUnimplementedServiceReportsServer()
+}
+
+// UnimplementedServiceReportsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceReportsServer struct{}
+
+func (UnimplementedServiceReportsServer) GetServiceUsage(context.Context, *ReportRequest) (*ServiceUsageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceUsage not implemented")
+}
+func (UnimplementedServiceReportsServer) GetCoverageGaps(context.Context, *ReportRequest) (*ServiceUsageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoverageGaps not implemented")
+}
+func (UnimplementedServiceReportsServer) GetVisitTrend(context.Context, *ReportRequest) (*VisitTrend, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVisitTrend not implemented")
+}
+func (UnimplementedServiceReportsServer) ExportReport(*ExportReportRequest, grpc.ServerStreamingServer[CsvChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportReport not implemented")
+}
+func (UnimplementedServiceReportsServer) mustEmbedUnimplementedServiceReportsServer() {}
+func (UnimplementedServiceReportsServer) testEmbeddedByValue()                        {}
+
+// UnsafeServiceReportsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceReportsServer will
+// result in compilation errors.
+type UnsafeServiceReportsServer interface {
+	mustEmbedUnimplementedServiceReportsServer()
+}
+
+func RegisterServiceReportsServer(s grpc.ServiceRegistrar, srv ServiceReportsServer) {
+	// If the following call pancis, it indicates UnimplementedServiceReportsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceReports_ServiceDesc, srv)
+}
+
+func _ServiceReports_GetServiceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceReportsServer).GetServiceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceReports_GetServiceUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceReportsServer).GetServiceUsage(ctx, req.(*ReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceReports_GetCoverageGaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceReportsServer).GetCoverageGaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceReports_GetCoverageGaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceReportsServer).GetCoverageGaps(ctx, req.(*ReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceReports_GetVisitTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceReportsServer).GetVisitTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceReports_GetVisitTrend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceReportsServer).GetVisitTrend(ctx, req.(*ReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceReports_ExportReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceReportsServer).ExportReport(m, &grpc.GenericServerStream[ExportReportRequest, CsvChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ServiceReports_ExportReportServer = grpc.ServerStreamingServer[CsvChunk]
+
+// ServiceReports_ServiceDesc is the grpc.ServiceDesc for ServiceReports service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceReports_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.ServiceReports",
+	HandlerType: (*ServiceReportsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServiceUsage",
+			Handler:    _ServiceReports_GetServiceUsage_Handler,
+		},
+		{
+			MethodName: "GetCoverageGaps",
+			Handler:    _ServiceReports_GetCoverageGaps_Handler,
+		},
+		{
+			MethodName: "GetVisitTrend",
+			Handler:    _ServiceReports_GetVisitTrend_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportReport",
+			Handler:       _ServiceReports_ExportReport_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service_reports.proto",
+}
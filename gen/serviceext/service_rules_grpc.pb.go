@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service_rules.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ServiceRules_CreateServiceRule_FullMethodName = "/fitness_center.service_ext.ServiceRules/CreateServiceRule"
+	ServiceRules_DeleteServiceRule_FullMethodName = "/fitness_center.service_ext.ServiceRules/DeleteServiceRule"
+	ServiceRules_GetServiceRules_FullMethodName   = "/fitness_center.service_ext.ServiceRules/GetServiceRules"
+	ServiceRules_CheckServiceSet_FullMethodName   = "/fitness_center.service_ext.ServiceRules/CheckServiceSet"
+)
+
+// ServiceRulesClient is the client API for ServiceRules service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServiceRules manages the prerequisite and exclusivity rules between
+// services. Linking services to an abonement fails with FAILED_PRECONDITION,
+// listing the broken rules, when the abonement would break any.
+type ServiceRulesClient interface {
+	// Fails with FAILED_PRECONDITION when a rule of the other kind binds the
+	// same services.
+	CreateServiceRule(ctx context.Context, in *CreateServiceRuleRequest, opts ...grpc.CallOption) (*ServiceRuleObject, error)
+	DeleteServiceRule(ctx context.Context, in *DeleteServiceRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetServiceRules(ctx context.Context, in *GetServiceRulesRequest, opts ...grpc.CallOption) (*ServiceRuleList, error)
+	// CheckServiceSet checks the services proposed for an abonement without
+	// saving them.
+	CheckServiceSet(ctx context.Context, in *CheckServiceSetRequest, opts ...grpc.CallOption) (*ServiceSetCheckObject, error)
+}
+
+type serviceRulesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceRulesClient(cc grpc.ClientConnInterface) ServiceRulesClient {
+	return &serviceRulesClient{cc}
+}
+
+func (c *serviceRulesClient) CreateServiceRule(ctx context.Context, in *CreateServiceRuleRequest, opts ...grpc.CallOption) (*ServiceRuleObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceRuleObject)
+	err := c.cc.Invoke(ctx, ServiceRules_CreateServiceRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceRulesClient) DeleteServiceRule(ctx context.Context, in *DeleteServiceRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ServiceRules_DeleteServiceRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceRulesClient) GetServiceRules(ctx context.Context, in *GetServiceRulesRequest, opts ...grpc.CallOption) (*ServiceRuleList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceRuleList)
+	err := c.cc.Invoke(ctx, ServiceRules_GetServiceRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceRulesClient) CheckServiceSet(ctx context.Context, in *CheckServiceSetRequest, opts ...grpc.CallOption) (*ServiceSetCheckObject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceSetCheckObject)
+	err := c.cc.Invoke(ctx, ServiceRules_CheckServiceSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceRulesServer is the server API for ServiceRules service.
+// All implementations must embed UnimplementedServiceRulesServer
+// for forward compatibility.
+//
+// ServiceRules manages the prerequisite and exclusivity rules between
+// services. Linking services to an abonement fails with FAILED_PRECONDITION,
+// listing the broken rules, when the abonement would break any.
+type ServiceRulesServer interface {
+	// Fails with FAILED_PRECONDITION when a rule of the other kind binds the
+	// same services.
+	CreateServiceRule(context.Context, *CreateServiceRuleRequest) (*ServiceRuleObject, error)
+	DeleteServiceRule(context.Context, *DeleteServiceRuleRequest) (*emptypb.Empty, error)
+	GetServiceRules(context.Context, *GetServiceRulesRequest) (*ServiceRuleList, error)
+	// CheckServiceSet checks the services proposed for an abonement without
+	// saving them.
+	CheckServiceSet(context.Context, *CheckServiceSetRequest) (*ServiceSetCheckObject, error)
+	mustEmbedUnimplementedServiceRulesServer()
+}
+
+// UnimplementedServiceRulesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceRulesServer struct{}
+
+func (UnimplementedServiceRulesServer) CreateServiceRule(context.Context, *CreateServiceRuleRequest) (*ServiceRuleObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceRule not implemented")
+}
+func (UnimplementedServiceRulesServer) DeleteServiceRule(context.Context, *DeleteServiceRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceRule not implemented")
+}
+func (UnimplementedServiceRulesServer) GetServiceRules(context.Context, *GetServiceRulesRequest) (*ServiceRuleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceRules not implemented")
+}
+func (UnimplementedServiceRulesServer) CheckServiceSet(context.Context, *CheckServiceSetRequest) (*ServiceSetCheckObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckServiceSet not implemented")
+}
+func (UnimplementedServiceRulesServer) mustEmbedUnimplementedServiceRulesServer() {}
+func (UnimplementedServiceRulesServer) testEmbeddedByValue()                      {}
+
+// UnsafeServiceRulesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceRulesServer will
+// result in compilation errors.
+type UnsafeServiceRulesServer interface {
+	mustEmbedUnimplementedServiceRulesServer()
+}
+
+func RegisterServiceRulesServer(s grpc.ServiceRegistrar, srv ServiceRulesServer) {
+	// If the following call pancis, it indicates UnimplementedServiceRulesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceRules_ServiceDesc, srv)
+}
+
+func _ServiceRules_CreateServiceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRulesServer).CreateServiceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRules_CreateServiceRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRulesServer).CreateServiceRule(ctx, req.(*CreateServiceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceRules_DeleteServiceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRulesServer).DeleteServiceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRules_DeleteServiceRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRulesServer).DeleteServiceRule(ctx, req.(*DeleteServiceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceRules_GetServiceRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRulesServer).GetServiceRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRules_GetServiceRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRulesServer).GetServiceRules(ctx, req.(*GetServiceRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceRules_CheckServiceSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckServiceSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRulesServer).CheckServiceSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRules_CheckServiceSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRulesServer).CheckServiceSet(ctx, req.(*CheckServiceSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceRules_ServiceDesc is the grpc.ServiceDesc for ServiceRules service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceRules_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.ServiceRules",
+	HandlerType: (*ServiceRulesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateServiceRule",
+			Handler:    _ServiceRules_CreateServiceRule_Handler,
+		},
+		{
+			MethodName: "DeleteServiceRule",
+			Handler:    _ServiceRules_DeleteServiceRule_Handler,
+		},
+		{
+			MethodName: "GetServiceRules",
+			Handler:    _ServiceRules_GetServiceRules_Handler,
+		},
+		{
+			MethodName: "CheckServiceSet",
+			Handler:    _ServiceRules_CheckServiceSet_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_rules.proto",
+}
@@ -0,0 +1,121 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service_slug.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ServiceSlug_GetServiceBySlug_FullMethodName = "/fitness_center.service_ext.ServiceSlug/GetServiceBySlug"
+)
+
+// ServiceSlugClient is the client API for ServiceSlug service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceSlugClient interface {
+	GetServiceBySlug(ctx context.Context, in *GetServiceBySlugRequest, opts ...grpc.CallOption) (*GetServiceBySlugResponse, error)
+}
+
+type serviceSlugClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceSlugClient(cc grpc.ClientConnInterface) ServiceSlugClient {
+	return &serviceSlugClient{cc}
+}
+
+func (c *serviceSlugClient) GetServiceBySlug(ctx context.Context, in *GetServiceBySlugRequest, opts ...grpc.CallOption) (*GetServiceBySlugResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServiceBySlugResponse)
+	err := c.cc.Invoke(ctx, ServiceSlug_GetServiceBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceSlugServer is the server API for ServiceSlug service.
+// All implementations must embed UnimplementedServiceSlugServer
+// for forward compatibility.
+type ServiceSlugServer interface {
+	GetServiceBySlug(context.Context, *GetServiceBySlugRequest) (*GetServiceBySlugResponse, error)
+	mustEmbedUnimplementedServiceSlugServer()
+}
+
+// UnimplementedServiceSlugServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceSlugServer struct{}
+
+func (UnimplementedServiceSlugServer) GetServiceBySlug(context.Context, *GetServiceBySlugRequest) (*GetServiceBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceBySlug not implemented")
+}
+func (UnimplementedServiceSlugServer) mustEmbedUnimplementedServiceSlugServer() {}
+func (UnimplementedServiceSlugServer) testEmbeddedByValue()                     {}
+
+// UnsafeServiceSlugServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceSlugServer will
+// result in compilation errors.
+type UnsafeServiceSlugServer interface {
+	mustEmbedUnimplementedServiceSlugServer()
+}
+
+func RegisterServiceSlugServer(s grpc.ServiceRegistrar, srv ServiceSlugServer) {
+	// If the following call pancis, it indicates UnimplementedServiceSlugServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceSlug_ServiceDesc, srv)
+}
+
+func _ServiceSlug_GetServiceBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceSlugServer).GetServiceBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceSlug_GetServiceBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceSlugServer).GetServiceBySlug(ctx, req.(*GetServiceBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceSlug_ServiceDesc is the grpc.ServiceDesc for ServiceSlug service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceSlug_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.ServiceSlug",
+	HandlerType: (*ServiceSlugServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServiceBySlug",
+			Handler:    _ServiceSlug_GetServiceBySlug_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_slug.proto",
+}
@@ -0,0 +1,236 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service_translation.proto
+
+package serviceext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ServiceTranslation_GetLocalizedService_FullMethodName      = "/fitness_center.service_ext.ServiceTranslation/GetLocalizedService"
+	ServiceTranslation_GetServiceTranslations_FullMethodName   = "/fitness_center.service_ext.ServiceTranslation/GetServiceTranslations"
+	ServiceTranslation_UpsertServiceTranslation_FullMethodName = "/fitness_center.service_ext.ServiceTranslation/UpsertServiceTranslation"
+	ServiceTranslation_DeleteServiceTranslation_FullMethodName = "/fitness_center.service_ext.ServiceTranslation/DeleteServiceTranslation"
+)
+
+// ServiceTranslationClient is the client API for ServiceTranslation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceTranslationClient interface {
+	GetLocalizedService(ctx context.Context, in *GetLocalizedServiceRequest, opts ...grpc.CallOption) (*GetLocalizedServiceResponse, error)
+	GetServiceTranslations(ctx context.Context, in *GetServiceTranslationsRequest, opts ...grpc.CallOption) (*GetServiceTranslationsResponse, error)
+	UpsertServiceTranslation(ctx context.Context, in *UpsertServiceTranslationRequest, opts ...grpc.CallOption) (*UpsertServiceTranslationResponse, error)
+	DeleteServiceTranslation(ctx context.Context, in *DeleteServiceTranslationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type serviceTranslationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceTranslationClient(cc grpc.ClientConnInterface) ServiceTranslationClient {
+	return &serviceTranslationClient{cc}
+}
+
+func (c *serviceTranslationClient) GetLocalizedService(ctx context.Context, in *GetLocalizedServiceRequest, opts ...grpc.CallOption) (*GetLocalizedServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLocalizedServiceResponse)
+	err := c.cc.Invoke(ctx, ServiceTranslation_GetLocalizedService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceTranslationClient) GetServiceTranslations(ctx context.Context, in *GetServiceTranslationsRequest, opts ...grpc.CallOption) (*GetServiceTranslationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServiceTranslationsResponse)
+	err := c.cc.Invoke(ctx, ServiceTranslation_GetServiceTranslations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceTranslationClient) UpsertServiceTranslation(ctx context.Context, in *UpsertServiceTranslationRequest, opts ...grpc.CallOption) (*UpsertServiceTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertServiceTranslationResponse)
+	err := c.cc.Invoke(ctx, ServiceTranslation_UpsertServiceTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceTranslationClient) DeleteServiceTranslation(ctx context.Context, in *DeleteServiceTranslationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ServiceTranslation_DeleteServiceTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceTranslationServer is the server API for ServiceTranslation service.
+// All implementations must embed UnimplementedServiceTranslationServer
+// for forward compatibility.
+type ServiceTranslationServer interface {
+	GetLocalizedService(context.Context, *GetLocalizedServiceRequest) (*GetLocalizedServiceResponse, error)
+	GetServiceTranslations(context.Context, *GetServiceTranslationsRequest) (*GetServiceTranslationsResponse, error)
+	UpsertServiceTranslation(context.Context, *UpsertServiceTranslationRequest) (*UpsertServiceTranslationResponse, error)
+	DeleteServiceTranslation(context.Context, *DeleteServiceTranslationRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedServiceTranslationServer()
+}
+
+// UnimplementedServiceTranslationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceTranslationServer struct{}
+
+func (UnimplementedServiceTranslationServer) GetLocalizedService(context.Context, *GetLocalizedServiceRequest) (*GetLocalizedServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocalizedService not implemented")
+}
+func (UnimplementedServiceTranslationServer) GetServiceTranslations(context.Context, *GetServiceTranslationsRequest) (*GetServiceTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceTranslations not implemented")
+}
+func (UnimplementedServiceTranslationServer) UpsertServiceTranslation(context.Context, *UpsertServiceTranslationRequest) (*UpsertServiceTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertServiceTranslation not implemented")
+}
+func (UnimplementedServiceTranslationServer) DeleteServiceTranslation(context.Context, *DeleteServiceTranslationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceTranslation not implemented")
+}
+func (UnimplementedServiceTranslationServer) mustEmbedUnimplementedServiceTranslationServer() {}
+func (UnimplementedServiceTranslationServer) testEmbeddedByValue()                            {}
+
+// UnsafeServiceTranslationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceTranslationServer will
+// result in compilation errors.
+type UnsafeServiceTranslationServer interface {
+	mustEmbedUnimplementedServiceTranslationServer()
+}
+
+func RegisterServiceTranslationServer(s grpc.ServiceRegistrar, srv ServiceTranslationServer) {
+	// If the following call pancis, it indicates UnimplementedServiceTranslationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceTranslation_ServiceDesc, srv)
+}
+
+func _ServiceTranslation_GetLocalizedService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocalizedServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceTranslationServer).GetLocalizedService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceTranslation_GetLocalizedService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceTranslationServer).GetLocalizedService(ctx, req.(*GetLocalizedServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceTranslation_GetServiceTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceTranslationServer).GetServiceTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceTranslation_GetServiceTranslations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceTranslationServer).GetServiceTranslations(ctx, req.(*GetServiceTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceTranslation_UpsertServiceTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertServiceTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceTranslationServer).UpsertServiceTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceTranslation_UpsertServiceTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceTranslationServer).UpsertServiceTranslation(ctx, req.(*UpsertServiceTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceTranslation_DeleteServiceTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceTranslationServer).DeleteServiceTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceTranslation_DeleteServiceTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceTranslationServer).DeleteServiceTranslation(ctx, req.(*DeleteServiceTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceTranslation_ServiceDesc is the grpc.ServiceDesc for ServiceTranslation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceTranslation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.service_ext.ServiceTranslation",
+	HandlerType: (*ServiceTranslationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLocalizedService",
+			Handler:    _ServiceTranslation_GetLocalizedService_Handler,
+		},
+		{
+			MethodName: "GetServiceTranslations",
+			Handler:    _ServiceTranslation_GetServiceTranslations_Handler,
+		},
+		{
+			MethodName: "UpsertServiceTranslation",
+			Handler:    _ServiceTranslation_UpsertServiceTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteServiceTranslation",
+			Handler:    _ServiceTranslation_DeleteServiceTranslation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_translation.proto",
+}
@@ -0,0 +1,282 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"time"
+)
+
+type AbonementAccessGRPC struct {
+	serviceext.UnimplementedAbonementAccessServer
+
+	AbonementAccessUseCase usecase.AbonementAccessUseCase
+	ServiceUseCase         usecase.ServiceUseCase
+	cloudUseCase           usecase.CloudUseCase
+}
+
+func RegisterAbonementAccess(
+	gRPC *grpc.Server,
+	abonementAccessUseCase usecase.AbonementAccessUseCase,
+	serviceUseCase usecase.ServiceUseCase,
+	cloudUseCase usecase.CloudUseCase,
+) {
+	serviceext.RegisterAbonementAccessServer(gRPC, &AbonementAccessGRPC{
+		AbonementAccessUseCase: abonementAccessUseCase,
+		ServiceUseCase:         serviceUseCase,
+		cloudUseCase:           cloudUseCase,
+	})
+}
+
+func (u *AbonementAccessGRPC) GetAbonementServiceTerms(
+	ctx context.Context,
+	request *serviceext.GetAbonementServiceTermsRequest,
+) (*serviceext.AbonementServiceTermsObject, error) {
+
+	v := validation.New()
+	abonementId := v.UUID("abonement_id", request.AbonementId)
+	serviceId := v.UUID("service_id", request.ServiceId)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	terms, err := u.AbonementAccessUseCase.GetAbonementServiceTerms(ctx, abonementId, serviceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toAbonementServiceTermsObject(terms), nil
+}
+
+func (u *AbonementAccessGRPC) SetAbonementServiceTerms(
+	ctx context.Context,
+	request *serviceext.SetAbonementServiceTermsRequest,
+) (*serviceext.AbonementServiceTermsObject, error) {
+
+	v := validation.New()
+	cmd := &dtos.SetAbonementServiceTermsCommand{
+		AbonementId: v.UUID("abonement_id", request.AbonementId),
+		ServiceId:   v.UUID("service_id", request.ServiceId),
+		QuotaPeriod: v.QuotaPeriod("quota_period", request.QuotaPeriod),
+		GuestPasses: v.GuestPasses("guest_passes", int(request.GuestPasses)),
+	}
+	if request.VisitQuota != nil {
+		visitQuota := int(*request.VisitQuota)
+		cmd.VisitQuota = v.VisitQuota("visit_quota", &visitQuota)
+	}
+	for i, window := range request.Windows {
+		cmd.Windows = append(cmd.Windows,
+			v.OpeningInterval(fmt.Sprintf("windows[%d]", i), window.GetWeekday(), window.GetOpens(), window.GetCloses()))
+	}
+	v.OpeningIntervals("windows", cmd.Windows)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	terms, err := u.AbonementAccessUseCase.SetAbonementServiceTerms(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toAbonementServiceTermsObject(terms), nil
+}
+
+func (u *AbonementAccessGRPC) GetAbonementsServices(
+	ctx context.Context,
+	request *serviceext.FilteredAbonementsServicesRequest,
+) (*serviceext.AbonementsServicesWithTermsResponse, error) {
+
+	v := validation.New()
+	abonementIds := v.UUIDs("abonement_ids", request.AbonementIds, validation.MaxBatchIds, true)
+	filter := validateServiceFilter(v, request.Filter)
+	expansion := v.BundleExpansion("bundles", request.Bundles)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	abonementsServices, err := u.ServiceUseCase.GetAbonementsServices(ctx, abonementIds, filter, expansion)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	abonementsTerms, err := u.AbonementAccessUseCase.GetAbonementsServicesTerms(ctx, abonementIds)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &serviceext.AbonementsServicesWithTermsResponse{}
+	for abonementId, services := range abonementsServices {
+		abonement := &serviceext.AbonementServicesWithTerms{AbonementId: abonementId.String()}
+
+		for _, service := range services {
+			serviceWithTerms := &serviceext.ServiceWithTerms{ServiceObject: toServiceObject(ctx, u.cloudUseCase, service)}
+			if terms, ok := linkTerms(abonementsTerms[abonementId], service); ok {
+				serviceWithTerms.Terms = toAbonementServiceTermsObject(terms)
+			}
+			for _, componentId := range service.ComponentIds {
+				serviceWithTerms.ComponentIds = append(serviceWithTerms.ComponentIds, componentId.String())
+			}
+			if service.BundleId != nil {
+				serviceWithTerms.BundleId = service.BundleId.String()
+			}
+			abonement.Services = append(abonement.Services, serviceWithTerms)
+		}
+
+		response.Abonements = append(response.Abonements, abonement)
+	}
+
+	return response, nil
+}
+
+func (u *AbonementAccessGRPC) CheckAccess(
+	ctx context.Context,
+	request *serviceext.CheckAccessRequest,
+) (*serviceext.AccessDecisionObject, error) {
+
+	v := validation.New()
+	abonementId := v.UUID("abonement_id", request.AbonementId)
+	serviceId := v.UUID("service_id", request.ServiceId)
+	at := v.Timestamp("at", request.At, time.Now())
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	decision, err := u.AbonementAccessUseCase.CheckAccess(ctx, abonementId, serviceId, at)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toAccessDecisionObject(decision), nil
+}
+
+func (u *AbonementAccessGRPC) RecordVisit(
+	ctx context.Context,
+	request *serviceext.RecordVisitRequest,
+) (*serviceext.VisitReceiptObject, error) {
+
+	v := validation.New()
+	cmd := &dtos.RecordVisitCommand{
+		AbonementId: v.UUID("abonement_id", request.AbonementId),
+		ServiceId:   v.UUID("service_id", request.ServiceId),
+		MemberId:    v.UUID("member_id", request.MemberId),
+		EventId:     v.EventId("event_id", request.EventId),
+		At:          v.Timestamp("at", request.At, time.Now()),
+		Guest:       request.Guest,
+	}
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	receipt, err := u.AbonementAccessUseCase.RecordVisit(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	object := &serviceext.VisitReceiptObject{
+		Visit:                toVisitObject(receipt.Visit),
+		Duplicate:            receipt.Duplicate,
+		RemainingGuestPasses: int32(receipt.RemainingGuestPasses),
+	}
+	if receipt.RemainingVisits != nil {
+		remainingVisits := int32(*receipt.RemainingVisits)
+		object.RemainingVisits = &remainingVisits
+	}
+
+	return object, nil
+}
+
+func (u *AbonementAccessGRPC) GetVisits(
+	ctx context.Context,
+	request *serviceext.GetVisitsRequest,
+) (*serviceext.VisitList, error) {
+
+	v := validation.New()
+	abonementId := v.UUID("abonement_id", request.AbonementId)
+	serviceId := v.OptionalUUID("service_id", request.ServiceId)
+	from := v.Timestamp("from", request.From, time.Time{})
+	to := v.Timestamp("to", request.To, time.Time{})
+	v.TimeRange("to", from, to, validation.MaxVisitsRange)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	visits, err := u.AbonementAccessUseCase.GetVisits(ctx, abonementId, serviceId, from, to)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	list := &serviceext.VisitList{}
+	for _, visit := range visits {
+		list.Visits = append(list.Visits, toVisitObject(visit))
+	}
+
+	return list, nil
+}
+
+// linkTerms finds the terms of the link the service is listed for, the one of
+// its bundle for flattened components.
+func linkTerms(terms map[uuid.UUID]*models.AbonementServiceTerms, service *models.Service) (*models.AbonementServiceTerms, bool) {
+	linkedId := service.Id
+	if service.BundleId != nil {
+		linkedId = *service.BundleId
+	}
+
+	linked, ok := terms[linkedId]
+	return linked, ok
+}
+
+func toAbonementServiceTermsObject(terms *models.AbonementServiceTerms) *serviceext.AbonementServiceTermsObject {
+	object := &serviceext.AbonementServiceTermsObject{
+		AbonementId: terms.AbonementId.String(),
+		ServiceId:   terms.ServiceId.String(),
+		QuotaPeriod: terms.QuotaPeriod,
+		GuestPasses: int32(terms.GuestPasses),
+		UpdatedTime: terms.UpdatedTime.String(),
+	}
+	if terms.VisitQuota != nil {
+		visitQuota := int32(*terms.VisitQuota)
+		object.VisitQuota = &visitQuota
+	}
+	for _, window := range terms.Windows {
+		object.Windows = append(object.Windows, toOpeningIntervalObject(window))
+	}
+
+	return object
+}
+
+func toAccessDecisionObject(decision *models.AccessDecision) *serviceext.AccessDecisionObject {
+	object := &serviceext.AccessDecisionObject{
+		Granted: decision.Granted,
+		Reason:  decision.Reason,
+		At:      decision.At.UTC().Format(time.RFC3339),
+	}
+	if decision.Terms != nil {
+		object.Terms = toAbonementServiceTermsObject(decision.Terms)
+		object.RemainingGuestPasses = int32(decision.RemainingGuestPasses)
+	}
+	if decision.RemainingVisits != nil {
+		remainingVisits := int32(*decision.RemainingVisits)
+		object.RemainingVisits = &remainingVisits
+	}
+
+	return object
+}
+
+func toVisitObject(visit *models.Visit) *serviceext.VisitObject {
+	return &serviceext.VisitObject{
+		Id:           visit.Id.String(),
+		EventId:      visit.EventId,
+		AbonementId:  visit.AbonementId.String(),
+		ServiceId:    visit.ServiceId.String(),
+		MemberId:     visit.MemberId.String(),
+		Guest:        visit.Guest,
+		VisitedTime:  visit.VisitedTime.UTC().Format(time.RFC3339),
+		RecordedTime: visit.RecordedTime.UTC().Format(time.RFC3339),
+	}
+}
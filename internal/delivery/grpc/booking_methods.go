@@ -0,0 +1,181 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	"google.golang.org/grpc"
+	"time"
+)
+
+type BookingGRPC struct {
+	serviceext.UnimplementedServiceBookingServer
+
+	BookingUseCase usecase.BookingUseCase
+}
+
+func RegisterBooking(gRPC *grpc.Server, bookingUseCase usecase.BookingUseCase) {
+	serviceext.RegisterServiceBookingServer(gRPC, &BookingGRPC{BookingUseCase: bookingUseCase})
+}
+
+func (u *BookingGRPC) GetBookingSettings(
+	ctx context.Context,
+	request *serviceext.GetBookingSettingsRequest,
+) (*serviceext.BookingSettingsObject, error) {
+
+	serviceId, err := validateId(request.ServiceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	settings, err := u.BookingUseCase.GetBookingSettings(ctx, serviceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toBookingSettingsObject(settings), nil
+}
+
+func (u *BookingGRPC) SetBookingSettings(
+	ctx context.Context,
+	request *serviceext.SetBookingSettingsRequest,
+) (*serviceext.BookingSettingsObject, error) {
+
+	v := validation.New()
+	cmd := &dtos.SetBookingSettingsCommand{
+		ServiceId:   v.UUID("service_id", request.ServiceId),
+		SlotMinutes: v.SlotMinutes("slot_minutes", int(request.SlotMinutes)),
+		Capacity:    v.SlotCapacity("capacity", int(request.Capacity)),
+	}
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	settings, err := u.BookingUseCase.SetBookingSettings(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toBookingSettingsObject(settings), nil
+}
+
+func (u *BookingGRPC) GetAvailability(
+	ctx context.Context,
+	request *serviceext.GetAvailabilityRequest,
+) (*serviceext.SlotList, error) {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	from := v.Timestamp("from", request.From, time.Time{})
+	to := v.Timestamp("to", request.To, time.Time{})
+	v.TimeRange("to", from, to, validation.MaxWindowsRange)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	slots, err := u.BookingUseCase.GetAvailability(ctx, serviceId, from, to)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	list := &serviceext.SlotList{}
+	for _, slot := range slots {
+		list.Slots = append(list.Slots, &serviceext.SlotObject{
+			Start:     slot.Start.Format(time.RFC3339),
+			End:       slot.End.Format(time.RFC3339),
+			Capacity:  int32(slot.Capacity),
+			Booked:    int32(slot.Booked),
+			Available: int32(slot.Available()),
+		})
+	}
+
+	return list, nil
+}
+
+func (u *BookingGRPC) BookSlot(
+	ctx context.Context,
+	request *serviceext.BookSlotRequest,
+) (*serviceext.BookingObject, error) {
+
+	v := validation.New()
+	cmd := &dtos.BookSlotCommand{
+		ServiceId: v.UUID("service_id", request.ServiceId),
+		MemberId:  v.UUID("member_id", request.MemberId),
+		SlotStart: v.Timestamp("slot_start", request.SlotStart, time.Time{}),
+	}
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	booking, err := u.BookingUseCase.BookSlot(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toBookingObject(booking), nil
+}
+
+func (u *BookingGRPC) CancelBooking(
+	ctx context.Context,
+	request *serviceext.CancelBookingRequest,
+) (*serviceext.BookingObject, error) {
+
+	bookingId, err := validateId(request.BookingId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	booking, err := u.BookingUseCase.CancelBooking(ctx, bookingId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toBookingObject(booking), nil
+}
+
+func (u *BookingGRPC) GetBooking(
+	ctx context.Context,
+	request *serviceext.GetBookingRequest,
+) (*serviceext.BookingObject, error) {
+
+	bookingId, err := validateId(request.BookingId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	booking, err := u.BookingUseCase.GetBooking(ctx, bookingId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toBookingObject(booking), nil
+}
+
+func toBookingSettingsObject(settings *models.BookingSettings) *serviceext.BookingSettingsObject {
+	return &serviceext.BookingSettingsObject{
+		ServiceId:   settings.ServiceId.String(),
+		SlotMinutes: int32(settings.SlotMinutes),
+		Capacity:    int32(settings.Capacity),
+		UpdatedTime: settings.UpdatedTime.String(),
+	}
+}
+
+func toBookingObject(booking *models.Booking) *serviceext.BookingObject {
+	object := &serviceext.BookingObject{
+		Id:          booking.Id.String(),
+		ServiceId:   booking.ServiceId.String(),
+		MemberId:    booking.MemberId.String(),
+		SlotStart:   booking.SlotStart.UTC().Format(time.RFC3339),
+		SlotEnd:     booking.SlotEnd.UTC().Format(time.RFC3339),
+		Status:      booking.Status,
+		CreatedTime: booking.CreatedTime.String(),
+	}
+	if booking.CancelledTime != nil {
+		object.CancelledTime = booking.CancelledTime.String()
+	}
+
+	return object
+}
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+type ServiceBundlesGRPC struct {
+	serviceext.UnimplementedServiceBundlesServer
+
+	ServiceUseCase usecase.ServiceUseCase
+	cloudUseCase   usecase.CloudUseCase
+}
+
+func RegisterServiceBundles(gRPC *grpc.Server, serviceUseCase usecase.ServiceUseCase, cloudUseCase usecase.CloudUseCase) {
+	serviceext.RegisterServiceBundlesServer(gRPC, &ServiceBundlesGRPC{ServiceUseCase: serviceUseCase, cloudUseCase: cloudUseCase})
+}
+
+func (u *ServiceBundlesGRPC) GetBundleComponents(
+	ctx context.Context,
+	request *serviceext.GetBundleComponentsRequest,
+) (*serviceext.BundleComponents, error) {
+
+	v := validation.New()
+	bundleId := v.UUID("bundle_id", request.BundleId)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	components, err := u.ServiceUseCase.GetBundleComponents(ctx, bundleId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return u.toBundleComponents(ctx, bundleId, components), nil
+}
+
+func (u *ServiceBundlesGRPC) SetBundleComponents(
+	ctx context.Context,
+	request *serviceext.SetBundleComponentsRequest,
+) (*serviceext.BundleComponents, error) {
+
+	v := validation.New()
+	cmd := &dtos.SetBundleComponentsCommand{
+		BundleId:     v.UUID("bundle_id", request.BundleId),
+		ComponentIds: v.UUIDs("component_ids", request.ComponentIds, validation.MaxLinkedServices, false),
+	}
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	components, err := u.ServiceUseCase.SetBundleComponents(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return u.toBundleComponents(ctx, cmd.BundleId, components), nil
+}
+
+func (u *ServiceBundlesGRPC) toBundleComponents(ctx context.Context, bundleId uuid.UUID, components []*models.Service) *serviceext.BundleComponents {
+	bundle := &serviceext.BundleComponents{BundleId: bundleId.String()}
+	for _, component := range components {
+		bundle.Components = append(bundle.Components, toServiceObject(ctx, u.cloudUseCase, component))
+	}
+
+	return bundle
+}
@@ -0,0 +1,278 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	serviceProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ServiceCatalogGRPC struct {
+	serviceext.UnimplementedServiceCatalogServer
+
+	CategoryUseCase usecase.CategoryUseCase
+	ServiceUseCase  usecase.ServiceUseCase
+	cloudUseCase    usecase.CloudUseCase
+}
+
+func RegisterServiceCatalog(
+	gRPC *grpc.Server,
+	categoryUseCase usecase.CategoryUseCase,
+	serviceUseCase usecase.ServiceUseCase,
+	cloudUseCase usecase.CloudUseCase,
+) {
+	serviceext.RegisterServiceCatalogServer(gRPC, &ServiceCatalogGRPC{
+		CategoryUseCase: categoryUseCase,
+		ServiceUseCase:  serviceUseCase,
+		cloudUseCase:    cloudUseCase,
+	})
+}
+
+func (u *ServiceCatalogGRPC) CreateCategory(
+	ctx context.Context,
+	request *serviceext.CreateCategoryRequest,
+) (*serviceext.CategoryObject, error) {
+
+	v := validation.New()
+	cmd := &dtos.CreateCategoryCommand{
+		Name:     v.CategoryName("name", request.Name),
+		ParentId: v.OptionalUUID("parent_id", request.ParentId),
+	}
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	category, err := u.CategoryUseCase.CreateCategory(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toCategoryObject(category), nil
+}
+
+func (u *ServiceCatalogGRPC) GetCategory(
+	ctx context.Context,
+	request *serviceext.GetCategoryRequest,
+) (*serviceext.CategoryObject, error) {
+
+	id, err := validateId(request.Id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	category, err := u.CategoryUseCase.GetCategoryById(ctx, id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toCategoryObject(category), nil
+}
+
+func (u *ServiceCatalogGRPC) GetCategories(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*serviceext.CategoryList, error) {
+
+	categories, err := u.CategoryUseCase.GetCategories(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	list := &serviceext.CategoryList{}
+	for _, category := range categories {
+		list.Categories = append(list.Categories, toCategoryObject(category))
+	}
+
+	return list, nil
+}
+
+func (u *ServiceCatalogGRPC) UpdateCategory(
+	ctx context.Context,
+	request *serviceext.UpdateCategoryRequest,
+) (*serviceext.CategoryObject, error) {
+
+	v := validation.New()
+	cmd := &dtos.UpdateCategoryCommand{
+		Id:       v.UUID("id", request.Id),
+		Name:     v.CategoryName("name", request.Name),
+		ParentId: v.OptionalUUID("parent_id", request.ParentId),
+	}
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	category, err := u.CategoryUseCase.UpdateCategory(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toCategoryObject(category), nil
+}
+
+func (u *ServiceCatalogGRPC) DeleteCategory(
+	ctx context.Context,
+	request *serviceext.DeleteCategoryRequest,
+) (*emptypb.Empty, error) {
+
+	id, err := validateId(request.Id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	err = u.CategoryUseCase.DeleteCategory(ctx, id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (u *ServiceCatalogGRPC) SetServiceCategory(
+	ctx context.Context,
+	request *serviceext.SetServiceCategoryRequest,
+) (*serviceext.ServiceClassification, error) {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	categoryId := v.OptionalUUID("category_id", request.CategoryId)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	classification, err := u.CategoryUseCase.SetServiceCategory(ctx, serviceId, categoryId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toServiceClassification(classification), nil
+}
+
+func (u *ServiceCatalogGRPC) SetServiceTags(
+	ctx context.Context,
+	request *serviceext.SetServiceTagsRequest,
+) (*serviceext.ServiceClassification, error) {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	tags := v.Tags("tags", request.Tags)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	classification, err := u.CategoryUseCase.SetServiceTags(ctx, serviceId, tags)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toServiceClassification(classification), nil
+}
+
+func (u *ServiceCatalogGRPC) ListServices(
+	ctx context.Context,
+	request *serviceext.ListServicesRequest,
+) (*serviceext.ListServicesResponse, error) {
+
+	v := validation.New()
+	filter := validateServiceFilter(v, request.Filter)
+	requestedLocale := v.Locale("locale", request.Locale, false)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	services, err := u.ServiceUseCase.GetServices(withRequestLocale(ctx, requestedLocale), filter)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &serviceext.ListServicesResponse{}
+	for _, service := range services {
+		classified := &serviceext.ClassifiedService{
+			ServiceObject: toServiceObject(ctx, u.cloudUseCase, service),
+			Tags:          service.Tags,
+		}
+		if service.CategoryId != nil {
+			classified.CategoryId = service.CategoryId.String()
+		}
+
+		response.Services = append(response.Services, classified)
+	}
+
+	return response, nil
+}
+
+func (u *ServiceCatalogGRPC) GetAbonementsServices(
+	ctx context.Context,
+	request *serviceext.FilteredAbonementsServicesRequest,
+) (*serviceProtobuf.GetAbonementsServicesResponse, error) {
+
+	v := validation.New()
+	abonementIds := v.UUIDs("abonement_ids", request.AbonementIds, validation.MaxBatchIds, true)
+	filter := validateServiceFilter(v, request.Filter)
+	expansion := v.BundleExpansion("bundles", request.Bundles)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	abonementsServices, err := u.ServiceUseCase.GetAbonementsServices(ctx, abonementIds, filter, expansion)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toAbonementsServicesResponse(ctx, u.cloudUseCase, abonementsServices), nil
+}
+
+func (u *ServiceCatalogGRPC) GetCoachesServices(
+	ctx context.Context,
+	request *serviceext.FilteredCoachesServicesRequest,
+) (*serviceProtobuf.GetCoachesServicesResponse, error) {
+
+	v := validation.New()
+	coachIds := v.UUIDs("coach_ids", request.CoachIds, validation.MaxBatchIds, true)
+	filter := validateServiceFilter(v, request.Filter)
+	expansion := v.BundleExpansion("bundles", request.Bundles)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	coachesServices, err := u.ServiceUseCase.GetCoachesServices(ctx, coachIds, filter, expansion)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toCoachesServicesResponse(ctx, u.cloudUseCase, coachesServices), nil
+}
+
+func validateServiceFilter(v *validation.Validator, filter *serviceext.ServiceFilter) *models.ServiceFilter {
+	return v.ServiceFilter("filter.category_id", filter.GetCategoryId(), "filter.tags", filter.GetTags())
+}
+
+func toCategoryObject(category *models.ServiceCategory) *serviceext.CategoryObject {
+	object := &serviceext.CategoryObject{
+		Id:          category.Id.String(),
+		Name:        category.Name,
+		CreatedTime: category.CreatedTime.String(),
+		UpdatedTime: category.UpdatedTime.String(),
+	}
+	if category.ParentId != nil {
+		object.ParentId = category.ParentId.String()
+	}
+
+	return object
+}
+
+func toServiceClassification(classification *models.ServiceClassification) *serviceext.ServiceClassification {
+	object := &serviceext.ServiceClassification{
+		ServiceId: classification.ServiceId.String(),
+		Tags:      classification.Tags,
+	}
+	if classification.CategoryId != nil {
+		object.CategoryId = classification.CategoryId.String()
+	}
+
+	return object
+}
@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	"google.golang.org/grpc"
+)
+
+type CoachServiceLinksGRPC struct {
+	serviceext.UnimplementedCoachServiceLinksServer
+
+	CoachServiceLinkUseCase usecase.CoachServiceLinkUseCase
+}
+
+func RegisterCoachServiceLinks(gRPC *grpc.Server, coachServiceLinkUseCase usecase.CoachServiceLinkUseCase) {
+	serviceext.RegisterCoachServiceLinksServer(gRPC, &CoachServiceLinksGRPC{CoachServiceLinkUseCase: coachServiceLinkUseCase})
+}
+
+func (u *CoachServiceLinksGRPC) CreateCoachServiceLink(
+	ctx context.Context,
+	request *serviceext.CreateCoachServiceLinkRequest,
+) (*serviceext.CoachServiceLinkObject, error) {
+
+	v := validation.New()
+	cmd := &dtos.CreateCoachServiceLinkCommand{
+		CoachId:             v.UUID("coach_id", request.CoachId),
+		ServiceId:           v.UUID("service_id", request.ServiceId),
+		QualificationLevel:  v.QualificationLevel("qualification_level", request.QualificationLevel),
+		CertificationExpiry: v.OptionalDate("certification_expiry", request.CertificationExpiry),
+		PriceOverride:       v.PriceOverride("price_override", request.PriceOverride),
+	}
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	link, err := u.CoachServiceLinkUseCase.CreateCoachServiceLink(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toCoachServiceLinkObject(link), nil
+}
+
+func (u *CoachServiceLinksGRPC) UpdateCoachServiceLink(
+	ctx context.Context,
+	request *serviceext.UpdateCoachServiceLinkRequest,
+) (*serviceext.CoachServiceLinkObject, error) {
+
+	v := validation.New()
+	cmd := &dtos.UpdateCoachServiceLinkCommand{
+		CoachId:             v.UUID("coach_id", request.CoachId),
+		ServiceId:           v.UUID("service_id", request.ServiceId),
+		QualificationLevel:  v.QualificationLevel("qualification_level", request.QualificationLevel),
+		CertificationExpiry: v.OptionalDate("certification_expiry", request.CertificationExpiry),
+		PriceOverride:       v.PriceOverride("price_override", request.PriceOverride),
+	}
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	link, err := u.CoachServiceLinkUseCase.UpdateCoachServiceLink(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toCoachServiceLinkObject(link), nil
+}
+
+func (u *CoachServiceLinksGRPC) GetCoachServiceLink(
+	ctx context.Context,
+	request *serviceext.GetCoachServiceLinkRequest,
+) (*serviceext.CoachServiceLinkObject, error) {
+
+	v := validation.New()
+	coachId := v.UUID("coach_id", request.CoachId)
+	serviceId := v.UUID("service_id", request.ServiceId)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	link, err := u.CoachServiceLinkUseCase.GetCoachServiceLink(ctx, coachId, serviceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toCoachServiceLinkObject(link), nil
+}
+
+func (u *CoachServiceLinksGRPC) GetCoachServiceLinks(
+	ctx context.Context,
+	request *serviceext.GetCoachServiceLinksRequest,
+) (*serviceext.CoachServiceLinkList, error) {
+
+	coachId, err := validateId(request.CoachId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	links, err := u.CoachServiceLinkUseCase.GetCoachServiceLinks(ctx, coachId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	list := &serviceext.CoachServiceLinkList{}
+	for _, link := range links {
+		list.Links = append(list.Links, toCoachServiceLinkObject(link))
+	}
+
+	return list, nil
+}
+
+func toCoachServiceLinkObject(link *models.CoachServiceLink) *serviceext.CoachServiceLinkObject {
+	object := &serviceext.CoachServiceLinkObject{
+		CoachId:              link.CoachId.String(),
+		ServiceId:            link.ServiceId.String(),
+		QualificationLevel:   link.QualificationLevel,
+		CertificationExpired: link.CertificationExpired,
+		PriceOverride:        link.PriceOverride,
+		UpdatedTime:          link.UpdatedTime.String(),
+	}
+	if link.CertificationExpiry != nil {
+		object.CertificationExpiry = link.CertificationExpiry.Format(models.DateLayout)
+	}
+
+	return object
+}
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/usecase"
+	"context"
+	serviceProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
+)
+
+type ServiceDeletionGRPC struct {
+	serviceext.UnimplementedServiceDeletionServer
+
+	ServiceUseCase usecase.ServiceUseCase
+	cloudUseCase   usecase.CloudUseCase
+}
+
+func (u *ServiceDeletionGRPC) DeleteService(
+	ctx context.Context,
+	request *serviceext.DeleteServiceRequest,
+) (*serviceext.DeleteServiceResponse, error) {
+
+	cmd, err := validateDeleteService(request.Id, request.Policy, request.ReplacementServiceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	service, err := u.ServiceUseCase.DeleteServiceById(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &serviceext.DeleteServiceResponse{ServiceObject: &serviceProtobuf.ServiceObject{
+		Id:          service.Id.String(),
+		Title:       service.Title,
+		Photo:       photoURL(ctx, u.cloudUseCase, service.Photo),
+		CreatedTime: service.CreatedTime.String(),
+		UpdatedTime: service.UpdatedTime.String(),
+	}}
+
+	return response, nil
+}
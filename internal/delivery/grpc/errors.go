@@ -0,0 +1,142 @@
+package grpc
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/pkg/logger"
+	"errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+const errorDomain = "service.fitness-center"
+
+type errorMapping struct {
+	err          error
+	code         codes.Code
+	reason       string
+	resourceType string
+}
+
+var errorMappings = []errorMapping{
+	{customErrors.InvalidArgument, codes.InvalidArgument, "INVALID_ARGUMENT", ""},
+	{customErrors.VoidServiceData, codes.InvalidArgument, "VOID_SERVICE_DATA", "service"},
+	{customErrors.ServiceNotFound, codes.NotFound, "SERVICE_NOT_FOUND", "service"},
+	{customErrors.ServiceTranslationNotFound, codes.NotFound, "SERVICE_TRANSLATION_NOT_FOUND", "service_translation"},
+	{customErrors.CoachNotFound, codes.NotFound, "COACH_NOT_FOUND", "coach"},
+	{customErrors.AbonementNotFound, codes.NotFound, "ABONEMENT_NOT_FOUND", "abonement"},
+	{customErrors.ServiceAlreadyExists, codes.AlreadyExists, "SERVICE_ALREADY_EXISTS", "service"},
+	{customErrors.ServiceLinkAlreadyExists, codes.AlreadyExists, "SERVICE_LINK_ALREADY_EXISTS", "service"},
+	{customErrors.ServiceInUse, codes.FailedPrecondition, "SERVICE_IN_USE", "service"},
+	{customErrors.InvalidReplacementService, codes.InvalidArgument, "INVALID_REPLACEMENT_SERVICE", "service"},
+	{customErrors.ServiceMediaNotFound, codes.NotFound, "SERVICE_MEDIA_NOT_FOUND", "service_media"},
+	{customErrors.ServiceMediaLimitReached, codes.FailedPrecondition, "SERVICE_MEDIA_LIMIT_REACHED", ""},
+	{customErrors.InvalidMediaOrder, codes.InvalidArgument, "INVALID_MEDIA_ORDER", ""},
+	{customErrors.ServicePhotoNotFound, codes.NotFound, "SERVICE_PHOTO_NOT_FOUND", "service"},
+	{customErrors.PhotoChanged, codes.FailedPrecondition, "PHOTO_CHANGED", ""},
+	{customErrors.InvalidPhotoRange, codes.OutOfRange, "INVALID_PHOTO_RANGE", ""},
+	{customErrors.PhotoUploadNotFound, codes.NotFound, "PHOTO_UPLOAD_NOT_FOUND", "photo_upload"},
+	{customErrors.PhotoUploadExpired, codes.FailedPrecondition, "PHOTO_UPLOAD_EXPIRED", "photo_upload"},
+	{customErrors.PhotoUploadIncomplete, codes.FailedPrecondition, "PHOTO_UPLOAD_INCOMPLETE", "photo_upload"},
+	{customErrors.PhotoUploadMismatch, codes.FailedPrecondition, "PHOTO_UPLOAD_MISMATCH", "photo_upload"},
+	{customErrors.CategoryNotFound, codes.NotFound, "CATEGORY_NOT_FOUND", "category"},
+	{customErrors.CategoryAlreadyExists, codes.AlreadyExists, "CATEGORY_ALREADY_EXISTS", "category"},
+	{customErrors.CategoryInUse, codes.FailedPrecondition, "CATEGORY_IN_USE", "category"},
+	{customErrors.InvalidCategoryParent, codes.InvalidArgument, "INVALID_CATEGORY_PARENT", "category"},
+	{customErrors.ServiceHoursNotFound, codes.NotFound, "SERVICE_HOURS_NOT_FOUND", "service"},
+	{customErrors.ServiceClosureNotFound, codes.NotFound, "SERVICE_CLOSURE_NOT_FOUND", "service_closure"},
+	{customErrors.ServiceNotBookable, codes.FailedPrecondition, "SERVICE_NOT_BOOKABLE", "service"},
+	{customErrors.SlotNotFound, codes.NotFound, "SLOT_NOT_FOUND", "slot"},
+	{customErrors.SlotInPast, codes.FailedPrecondition, "SLOT_IN_PAST", "slot"},
+	{customErrors.SlotFull, codes.ResourceExhausted, "SLOT_FULL", "slot"},
+	{customErrors.BookingNotFound, codes.NotFound, "BOOKING_NOT_FOUND", "booking"},
+	{customErrors.BookingAlreadyExists, codes.AlreadyExists, "BOOKING_ALREADY_EXISTS", "slot"},
+	{customErrors.CoachServiceLinkNotFound, codes.NotFound, "COACH_SERVICE_LINK_NOT_FOUND", "coach_service"},
+	{customErrors.AbonementServiceLinkNotFound, codes.NotFound, "ABONEMENT_SERVICE_LINK_NOT_FOUND", "abonement_service"},
+	{customErrors.AccessOutsideHours, codes.FailedPrecondition, "ACCESS_OUTSIDE_HOURS", "abonement_service"},
+	{customErrors.VisitQuotaUsedUp, codes.ResourceExhausted, "VISIT_QUOTA_USED_UP", "abonement_service"},
+	{customErrors.GuestPassesUsedUp, codes.ResourceExhausted, "GUEST_PASSES_USED_UP", "abonement_service"},
+	{customErrors.VisitEventConflict, codes.AlreadyExists, "VISIT_EVENT_CONFLICT", ""},
+	{customErrors.BundleCycle, codes.InvalidArgument, "BUNDLE_CYCLE", "service"},
+	{customErrors.ServiceRuleNotFound, codes.NotFound, "SERVICE_RULE_NOT_FOUND", "service_rule"},
+	{customErrors.ServiceRuleAlreadyExists, codes.AlreadyExists, "SERVICE_RULE_ALREADY_EXISTS", "service_rule"},
+	{customErrors.ServiceRuleConflict, codes.FailedPrecondition, "SERVICE_RULE_CONFLICT", "service_rule"},
+	{customErrors.ServiceRulesViolated, codes.FailedPrecondition, "SERVICE_RULES_VIOLATED", ""},
+	{customErrors.ReconciliationInProgress, codes.Aborted, "RECONCILIATION_IN_PROGRESS", ""},
+	{customErrors.InternalCoachServerError, codes.Unavailable, "COACH_SERVICE_UNAVAILABLE", "coach"},
+	{customErrors.InternalAbonementServerError, codes.Unavailable, "ABONEMENT_SERVICE_UNAVAILABLE", "abonement"},
+}
+
+// toStatus translates a use case error into a gRPC status error carrying
+// ErrorInfo, and BadRequest, PreconditionFailure or ResourceInfo details where
+// they apply.
+// Errors that already are statuses are passed through untouched.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	for _, mapping := range errorMappings {
+		if !errors.Is(err, mapping.err) {
+			continue
+		}
+
+		return withDetails(status.New(mapping.code, err.Error()), err, mapping)
+	}
+
+	logger.ErrorLogger.Printf("Unhandled error: %v", err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func withDetails(st *status.Status, err error, mapping errorMapping) error {
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{Reason: mapping.reason, Domain: errorDomain},
+	}
+
+	var validationErr *customErrors.ValidationError
+	if errors.As(err, &validationErr) {
+		badRequest := &errdetails.BadRequest{}
+		for _, violation := range validationErr.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	var preconditionErr *customErrors.PreconditionError
+	if errors.As(err, &preconditionErr) {
+		preconditionFailure := &errdetails.PreconditionFailure{}
+		for _, violation := range preconditionErr.Violations {
+			preconditionFailure.Violations = append(preconditionFailure.Violations, &errdetails.PreconditionFailure_Violation{
+				Type:        violation.Type,
+				Subject:     violation.Subject,
+				Description: violation.Description,
+			})
+		}
+		details = append(details, preconditionFailure)
+	}
+
+	var resourceErr *customErrors.ResourceError
+	if errors.As(err, &resourceErr) && mapping.resourceType != "" {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: mapping.resourceType,
+			ResourceName: resourceErr.ResourceName,
+			Description:  mapping.err.Error(),
+		})
+	}
+
+	detailed, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		logger.ErrorLogger.Printf("Failed to attach error details: %v", detailsErr)
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
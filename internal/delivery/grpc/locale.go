@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"Service/pkg/locale"
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const acceptLanguageHeader = "accept-language"
+
+// LocaleUnaryInterceptor puts the locales from the accept-language metadata
+// into the context so that the use case can localize what it returns.
+func LocaleUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if values := md.Get(acceptLanguageHeader); len(values) > 0 {
+			ctx = locale.NewContext(ctx, locale.ParseAcceptLanguage(values[0]))
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// withRequestLocale lets an explicit locale field of a request take precedence
+// over the accept-language metadata.
+func withRequestLocale(ctx context.Context, requested string) context.Context {
+	if requested == "" {
+		return ctx
+	}
+
+	return locale.NewContext(ctx, locale.ParseAcceptLanguage(requested))
+}
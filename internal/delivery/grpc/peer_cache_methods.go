@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type PeerCacheGRPC struct {
+	serviceext.UnimplementedPeerCacheServer
+
+	ServiceUseCase usecase.ServiceUseCase
+}
+
+func (u *PeerCacheGRPC) InvalidatePeerCache(
+	ctx context.Context,
+	request *serviceext.InvalidatePeerCacheRequest,
+) (*emptypb.Empty, error) {
+
+	v := validation.New()
+	coachIds := v.UUIDs("coach_ids", request.CoachIds, validation.MaxBatchIds, true)
+	abonementIds := v.UUIDs("abonement_ids", request.AbonementIds, validation.MaxBatchIds, true)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	u.ServiceUseCase.InvalidatePeerCache(ctx, coachIds, abonementIds, request.All)
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,19 @@
+package grpc
+
+import (
+	"Service/internal/usecase"
+	"Service/pkg/logger"
+	"context"
+)
+
+// photoURL renders the url of a photo for a response. A photo that cannot be
+// rendered is left out rather than failing the whole request.
+func photoURL(ctx context.Context, cloudUseCase usecase.CloudUseCase, key string) string {
+	url, err := cloudUseCase.PhotoURL(ctx, key)
+	if err != nil {
+		logger.ErrorLogger.Printf("Failed to render url of photo %s: %v", key, err)
+		return ""
+	}
+
+	return url
+}
@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"Service/pkg/logger"
+	"errors"
+	"google.golang.org/grpc"
+	"io"
+)
+
+const (
+	photoChunkSize       = 64 << 10
+	originalPhotoVariant = "original"
+)
+
+type PhotoDownloadGRPC struct {
+	serviceext.UnimplementedPhotoDownloadServer
+
+	ServiceUseCase usecase.ServiceUseCase
+	cloudUseCase   usecase.CloudUseCase
+}
+
+func (u *PhotoDownloadGRPC) DownloadPhoto(
+	request *serviceext.DownloadPhotoRequest,
+	stream grpc.ServerStreamingServer[serviceext.DownloadPhotoResponse],
+) error {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	if request.Variant != "" && request.Variant != originalPhotoVariant {
+		v.Violation("variant", "must be empty or original")
+	}
+	if request.Offset < 0 {
+		v.Violation("offset", "must not be negative")
+	}
+	if err := v.Err(); err != nil {
+		return toStatus(err)
+	}
+
+	ctx := stream.Context()
+
+	service, err := u.ServiceUseCase.GetServiceById(ctx, serviceId)
+	if err != nil {
+		return toStatus(err)
+	}
+
+	if service.Photo == "" {
+		return toStatus(customErrors.NewResourceError(customErrors.ServicePhotoNotFound, serviceId.String()))
+	}
+
+	object, err := u.cloudUseCase.OpenObject(ctx, service.Photo, models.ObjectReadOptions{
+		Offset:      request.Offset,
+		IfMatch:     request.IfMatch,
+		IfNoneMatch: request.IfNoneMatch,
+	})
+	if err != nil {
+		if errors.Is(err, customErrors.ServicePhotoNotFound) {
+			err = customErrors.NewResourceError(err, serviceId.String())
+		}
+		return toStatus(err)
+	}
+	if object.Body != nil {
+		defer object.Body.Close()
+	}
+
+	err = stream.Send(&serviceext.DownloadPhotoResponse{Metadata: &serviceext.PhotoMetadata{
+		ContentType: object.ContentType,
+		Size:        object.Size,
+		Etag:        object.ETag,
+		Offset:      object.Offset,
+		NotModified: object.NotModified,
+	}})
+	if err != nil || object.NotModified {
+		return err
+	}
+
+	buffer := make([]byte, photoChunkSize)
+	for {
+		n, readErr := io.ReadFull(object.Body, buffer)
+		if n > 0 {
+			if err := stream.Send(&serviceext.DownloadPhotoResponse{Chunk: buffer[:n]}); err != nil {
+				return err
+			}
+		}
+
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if readErr != nil {
+			logger.ErrorLogger.Printf("Failed to read photo %s: %v", service.Photo, readErr)
+			return toStatus(readErr)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/dtos"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	serviceProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
+	"google.golang.org/grpc"
+)
+
+type PhotoUploadGRPC struct {
+	serviceext.UnimplementedPhotoUploadServer
+
+	PhotoUploadUseCase usecase.PhotoUploadUseCase
+	cloudUseCase       usecase.CloudUseCase
+}
+
+func RegisterPhotoUpload(gRPC *grpc.Server, photoUploadUseCase usecase.PhotoUploadUseCase, cloudUseCase usecase.CloudUseCase) {
+	serviceext.RegisterPhotoUploadServer(gRPC, &PhotoUploadGRPC{PhotoUploadUseCase: photoUploadUseCase, cloudUseCase: cloudUseCase})
+}
+
+func (u *PhotoUploadGRPC) CreatePhotoUpload(
+	ctx context.Context,
+	request *serviceext.CreatePhotoUploadRequest,
+) (*serviceext.CreatePhotoUploadResponse, error) {
+
+	v := validation.New()
+	cmd := &dtos.CreatePhotoUploadCommand{
+		ServiceId:   v.UUID("service_id", request.ServiceId),
+		ContentType: v.PhotoContentType("content_type", request.ContentType),
+		Size:        request.Size,
+	}
+	v.PhotoSize("size", request.Size)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	upload, err := u.PhotoUploadUseCase.CreatePhotoUpload(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &serviceext.CreatePhotoUploadResponse{
+		UploadToken: upload.Token.String(),
+		UploadUrl:   upload.UploadURL,
+		ExpiresTime: upload.ExpiresTime.String(),
+	}
+
+	return response, nil
+}
+
+func (u *PhotoUploadGRPC) ConfirmPhotoUpload(
+	ctx context.Context,
+	request *serviceext.ConfirmPhotoUploadRequest,
+) (*serviceext.ConfirmPhotoUploadResponse, error) {
+
+	v := validation.New()
+	cmd := &dtos.ConfirmPhotoUploadCommand{
+		ServiceId: v.UUID("service_id", request.ServiceId),
+		Token:     v.UUID("upload_token", request.UploadToken),
+	}
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	service, err := u.PhotoUploadUseCase.ConfirmPhotoUpload(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &serviceext.ConfirmPhotoUploadResponse{ServiceObject: &serviceProtobuf.ServiceObject{
+		Id:          service.Id.String(),
+		Title:       service.Title,
+		Photo:       photoURL(ctx, u.cloudUseCase, service.Photo),
+		CreatedTime: service.CreatedTime.String(),
+		UpdatedTime: service.UpdatedTime.String(),
+	}}
+
+	return response, nil
+}
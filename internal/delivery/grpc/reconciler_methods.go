@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	"google.golang.org/grpc"
+)
+
+const maxReconcileBatchSize = 1000
+
+type ReconcilerGRPC struct {
+	serviceext.UnimplementedReconcilerServer
+
+	ReconcileUseCase usecase.ReconcileUseCase
+}
+
+func RegisterReconciler(gRPC *grpc.Server, reconcileUseCase usecase.ReconcileUseCase) {
+	serviceext.RegisterReconcilerServer(gRPC, &ReconcilerGRPC{ReconcileUseCase: reconcileUseCase})
+}
+
+func (u *ReconcilerGRPC) ReconcileLinks(
+	ctx context.Context,
+	request *serviceext.ReconcileLinksRequest,
+) (*serviceext.ReconcileLinksResponse, error) {
+
+	v := validation.New()
+	if request.BatchSize < 0 || request.BatchSize > maxReconcileBatchSize {
+		v.Violation("batch_size", "must be between 0 and 1000")
+	}
+	if request.CallsPerSecond < 0 {
+		v.Violation("calls_per_second", "must not be negative")
+	}
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	report, err := u.ReconcileUseCase.ReconcileLinks(ctx, &models.ReconcileOptions{
+		DryRun:         !request.Apply,
+		BatchSize:      int(request.BatchSize),
+		CallsPerSecond: int(request.CallsPerSecond),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &serviceext.ReconcileLinksResponse{
+		DryRun:              report.DryRun,
+		StartedTime:         report.StartedTime.String(),
+		DurationMs:          report.Duration.Milliseconds(),
+		CoachIdsChecked:     int32(report.CoachIdsChecked),
+		AbonementIdsChecked: int32(report.AbonementIdsChecked),
+		Unverified:          int32(report.Unverified),
+		RemovedLinks:        report.RemovedLinks,
+	}
+
+	for _, id := range report.OrphanCoachIds {
+		response.OrphanCoachIds = append(response.OrphanCoachIds, id.String())
+	}
+	for _, id := range report.OrphanAbonementIds {
+		response.OrphanAbonementIds = append(response.OrphanAbonementIds, id.String())
+	}
+
+	return response, nil
+}
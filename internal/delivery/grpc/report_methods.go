@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"bufio"
+	"context"
+	"google.golang.org/grpc"
+	"time"
+)
+
+const reportChunkSize = 32 << 10
+
+type ServiceReportsGRPC struct {
+	serviceext.UnimplementedServiceReportsServer
+
+	ReportUseCase usecase.ReportUseCase
+}
+
+func RegisterServiceReports(gRPC *grpc.Server, reportUseCase usecase.ReportUseCase) {
+	serviceext.RegisterServiceReportsServer(gRPC, &ServiceReportsGRPC{ReportUseCase: reportUseCase})
+}
+
+func (u *ServiceReportsGRPC) GetServiceUsage(
+	ctx context.Context,
+	request *serviceext.ReportRequest,
+) (*serviceext.ServiceUsageList, error) {
+
+	v := validation.New()
+	query := validateReportRequest(v, request)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	usage, err := u.ReportUseCase.GetServiceUsage(ctx, query)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toServiceUsageList(usage), nil
+}
+
+func (u *ServiceReportsGRPC) GetCoverageGaps(
+	ctx context.Context,
+	request *serviceext.ReportRequest,
+) (*serviceext.ServiceUsageList, error) {
+
+	v := validation.New()
+	query := validateReportRequest(v, request)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	usage, err := u.ReportUseCase.GetCoverageGaps(ctx, query)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toServiceUsageList(usage), nil
+}
+
+func (u *ServiceReportsGRPC) GetVisitTrend(
+	ctx context.Context,
+	request *serviceext.ReportRequest,
+) (*serviceext.VisitTrend, error) {
+
+	v := validation.New()
+	query := validateReportRequest(v, request)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	points, err := u.ReportUseCase.GetVisitTrend(ctx, query)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	trend := &serviceext.VisitTrend{}
+	for _, point := range points {
+		trend.Points = append(trend.Points, &serviceext.TrendPointObject{
+			PeriodStart: point.PeriodStart.Format(time.RFC3339),
+			Visits:      int32(point.Visits),
+			GuestVisits: int32(point.GuestVisits),
+			Members:     int32(point.Members),
+		})
+	}
+
+	return trend, nil
+}
+
+func (u *ServiceReportsGRPC) ExportReport(
+	request *serviceext.ExportReportRequest,
+	stream grpc.ServerStreamingServer[serviceext.CsvChunk],
+) error {
+
+	v := validation.New()
+	report := v.ReportName("report", request.Report)
+	query := validateReportRequest(v, request.GetRequest())
+	if err := v.Err(); err != nil {
+		return toStatus(err)
+	}
+
+	writer := bufio.NewWriterSize(&csvChunkWriter{stream: stream}, reportChunkSize)
+
+	if err := u.ReportUseCase.ExportReport(stream.Context(), report, query, writer); err != nil {
+		return toStatus(err)
+	}
+
+	return toStatus(writer.Flush())
+}
+
+// csvChunkWriter sends every write as one chunk of the export stream.
+type csvChunkWriter struct {
+	stream grpc.ServerStreamingServer[serviceext.CsvChunk]
+}
+
+func (w *csvChunkWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&serviceext.CsvChunk{Chunk: p}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func validateReportRequest(v *validation.Validator, request *serviceext.ReportRequest) *models.ReportQuery {
+	if request == nil {
+		v.Violation("request", "must be set")
+		return nil
+	}
+
+	query := &models.ReportQuery{
+		From:        v.Timestamp("from", request.From, time.Time{}),
+		To:          v.Timestamp("to", request.To, time.Time{}),
+		ServiceId:   v.OptionalUUID("service_id", request.ServiceId),
+		Granularity: v.TrendGranularity("granularity", request.Granularity),
+		TimeZone:    "UTC",
+	}
+	v.TimeRange("to", query.From, query.To, validation.MaxReportRange)
+	if request.TimeZone != "" {
+		query.TimeZone = v.TimeZone("time_zone", request.TimeZone)
+	}
+
+	return query
+}
+
+func toServiceUsageList(usage []*models.ServiceUsage) *serviceext.ServiceUsageList {
+	list := &serviceext.ServiceUsageList{}
+	for _, service := range usage {
+		list.Services = append(list.Services, &serviceext.ServiceUsageObject{
+			ServiceId:   service.ServiceId.String(),
+			Title:       service.Title,
+			Coaches:     int32(service.Coaches),
+			Abonements:  int32(service.Abonements),
+			Visits:      int32(service.Visits),
+			GuestVisits: int32(service.GuestVisits),
+			Members:     int32(service.Members),
+		})
+	}
+
+	return list
+}
@@ -0,0 +1,233 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
+)
+
+type ServiceHoursGRPC struct {
+	serviceext.UnimplementedServiceHoursServer
+
+	ServiceHoursUseCase usecase.ServiceHoursUseCase
+}
+
+func RegisterServiceHours(gRPC *grpc.Server, serviceHoursUseCase usecase.ServiceHoursUseCase) {
+	serviceext.RegisterServiceHoursServer(gRPC, &ServiceHoursGRPC{ServiceHoursUseCase: serviceHoursUseCase})
+}
+
+func (u *ServiceHoursGRPC) GetServiceHours(
+	ctx context.Context,
+	request *serviceext.GetServiceHoursRequest,
+) (*serviceext.ServiceHoursObject, error) {
+
+	serviceId, err := validateId(request.ServiceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	hours, err := u.ServiceHoursUseCase.GetServiceHours(ctx, serviceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toServiceHoursObject(hours), nil
+}
+
+func (u *ServiceHoursGRPC) SetServiceHours(
+	ctx context.Context,
+	request *serviceext.SetServiceHoursRequest,
+) (*serviceext.ServiceHoursObject, error) {
+
+	v := validation.New()
+	cmd := &dtos.SetServiceHoursCommand{
+		ServiceId: v.UUID("service_id", request.ServiceId),
+		TimeZone:  v.TimeZone("time_zone", request.TimeZone),
+	}
+	for i, interval := range request.Intervals {
+		cmd.Intervals = append(cmd.Intervals,
+			v.OpeningInterval(fmt.Sprintf("intervals[%d]", i), interval.GetWeekday(), interval.GetOpens(), interval.GetCloses()))
+	}
+	v.OpeningIntervals("intervals", cmd.Intervals)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	hours, err := u.ServiceHoursUseCase.SetServiceHours(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toServiceHoursObject(hours), nil
+}
+
+func (u *ServiceHoursGRPC) AddServiceClosure(
+	ctx context.Context,
+	request *serviceext.AddServiceClosureRequest,
+) (*serviceext.ServiceClosureObject, error) {
+
+	v := validation.New()
+	cmd := &dtos.AddServiceClosureCommand{
+		ServiceId: v.UUID("service_id", request.ServiceId),
+		StartDate: v.Date("start_date", request.StartDate),
+		EndDate:   v.Date("end_date", request.EndDate),
+		Kind:      v.ClosureKind("kind", request.Kind),
+		Reason:    v.ClosureReason("reason", request.Reason),
+	}
+	v.DateRange("end_date", cmd.StartDate, cmd.EndDate, validation.MaxClosureDays)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	closure, err := u.ServiceHoursUseCase.AddServiceClosure(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toServiceClosureObject(closure), nil
+}
+
+func (u *ServiceHoursGRPC) RemoveServiceClosure(
+	ctx context.Context,
+	request *serviceext.RemoveServiceClosureRequest,
+) (*emptypb.Empty, error) {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	closureId := v.UUID("closure_id", request.ClosureId)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	err := u.ServiceHoursUseCase.RemoveServiceClosure(ctx, serviceId, closureId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (u *ServiceHoursGRPC) GetServiceClosures(
+	ctx context.Context,
+	request *serviceext.GetServiceClosuresRequest,
+) (*serviceext.ServiceClosureList, error) {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	from, to := v.ClosureDates("from_date", request.FromDate, "to_date", request.ToDate)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	closures, err := u.ServiceHoursUseCase.GetServiceClosures(ctx, serviceId, from, to)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	list := &serviceext.ServiceClosureList{}
+	for _, closure := range closures {
+		list.Closures = append(list.Closures, toServiceClosureObject(closure))
+	}
+
+	return list, nil
+}
+
+func (u *ServiceHoursGRPC) IsServiceOpen(
+	ctx context.Context,
+	request *serviceext.IsServiceOpenRequest,
+) (*serviceext.IsServiceOpenResponse, error) {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	at := v.Timestamp("at", request.At, time.Now())
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	openStatus, err := u.ServiceHoursUseCase.GetOpenStatus(ctx, serviceId, at)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &serviceext.IsServiceOpenResponse{Open: openStatus.Open}
+	if openStatus.Window != nil {
+		response.Window = toOpeningWindow(openStatus.Window)
+	}
+
+	return response, nil
+}
+
+func (u *ServiceHoursGRPC) GetOpeningWindows(
+	ctx context.Context,
+	request *serviceext.GetOpeningWindowsRequest,
+) (*serviceext.OpeningWindowList, error) {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	from := v.Timestamp("from", request.From, time.Time{})
+	to := v.Timestamp("to", request.To, time.Time{})
+	v.TimeRange("to", from, to, validation.MaxWindowsRange)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	windows, err := u.ServiceHoursUseCase.GetOpeningWindows(ctx, serviceId, from, to)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	list := &serviceext.OpeningWindowList{}
+	for _, window := range windows {
+		list.Windows = append(list.Windows, toOpeningWindow(window))
+	}
+
+	return list, nil
+}
+
+func toServiceHoursObject(hours *models.ServiceHours) *serviceext.ServiceHoursObject {
+	object := &serviceext.ServiceHoursObject{
+		ServiceId:   hours.ServiceId.String(),
+		TimeZone:    hours.TimeZone,
+		UpdatedTime: hours.UpdatedTime.String(),
+	}
+
+	for _, interval := range hours.Intervals {
+		object.Intervals = append(object.Intervals, toOpeningIntervalObject(interval))
+	}
+
+	return object
+}
+
+func toOpeningIntervalObject(interval models.OpeningInterval) *serviceext.OpeningIntervalObject {
+	return &serviceext.OpeningIntervalObject{
+		Weekday: models.WeekdayName(interval.Weekday),
+		Opens:   models.Clock(interval.OpensMinute),
+		Closes:  models.Clock(interval.ClosesMinute),
+	}
+}
+
+func toServiceClosureObject(closure *models.ServiceClosure) *serviceext.ServiceClosureObject {
+	return &serviceext.ServiceClosureObject{
+		Id:          closure.Id.String(),
+		ServiceId:   closure.ServiceId.String(),
+		StartDate:   closure.StartDate.Format(models.DateLayout),
+		EndDate:     closure.EndDate.Format(models.DateLayout),
+		Kind:        closure.Kind,
+		Reason:      closure.Reason,
+		CreatedTime: closure.CreatedTime.String(),
+	}
+}
+
+func toOpeningWindow(window *models.OpeningWindow) *serviceext.OpeningWindow {
+	return &serviceext.OpeningWindow{
+		Start: window.Start.Format(time.RFC3339),
+		End:   window.End.Format(time.RFC3339),
+	}
+}
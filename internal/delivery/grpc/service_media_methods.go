@@ -0,0 +1,168 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"net/http"
+)
+
+type ServiceMediaGRPC struct {
+	serviceext.UnimplementedServiceMediaServer
+
+	ServiceMediaUseCase usecase.ServiceMediaUseCase
+	cloudUseCase        usecase.CloudUseCase
+}
+
+func RegisterServiceMedia(gRPC *grpc.Server, serviceMediaUseCase usecase.ServiceMediaUseCase, cloudUseCase usecase.CloudUseCase) {
+	serviceext.RegisterServiceMediaServer(gRPC, &ServiceMediaGRPC{ServiceMediaUseCase: serviceMediaUseCase, cloudUseCase: cloudUseCase})
+}
+
+func (u *ServiceMediaGRPC) GetServiceMedia(
+	ctx context.Context,
+	request *serviceext.GetServiceMediaRequest,
+) (*serviceext.ServiceMediaList, error) {
+
+	serviceId, err := validateId(request.ServiceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	media, err := u.ServiceMediaUseCase.GetServiceMedia(ctx, serviceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return u.toServiceMediaList(ctx, media), nil
+}
+
+func (u *ServiceMediaGRPC) AddServiceMedia(
+	g grpc.ClientStreamingServer[serviceext.AddServiceMediaRequest, serviceext.ServiceMediaObject],
+) error {
+
+	mediaData, content, err := GetObjectData(
+		&g,
+		"content",
+		func(chunk *serviceext.AddServiceMediaRequest) (interface{}, bool) {
+			mediaData := chunk.GetMediaData()
+			return mediaData, mediaData != nil
+		},
+		func(chunk *serviceext.AddServiceMediaRequest) []byte {
+			return chunk.GetContent()
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	data, _ := mediaData.(*serviceext.ServiceMediaData)
+
+	v := validation.New()
+	cmd := &dtos.AddServiceMediaCommand{
+		ServiceId: v.UUID("media_data.service_id", data.GetServiceId()),
+		MediaType: v.MediaType("media_data.media_type", data.GetMediaType()),
+		AltText:   v.AltText("media_data.alt_text", data.GetAltText()),
+		Cover:     data.GetCover(),
+		Content:   content,
+	}
+	v.PhotoSize("content", int64(len(content)))
+	cmd.ContentType = v.PhotoContentType("content", http.DetectContentType(content))
+	if err := v.Err(); err != nil {
+		return toStatus(err)
+	}
+
+	media, err := u.ServiceMediaUseCase.AddServiceMedia(g.Context(), cmd)
+	if err != nil {
+		return toStatus(err)
+	}
+
+	return g.SendAndClose(u.toServiceMediaObject(g.Context(), media))
+}
+
+func (u *ServiceMediaGRPC) RemoveServiceMedia(
+	ctx context.Context,
+	request *serviceext.RemoveServiceMediaRequest,
+) (*emptypb.Empty, error) {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	mediaId := v.UUID("media_id", request.MediaId)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	err := u.ServiceMediaUseCase.RemoveServiceMedia(ctx, serviceId, mediaId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (u *ServiceMediaGRPC) ReorderServiceMedia(
+	ctx context.Context,
+	request *serviceext.ReorderServiceMediaRequest,
+) (*serviceext.ServiceMediaList, error) {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	mediaIds := v.UUIDs("media_ids", request.MediaIds, validation.MaxServiceMedia, false)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	media, err := u.ServiceMediaUseCase.ReorderServiceMedia(ctx, serviceId, mediaIds)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return u.toServiceMediaList(ctx, media), nil
+}
+
+func (u *ServiceMediaGRPC) SetServiceMediaCover(
+	ctx context.Context,
+	request *serviceext.SetServiceMediaCoverRequest,
+) (*serviceext.ServiceMediaList, error) {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	mediaId := v.UUID("media_id", request.MediaId)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	media, err := u.ServiceMediaUseCase.SetServiceMediaCover(ctx, serviceId, mediaId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return u.toServiceMediaList(ctx, media), nil
+}
+
+func (u *ServiceMediaGRPC) toServiceMediaList(ctx context.Context, media []*models.ServiceMedia) *serviceext.ServiceMediaList {
+	list := &serviceext.ServiceMediaList{}
+	for _, item := range media {
+		list.Media = append(list.Media, u.toServiceMediaObject(ctx, item))
+	}
+
+	return list
+}
+
+func (u *ServiceMediaGRPC) toServiceMediaObject(ctx context.Context, media *models.ServiceMedia) *serviceext.ServiceMediaObject {
+	return &serviceext.ServiceMediaObject{
+		Id:          media.Id.String(),
+		ServiceId:   media.ServiceId.String(),
+		Url:         photoURL(ctx, u.cloudUseCase, media.ObjectKey),
+		MediaType:   media.MediaType,
+		ContentType: media.ContentType,
+		AltText:     media.AltText,
+		Position:    int32(media.Position),
+		Cover:       media.Cover,
+		CreatedTime: media.CreatedTime.String(),
+	}
+}
@@ -0,0 +1,127 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ServiceRulesGRPC struct {
+	serviceext.UnimplementedServiceRulesServer
+
+	ServiceRuleUseCase usecase.ServiceRuleUseCase
+}
+
+func RegisterServiceRules(gRPC *grpc.Server, serviceRuleUseCase usecase.ServiceRuleUseCase) {
+	serviceext.RegisterServiceRulesServer(gRPC, &ServiceRulesGRPC{ServiceRuleUseCase: serviceRuleUseCase})
+}
+
+func (u *ServiceRulesGRPC) CreateServiceRule(
+	ctx context.Context,
+	request *serviceext.CreateServiceRuleRequest,
+) (*serviceext.ServiceRuleObject, error) {
+
+	v := validation.New()
+	cmd := &dtos.CreateServiceRuleCommand{
+		Kind:           v.ServiceRuleKind("kind", request.Kind),
+		ServiceId:      v.UUID("service_id", request.ServiceId),
+		OtherServiceId: v.UUID("other_service_id", request.OtherServiceId),
+		Description:    v.RuleDescription("description", request.Description),
+	}
+	if cmd.ServiceId != uuid.Nil && cmd.ServiceId == cmd.OtherServiceId {
+		v.Violation("other_service_id", "must differ from service_id")
+	}
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	rule, err := u.ServiceRuleUseCase.CreateServiceRule(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toServiceRuleObject(rule), nil
+}
+
+func (u *ServiceRulesGRPC) DeleteServiceRule(
+	ctx context.Context,
+	request *serviceext.DeleteServiceRuleRequest,
+) (*emptypb.Empty, error) {
+
+	id, err := validateId(request.Id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	err = u.ServiceRuleUseCase.DeleteServiceRule(ctx, id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (u *ServiceRulesGRPC) GetServiceRules(
+	ctx context.Context,
+	request *serviceext.GetServiceRulesRequest,
+) (*serviceext.ServiceRuleList, error) {
+
+	v := validation.New()
+	serviceId := v.OptionalUUID("service_id", request.ServiceId)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	rules, err := u.ServiceRuleUseCase.GetServiceRules(ctx, serviceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	list := &serviceext.ServiceRuleList{}
+	for _, rule := range rules {
+		list.Rules = append(list.Rules, toServiceRuleObject(rule))
+	}
+
+	return list, nil
+}
+
+func (u *ServiceRulesGRPC) CheckServiceSet(
+	ctx context.Context,
+	request *serviceext.CheckServiceSetRequest,
+) (*serviceext.ServiceSetCheckObject, error) {
+
+	v := validation.New()
+	servicesIds := v.UUIDs("service_ids", request.ServiceIds, validation.MaxLinkedServices, false)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	check, err := u.ServiceRuleUseCase.CheckServiceSet(ctx, servicesIds)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	object := &serviceext.ServiceSetCheckObject{Valid: check.Valid()}
+	for _, rule := range check.Violations {
+		object.Violations = append(object.Violations, toServiceRuleObject(rule))
+	}
+
+	return object, nil
+}
+
+func toServiceRuleObject(rule *models.ServiceRule) *serviceext.ServiceRuleObject {
+	return &serviceext.ServiceRuleObject{
+		Id:             rule.Id.String(),
+		Kind:           rule.Kind,
+		ServiceId:      rule.ServiceId.String(),
+		OtherServiceId: rule.OtherServiceId.String(),
+		Description:    rule.Description,
+		CreatedTime:    rule.CreatedTime.String(),
+	}
+}
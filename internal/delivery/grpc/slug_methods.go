@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	serviceProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
+)
+
+type ServiceSlugGRPC struct {
+	serviceext.UnimplementedServiceSlugServer
+
+	ServiceUseCase usecase.ServiceUseCase
+	cloudUseCase   usecase.CloudUseCase
+}
+
+func (u *ServiceSlugGRPC) GetServiceBySlug(
+	ctx context.Context,
+	request *serviceext.GetServiceBySlugRequest,
+) (*serviceext.GetServiceBySlugResponse, error) {
+
+	v := validation.New()
+	slug := v.Slug("slug", request.Slug)
+	requestedLocale := v.Locale("locale", request.Locale, false)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	ctx = withRequestLocale(ctx, requestedLocale)
+
+	service, redirected, err := u.ServiceUseCase.GetServiceBySlug(ctx, slug)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	serviceObject := &serviceProtobuf.ServiceObject{
+		Id:          service.Id.String(),
+		Title:       service.Title,
+		Photo:       photoURL(ctx, u.cloudUseCase, service.Photo),
+		CreatedTime: service.CreatedTime.String(),
+		UpdatedTime: service.UpdatedTime.String(),
+	}
+
+	response := &serviceext.GetServiceBySlugResponse{
+		ServiceObject: serviceObject,
+		Slug:          service.Slug,
+		Redirected:    redirected,
+	}
+
+	return response, nil
+}
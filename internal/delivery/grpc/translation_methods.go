@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"Service/gen/serviceext"
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"context"
+	serviceProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ServiceTranslationGRPC struct {
+	serviceext.UnimplementedServiceTranslationServer
+
+	ServiceUseCase usecase.ServiceUseCase
+	cloudUseCase   usecase.CloudUseCase
+}
+
+func (u *ServiceTranslationGRPC) GetLocalizedService(
+	ctx context.Context,
+	request *serviceext.GetLocalizedServiceRequest,
+) (*serviceext.GetLocalizedServiceResponse, error) {
+
+	v := validation.New()
+	id := v.UUID("id", request.Id)
+	requestedLocale := v.Locale("locale", request.Locale, false)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	service, err := u.ServiceUseCase.GetServiceById(withRequestLocale(ctx, requestedLocale), id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	serviceObject := &serviceProtobuf.ServiceObject{
+		Id:          service.Id.String(),
+		Title:       service.Title,
+		Photo:       photoURL(ctx, u.cloudUseCase, service.Photo),
+		CreatedTime: service.CreatedTime.String(),
+		UpdatedTime: service.UpdatedTime.String(),
+	}
+
+	response := &serviceext.GetLocalizedServiceResponse{
+		ServiceObject: serviceObject,
+		Description:   service.Description,
+		Locale:        service.Locale,
+	}
+
+	return response, nil
+}
+
+func (u *ServiceTranslationGRPC) GetServiceTranslations(
+	ctx context.Context,
+	request *serviceext.GetServiceTranslationsRequest,
+) (*serviceext.GetServiceTranslationsResponse, error) {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	translations, err := u.ServiceUseCase.GetServiceTranslations(ctx, serviceId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &serviceext.GetServiceTranslationsResponse{}
+	for _, translation := range translations {
+		response.Translations = append(response.Translations, toServiceTranslationObject(translation))
+	}
+
+	return response, nil
+}
+
+func (u *ServiceTranslationGRPC) UpsertServiceTranslation(
+	ctx context.Context,
+	request *serviceext.UpsertServiceTranslationRequest,
+) (*serviceext.UpsertServiceTranslationResponse, error) {
+
+	v := validation.New()
+	cmd := &dtos.UpsertServiceTranslationCommand{
+		ServiceId:   v.UUID("service_id", request.ServiceId),
+		Locale:      v.Locale("locale", request.Locale, true),
+		Title:       v.Title("title", request.Title, true),
+		Description: v.Description("description", request.Description),
+	}
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	translation, err := u.ServiceUseCase.UpsertServiceTranslation(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &serviceext.UpsertServiceTranslationResponse{
+		Translation: toServiceTranslationObject(translation),
+	}
+
+	return response, nil
+}
+
+func (u *ServiceTranslationGRPC) DeleteServiceTranslation(
+	ctx context.Context,
+	request *serviceext.DeleteServiceTranslationRequest,
+) (*emptypb.Empty, error) {
+
+	v := validation.New()
+	serviceId := v.UUID("service_id", request.ServiceId)
+	serviceLocale := v.Locale("locale", request.Locale, true)
+	if err := v.Err(); err != nil {
+		return nil, toStatus(err)
+	}
+
+	err := u.ServiceUseCase.DeleteServiceTranslation(ctx, serviceId, serviceLocale)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toServiceTranslationObject(translation *models.ServiceTranslation) *serviceext.ServiceTranslationObject {
+	return &serviceext.ServiceTranslationObject{
+		ServiceId:   translation.ServiceId.String(),
+		Locale:      translation.Locale,
+		Title:       translation.Title,
+		Description: translation.Description,
+		CreatedTime: translation.CreatedTime.String(),
+		UpdatedTime: translation.UpdatedTime.String(),
+	}
+}
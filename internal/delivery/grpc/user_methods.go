@@ -1,12 +1,13 @@
 package grpc
 
 import (
+	"Service/gen/serviceext"
 	"Service/internal/dtos"
-	customErrors "Service/internal/errors"
+	"Service/internal/models"
 	"Service/internal/usecase"
+	"Service/internal/validation"
 	"Service/pkg/logger"
 	"context"
-	"errors"
 	serviceProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
@@ -26,6 +27,11 @@ type ServicegRPC struct {
 
 func Register(gRPC *grpc.Server, ServiceUseCase usecase.ServiceUseCase, cloudUseCase usecase.CloudUseCase) {
 	serviceProtobuf.RegisterServiceServer(gRPC, &ServicegRPC{ServiceUseCase: ServiceUseCase, cloudUseCase: cloudUseCase})
+	serviceext.RegisterServiceSlugServer(gRPC, &ServiceSlugGRPC{ServiceUseCase: ServiceUseCase, cloudUseCase: cloudUseCase})
+	serviceext.RegisterServiceTranslationServer(gRPC, &ServiceTranslationGRPC{ServiceUseCase: ServiceUseCase, cloudUseCase: cloudUseCase})
+	serviceext.RegisterPeerCacheServer(gRPC, &PeerCacheGRPC{ServiceUseCase: ServiceUseCase})
+	serviceext.RegisterServiceDeletionServer(gRPC, &ServiceDeletionGRPC{ServiceUseCase: ServiceUseCase, cloudUseCase: cloudUseCase})
+	serviceext.RegisterPhotoDownloadServer(gRPC, &PhotoDownloadGRPC{ServiceUseCase: ServiceUseCase, cloudUseCase: cloudUseCase})
 }
 
 func (u *ServicegRPC) CreateService(
@@ -36,15 +42,17 @@ func (u *ServicegRPC) CreateService(
 
 	serviceData, servicePhoto, err := GetObjectData(
 		&g,
-		func(chunk *serviceProtobuf.CreateServiceRequest) interface{} {
-			return chunk.GetServiceDataForCreate()
+		"service_photo",
+		func(chunk *serviceProtobuf.CreateServiceRequest) (interface{}, bool) {
+			serviceData := chunk.GetServiceDataForCreate()
+			return serviceData, serviceData != nil
 		},
 		func(chunk *serviceProtobuf.CreateServiceRequest) []byte {
 			return chunk.GetServicePhoto()
 		},
 	)
 	if err != nil {
-		return status.Error(codes.InvalidArgument, "invalid request data")
+		return err
 	}
 
 	if serviceData == nil {
@@ -58,33 +66,26 @@ func (u *ServicegRPC) CreateService(
 		return status.Error(codes.InvalidArgument, "service data is not of type ServiceProtobuf.ServiceDataForCreate")
 	}
 
-	cmd := &dtos.CreateServiceCommand{
-		Id:    uuid.New(),
-		Title: castedServiceData.Title,
-		Photo: "",
+	title, err := validateServiceDataForCreate(castedServiceData, servicePhoto)
+	if err != nil {
+		return toStatus(err)
 	}
 
-	var photoURL string
-	if servicePhoto != nil {
-		url, err := u.cloudUseCase.PutObject(context.TODO(), servicePhoto, "service/"+cmd.Id.String())
-		photoURL = url
-		if err != nil {
-			logger.ErrorLogger.Printf("Failed to create service photo in cloud: %v", err)
-			return status.Error(codes.Internal, "Failed to create service photo in cloud")
-		}
+	cmd := &dtos.CreateServiceCommand{
+		Id:           uuid.New(),
+		Title:        title,
+		PhotoContent: servicePhoto,
 	}
 
-	cmd.Photo = photoURL
-
 	service, err := u.ServiceUseCase.CreateService(context.TODO(), cmd)
 	if err != nil {
-		return err
+		return toStatus(err)
 	}
 
 	serviceObject := &serviceProtobuf.ServiceObject{
 		Id:    service.Id.String(),
 		Title: service.Title,
-		Photo: service.Photo,
+		Photo: photoURL(g.Context(), u.cloudUseCase, service.Photo),
 	}
 
 	response := &serviceProtobuf.CreateServiceResponse{
@@ -104,20 +105,20 @@ func (u *ServicegRPC) GetServiceById(ctx context.Context,
 	request *serviceProtobuf.GetServiceByIdRequest,
 ) (*serviceProtobuf.GetServiceByIdResponse, error) {
 
-	service, err := u.ServiceUseCase.GetServiceById(ctx, uuid.MustParse(request.Id))
+	id, err := validateId(request.Id)
 	if err != nil {
+		return nil, toStatus(err)
+	}
 
-		if errors.Is(err, customErrors.ServiceNotFound) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
-
-		return nil, err
+	service, err := u.ServiceUseCase.GetServiceById(ctx, id)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
 	serviceObject := &serviceProtobuf.ServiceObject{
 		Id:          service.Id.String(),
 		Title:       service.Title,
-		Photo:       service.Photo,
+		Photo:       photoURL(ctx, u.cloudUseCase, service.Photo),
 		CreatedTime: service.CreatedTime.String(),
 		UpdatedTime: service.UpdatedTime.String(),
 	}
@@ -135,15 +136,17 @@ func (u *ServicegRPC) UpdateService(
 
 	serviceData, servicePhoto, err := GetObjectData(
 		&g,
-		func(chunk *serviceProtobuf.UpdateServiceRequest) interface{} {
-			return chunk.GetServiceDataForUpdate()
+		"service_photo",
+		func(chunk *serviceProtobuf.UpdateServiceRequest) (interface{}, bool) {
+			serviceData := chunk.GetServiceDataForUpdate()
+			return serviceData, serviceData != nil
 		},
 		func(chunk *serviceProtobuf.UpdateServiceRequest) []byte {
 			return chunk.GetServicePhoto()
 		},
 	)
 	if err != nil {
-		return status.Error(codes.InvalidArgument, "invalid request data")
+		return err
 	}
 
 	if serviceData == nil {
@@ -157,46 +160,27 @@ func (u *ServicegRPC) UpdateService(
 		return status.Error(codes.InvalidArgument, "service data is not of type ServiceProtobuf.ServiceDataForUpdate")
 	}
 
-	cmd := &dtos.UpdateServiceCommand{
-		Id:          uuid.MustParse(castedServiceData.Id),
-		Title:       castedServiceData.Title,
-		UpdatedTime: time.Now(),
+	id, title, err := validateServiceDataForUpdate(castedServiceData, servicePhoto)
+	if err != nil {
+		return toStatus(err)
 	}
 
-	var photoURL string
-	var previousPhoto []byte
-	if servicePhoto != nil {
-		previousPhoto, err = u.cloudUseCase.GetObjectByName(context.TODO(), "service/"+castedServiceData.Id)
-		if err != nil {
-			logger.ErrorLogger.Printf("Failed to get previos photo from cloud: %v", err)
-			return err
-		}
-
-		url, err := u.cloudUseCase.PutObject(context.TODO(), servicePhoto, "service/"+castedServiceData.Id)
-		photoURL = url
-		if err != nil {
-			logger.ErrorLogger.Printf("Failed to create service photo in cloud: %v", err)
-			return status.Error(codes.Internal, "Failed to create service photo in cloud")
-		}
+	cmd := &dtos.UpdateServiceCommand{
+		Id:           id,
+		Title:        title,
+		PhotoContent: servicePhoto,
+		UpdatedTime:  time.Now(),
 	}
 
-	cmd.Photo = photoURL
-
 	service, err := u.ServiceUseCase.UpdateService(context.TODO(), cmd)
 	if err != nil {
-		_, err := u.cloudUseCase.PutObject(context.TODO(), previousPhoto, "service/"+castedServiceData.Id)
-		if err != nil {
-			logger.ErrorLogger.Printf("Failed to set previous photo in cloud: %v", err)
-			return status.Error(codes.Internal, "Failed to create service photo in cloud")
-		}
-
-		return status.Error(codes.Internal, "Failed to create service")
+		return toStatus(err)
 	}
 
 	serviceObject := &serviceProtobuf.ServiceObject{
 		Id:          service.Id.String(),
 		Title:       service.Title,
-		Photo:       service.Photo,
+		Photo:       photoURL(g.Context(), u.cloudUseCase, service.Photo),
 		CreatedTime: service.CreatedTime.String(),
 		UpdatedTime: service.UpdatedTime.String(),
 	}
@@ -219,15 +203,20 @@ func (u *ServicegRPC) DeleteServiceById(
 	request *serviceProtobuf.DeleteServiceByIdRequest,
 ) (*serviceProtobuf.DeleteServiceByIdResponse, error) {
 
-	service, err := u.ServiceUseCase.DeleteServiceById(ctx, uuid.MustParse(request.Id))
+	cmd, err := validateDeleteService(request.Id, "", "")
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
+	}
+
+	service, err := u.ServiceUseCase.DeleteServiceById(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
 	response := &serviceProtobuf.DeleteServiceByIdResponse{ServiceObject: &serviceProtobuf.ServiceObject{
 		Id:          service.Id.String(),
 		Title:       service.Title,
-		Photo:       service.Photo,
+		Photo:       photoURL(ctx, u.cloudUseCase, service.Photo),
 		CreatedTime: service.CreatedTime.String(),
 		UpdatedTime: service.UpdatedTime.String(),
 	}}
@@ -240,9 +229,9 @@ func (u *ServicegRPC) GetServices(
 	_ *emptypb.Empty,
 ) (*serviceProtobuf.GetServicesResponse, error) {
 
-	services, err := u.ServiceUseCase.GetServices(ctx)
+	services, err := u.ServiceUseCase.GetServices(ctx, nil)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	var serviceObjects []*serviceProtobuf.ServiceObject
@@ -251,7 +240,7 @@ func (u *ServicegRPC) GetServices(
 		serviceObjects = append(serviceObjects, &serviceProtobuf.ServiceObject{
 			Id:          service.Id.String(),
 			Title:       service.Title,
-			Photo:       service.Photo,
+			Photo:       photoURL(ctx, u.cloudUseCase, service.Photo),
 			CreatedTime: service.CreatedTime.String(),
 			UpdatedTime: service.UpdatedTime.String(),
 		})
@@ -267,19 +256,19 @@ func (u *ServicegRPC) CreateCoachServices(
 	request *serviceProtobuf.CreateCoachServicesRequest,
 ) (*serviceProtobuf.CreateCoachServicesResponse, error) {
 
-	var servicesIds []uuid.UUID
-	for _, serviceId := range request.CoachService.ServiceId {
-		servicesIds = append(servicesIds, uuid.MustParse(serviceId))
+	coachId, servicesIds, err := validateCoachService(request.CoachService, true)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
 	cmd := &dtos.CreateCoachServicesCommand{
-		CoachId:     uuid.MustParse(request.CoachService.CoachId),
+		CoachId:     coachId,
 		ServicesIds: servicesIds,
 	}
 
 	coachServices, err := u.ServiceUseCase.CreateCoachServices(ctx, cmd)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	var coachServicesIds []string
@@ -304,19 +293,19 @@ func (u *ServicegRPC) CreateAbonementServices(
 	request *serviceProtobuf.CreateAbonementServicesRequest,
 ) (*serviceProtobuf.CreateAbonementServicesResponse, error) {
 
-	var servicesIds []uuid.UUID
-	for _, serviceId := range request.AbonementService.ServiceId {
-		servicesIds = append(servicesIds, uuid.MustParse(serviceId))
+	abonementId, servicesIds, err := validateAbonementService(request.AbonementService, true)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
 	cmd := &dtos.CreateAbonementServicesCommand{
-		AbonementId: uuid.MustParse(request.AbonementService.AbonementId),
+		AbonementId: abonementId,
 		ServicesIds: servicesIds,
 	}
 
 	abonementServices, err := u.ServiceUseCase.CreateAbonemntServices(ctx, cmd)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	var abonementServicesIds []string
@@ -341,46 +330,17 @@ func (u *ServicegRPC) GetAbonementsServices(
 	request *serviceProtobuf.GetAbonementsServicesRequest,
 ) (*serviceProtobuf.GetAbonementsServicesResponse, error) {
 
-	var abonementIdsUUID []uuid.UUID
-	for _, id := range request.AbonementIds {
-		abonementIdsUUID = append(abonementIdsUUID, uuid.MustParse(id))
-	}
-
-	abonementIdWithServicesResponse, err := u.ServiceUseCase.GetAbonementsServices(ctx, abonementIdsUUID)
+	abonementIdsUUID, err := validateOwnerIds("abonement_ids", request.AbonementIds)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
-	getAbonementsServicesResponse := &serviceProtobuf.GetAbonementsServicesResponse{}
-
-	for ai, aiws := range abonementIdWithServicesResponse {
-
-		abonementIdWithServices := &serviceProtobuf.AbonementIdWithServices{
-			AbonementId:    ai.String(),
-			ServiceObjects: nil,
-		}
-
-		for _, service := range aiws {
-
-			serviceObject := &serviceProtobuf.ServiceObject{
-				Id:          service.Id.String(),
-				Title:       service.Title,
-				Photo:       service.Photo,
-				CreatedTime: service.CreatedTime.String(),
-				UpdatedTime: service.UpdatedTime.String(),
-			}
-
-			abonementIdWithServices.ServiceObjects = append(abonementIdWithServices.ServiceObjects, serviceObject)
-		}
-
-		getAbonementsServicesResponse.AbonementIdsWithServices =
-			append(
-				getAbonementsServicesResponse.AbonementIdsWithServices,
-				abonementIdWithServices,
-			)
+	abonementIdWithServicesResponse, err := u.ServiceUseCase.GetAbonementsServices(ctx, abonementIdsUUID, nil, models.BundleExpansionBundle)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
-	return getAbonementsServicesResponse, nil
+	return toAbonementsServicesResponse(ctx, u.cloudUseCase, abonementIdWithServicesResponse), nil
 }
 
 func (u *ServicegRPC) GetCoachesServices(
@@ -388,45 +348,17 @@ func (u *ServicegRPC) GetCoachesServices(
 	request *serviceProtobuf.GetCoachesServicesRequest,
 ) (*serviceProtobuf.GetCoachesServicesResponse, error) {
 
-	var coachIdsUUID []uuid.UUID
-	for _, id := range request.CoachIds {
-		coachIdsUUID = append(coachIdsUUID, uuid.MustParse(id))
-	}
-
-	coachIdWithServicesResponse, err := u.ServiceUseCase.GetCoachesServices(ctx, coachIdsUUID)
+	coachIdsUUID, err := validateOwnerIds("coach_ids", request.CoachIds)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
-	getCoachesServicesResponse := &serviceProtobuf.GetCoachesServicesResponse{}
-	for ai, aiws := range coachIdWithServicesResponse {
-
-		coachIdWithServices := &serviceProtobuf.CoachIdWithServices{
-			CoachId:        ai.String(),
-			ServiceObjects: nil,
-		}
-
-		for _, service := range aiws {
-
-			serviceObject := &serviceProtobuf.ServiceObject{
-				Id:          service.Id.String(),
-				Title:       service.Title,
-				Photo:       service.Photo,
-				CreatedTime: service.CreatedTime.String(),
-				UpdatedTime: service.UpdatedTime.String(),
-			}
-
-			coachIdWithServices.ServiceObjects = append(coachIdWithServices.ServiceObjects, serviceObject)
-		}
-
-		getCoachesServicesResponse.CoachIdsWithServices =
-			append(
-				getCoachesServicesResponse.CoachIdsWithServices,
-				coachIdWithServices,
-			)
+	coachIdWithServicesResponse, err := u.ServiceUseCase.GetCoachesServices(ctx, coachIdsUUID, nil, models.BundleExpansionBundle)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
-	return getCoachesServicesResponse, nil
+	return toCoachesServicesResponse(ctx, u.cloudUseCase, coachIdWithServicesResponse), nil
 }
 
 func (u *ServicegRPC) UpdateAbonementServices(
@@ -434,14 +366,14 @@ func (u *ServicegRPC) UpdateAbonementServices(
 	request *serviceProtobuf.UpdateAbonementServicesRequest,
 ) (*serviceProtobuf.UpdateAbonementServicesResponse, error) {
 
-	var servicesIds []uuid.UUID
-	for _, serviceId := range request.AbonementService.ServiceId {
-		servicesIds = append(servicesIds, uuid.MustParse(serviceId))
+	abonementId, servicesIds, err := validateAbonementService(request.AbonementService, false)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
-	abonementServices, err := u.ServiceUseCase.UpdateAbonementServices(ctx, uuid.MustParse(request.AbonementService.AbonementId), servicesIds)
+	abonementServices, err := u.ServiceUseCase.UpdateAbonementServices(ctx, abonementId, servicesIds)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	var abonementServicesIds []string
@@ -465,14 +397,14 @@ func (u *ServicegRPC) UpdateCoachServices(
 	ctx context.Context,
 	request *serviceProtobuf.UpdateCoachServicesRequest,
 ) (*serviceProtobuf.UpdateCoachServicesResponse, error) {
-	var servicesIds []uuid.UUID
-	for _, serviceId := range request.CoachService.ServiceId {
-		servicesIds = append(servicesIds, uuid.MustParse(serviceId))
+	coachId, servicesIds, err := validateCoachService(request.CoachService, false)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
-	coachServices, err := u.ServiceUseCase.UpdateCoachServices(ctx, uuid.MustParse(request.CoachService.CoachId), servicesIds)
+	coachServices, err := u.ServiceUseCase.UpdateCoachServices(ctx, coachId, servicesIds)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	var coachServicesIds []string
@@ -492,9 +424,82 @@ func (u *ServicegRPC) UpdateCoachServices(
 	return updateCoachServicesResponse, nil
 }
 
+func toAbonementsServicesResponse(
+	ctx context.Context,
+	cloudUseCase usecase.CloudUseCase,
+	abonementIdWithServicesResponse map[uuid.UUID][]*models.Service,
+) *serviceProtobuf.GetAbonementsServicesResponse {
+
+	getAbonementsServicesResponse := &serviceProtobuf.GetAbonementsServicesResponse{}
+
+	for ai, aiws := range abonementIdWithServicesResponse {
+
+		abonementIdWithServices := &serviceProtobuf.AbonementIdWithServices{
+			AbonementId:    ai.String(),
+			ServiceObjects: nil,
+		}
+
+		for _, service := range aiws {
+			abonementIdWithServices.ServiceObjects = append(abonementIdWithServices.ServiceObjects, toServiceObject(ctx, cloudUseCase, service))
+		}
+
+		getAbonementsServicesResponse.AbonementIdsWithServices =
+			append(
+				getAbonementsServicesResponse.AbonementIdsWithServices,
+				abonementIdWithServices,
+			)
+	}
+
+	return getAbonementsServicesResponse
+}
+
+func toCoachesServicesResponse(
+	ctx context.Context,
+	cloudUseCase usecase.CloudUseCase,
+	coachIdWithServicesResponse map[uuid.UUID][]*models.Service,
+) *serviceProtobuf.GetCoachesServicesResponse {
+
+	getCoachesServicesResponse := &serviceProtobuf.GetCoachesServicesResponse{}
+	for ai, aiws := range coachIdWithServicesResponse {
+
+		coachIdWithServices := &serviceProtobuf.CoachIdWithServices{
+			CoachId:        ai.String(),
+			ServiceObjects: nil,
+		}
+
+		for _, service := range aiws {
+			coachIdWithServices.ServiceObjects = append(coachIdWithServices.ServiceObjects, toServiceObject(ctx, cloudUseCase, service))
+		}
+
+		getCoachesServicesResponse.CoachIdsWithServices =
+			append(
+				getCoachesServicesResponse.CoachIdsWithServices,
+				coachIdWithServices,
+			)
+	}
+
+	return getCoachesServicesResponse
+}
+
+func toServiceObject(ctx context.Context, cloudUseCase usecase.CloudUseCase, service *models.Service) *serviceProtobuf.ServiceObject {
+	return &serviceProtobuf.ServiceObject{
+		Id:          service.Id.String(),
+		Title:       service.Title,
+		Photo:       photoURL(ctx, cloudUseCase, service.Photo),
+		CreatedTime: service.CreatedTime.String(),
+		UpdatedTime: service.UpdatedTime.String(),
+	}
+}
+
+// GetObjectData collects the data message and the concatenated photo bytes of
+// a client stream. extractObjectData reports whether the chunk carries the
+// data message, a typed nil pointer boxed in interface{} is never nil.
+// Reading stops as soon as the photo outgrows validation.MaxPhotoSize, the
+// violation is reported on photoField. Errors are already gRPC statuses.
 func GetObjectData[T any, R any](
 	g *grpc.ClientStreamingServer[T, R],
-	extractObjectData func(chunk *T) interface{},
+	photoField string,
+	extractObjectData func(chunk *T) (interface{}, bool),
 	extractObjectPhoto func(chunk *T) []byte,
 ) (interface{},
 	[]byte,
@@ -510,14 +515,19 @@ func GetObjectData[T any, R any](
 		}
 		if err != nil {
 			logger.ErrorLogger.Printf("Error getting chunk: %v", err)
-			return nil, nil, err
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid request data")
 		}
 
-		if ud := extractObjectData(chunk); ud != nil {
+		if ud, ok := extractObjectData(chunk); ok {
 			objectData = ud
 		}
 
 		if uf := extractObjectPhoto(chunk); uf != nil {
+			if len(objectPhoto)+len(uf) > validation.MaxPhotoSize {
+				v := validation.New()
+				v.PhotoSize(photoField, int64(len(objectPhoto)+len(uf)))
+				return nil, nil, toStatus(v.Err())
+			}
 			objectPhoto = append(objectPhoto, uf...)
 		}
 	}
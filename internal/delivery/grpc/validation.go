@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/validation"
+	serviceProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+func validateServiceDataForCreate(data *serviceProtobuf.ServiceDataForCreate, photo []byte) (string, error) {
+	v := validation.New()
+
+	title := v.Title("service_data_for_create.title", data.GetTitle(), true)
+	validateServicePhoto(v, photo)
+
+	return title, v.Err()
+}
+
+func validateServiceDataForUpdate(data *serviceProtobuf.ServiceDataForUpdate, photo []byte) (uuid.UUID, string, error) {
+	v := validation.New()
+
+	id := v.UUID("service_data_for_update.id", data.GetId())
+	title := v.Title("service_data_for_update.title", data.GetTitle(), false)
+	validateServicePhoto(v, photo)
+
+	return id, title, v.Err()
+}
+
+// validateServicePhoto checks a streamed photo, when one was sent, by its
+// size and the type detected from its bytes.
+func validateServicePhoto(v *validation.Validator, photo []byte) {
+	if photo == nil {
+		return
+	}
+
+	v.PhotoSize("service_photo", int64(len(photo)))
+	v.PhotoContentType("service_photo", http.DetectContentType(photo))
+}
+
+func validateId(id string) (uuid.UUID, error) {
+	v := validation.New()
+
+	parsed := v.UUID("id", id)
+
+	return parsed, v.Err()
+}
+
+func validateDeleteService(id, policy, replacementServiceId string) (*dtos.DeleteServiceCommand, error) {
+	v := validation.New()
+
+	cmd := &dtos.DeleteServiceCommand{
+		Id:     v.UUID("id", id),
+		Policy: v.DeletePolicy("policy", policy),
+	}
+
+	if cmd.Policy == models.DeletePolicyReassign {
+		cmd.ReplacementServiceId = v.UUID("replacement_service_id", replacementServiceId)
+	} else if replacementServiceId != "" {
+		v.Violation("replacement_service_id", "is only allowed with the reassign policy")
+	}
+
+	return cmd, v.Err()
+}
+
+func validateCoachService(coachService *serviceProtobuf.CoachService, requireServices bool) (uuid.UUID, []uuid.UUID, error) {
+	v := validation.New()
+
+	if coachService == nil {
+		v.Violation("coach_service", "must be set")
+		return uuid.Nil, nil, v.Err()
+	}
+
+	coachId := v.UUID("coach_service.coach_id", coachService.CoachId)
+	if requireServices {
+		v.NotEmpty("coach_service.service_id", len(coachService.ServiceId))
+	}
+	servicesIds := v.UUIDs("coach_service.service_id", coachService.ServiceId, validation.MaxLinkedServices, false)
+
+	return coachId, servicesIds, v.Err()
+}
+
+func validateAbonementService(abonementService *serviceProtobuf.AbonementService, requireServices bool) (uuid.UUID, []uuid.UUID, error) {
+	v := validation.New()
+
+	if abonementService == nil {
+		v.Violation("abonement_service", "must be set")
+		return uuid.Nil, nil, v.Err()
+	}
+
+	abonementId := v.UUID("abonement_service.abonement_id", abonementService.AbonementId)
+	if requireServices {
+		v.NotEmpty("abonement_service.service_id", len(abonementService.ServiceId))
+	}
+	servicesIds := v.UUIDs("abonement_service.service_id", abonementService.ServiceId, validation.MaxLinkedServices, false)
+
+	return abonementId, servicesIds, v.Err()
+}
+
+func validateOwnerIds(field string, ids []string) ([]uuid.UUID, error) {
+	v := validation.New()
+
+	parsed := v.UUIDs(field, ids, validation.MaxBatchIds, true)
+
+	return parsed, v.Err()
+}
@@ -0,0 +1,265 @@
+package http
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type AbonementAccessHTTP struct {
+	AbonementAccessUseCase usecase.AbonementAccessUseCase
+}
+
+type abonementServiceTermsObject struct {
+	AbonementId string                   `json:"abonementId"`
+	ServiceId   string                   `json:"serviceId"`
+	VisitQuota  *int                     `json:"visitQuota,omitempty"`
+	QuotaPeriod string                   `json:"quotaPeriod"`
+	GuestPasses int                      `json:"guestPasses"`
+	Windows     []*openingIntervalObject `json:"windows"`
+	UpdatedTime string                   `json:"updatedTime"`
+}
+
+type abonementServiceTermsRequest struct {
+	VisitQuota  *int                     `json:"visitQuota"`
+	QuotaPeriod string                   `json:"quotaPeriod"`
+	GuestPasses int                      `json:"guestPasses"`
+	Windows     []*openingIntervalObject `json:"windows"`
+}
+
+type accessDecisionObject struct {
+	Granted              bool                         `json:"granted"`
+	Reason               string                       `json:"reason,omitempty"`
+	At                   string                       `json:"at"`
+	Terms                *abonementServiceTermsObject `json:"terms,omitempty"`
+	RemainingVisits      *int                         `json:"remainingVisits,omitempty"`
+	RemainingGuestPasses *int                         `json:"remainingGuestPasses,omitempty"`
+}
+
+type recordVisitRequest struct {
+	MemberId string `json:"memberId"`
+	EventId  string `json:"eventId"`
+	At       string `json:"at"`
+	Guest    bool   `json:"guest"`
+}
+
+type visitObject struct {
+	Id           string `json:"id"`
+	EventId      string `json:"eventId"`
+	AbonementId  string `json:"abonementId"`
+	ServiceId    string `json:"serviceId"`
+	MemberId     string `json:"memberId"`
+	Guest        bool   `json:"guest"`
+	VisitedTime  string `json:"visitedTime"`
+	RecordedTime string `json:"recordedTime"`
+}
+
+type visitReceiptObject struct {
+	Visit                *visitObject `json:"visit"`
+	Duplicate            bool         `json:"duplicate"`
+	RemainingVisits      *int         `json:"remainingVisits,omitempty"`
+	RemainingGuestPasses int          `json:"remainingGuestPasses"`
+}
+
+func RegisterAbonementAccess(mux *http.ServeMux, abonementAccessUseCase usecase.AbonementAccessUseCase) {
+	h := &AbonementAccessHTTP{AbonementAccessUseCase: abonementAccessUseCase}
+
+	mux.HandleFunc("GET /v1/abonements/{abonementId}/services/{serviceId}/terms", h.GetAbonementServiceTerms)
+	mux.HandleFunc("PUT /v1/abonements/{abonementId}/services/{serviceId}/terms", h.SetAbonementServiceTerms)
+	mux.HandleFunc("GET /v1/abonements/{abonementId}/services/{serviceId}/access", h.CheckAccess)
+	mux.HandleFunc("POST /v1/abonements/{abonementId}/services/{serviceId}/visits", h.RecordVisit)
+	mux.HandleFunc("GET /v1/abonements/{abonementId}/visits", h.GetVisits)
+}
+
+func (h *AbonementAccessHTTP) GetAbonementServiceTerms(w http.ResponseWriter, r *http.Request) {
+	v := validation.New()
+	abonementId := v.UUID("abonementId", r.PathValue("abonementId"))
+	serviceId := v.UUID("serviceId", r.PathValue("serviceId"))
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	terms, err := h.AbonementAccessUseCase.GetAbonementServiceTerms(r.Context(), abonementId, serviceId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toAbonementServiceTermsObject(terms))
+}
+
+func (h *AbonementAccessHTTP) SetAbonementServiceTerms(w http.ResponseWriter, r *http.Request) {
+	var request abonementServiceTermsRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.SetAbonementServiceTermsCommand{
+		AbonementId: v.UUID("abonementId", r.PathValue("abonementId")),
+		ServiceId:   v.UUID("serviceId", r.PathValue("serviceId")),
+		VisitQuota:  v.VisitQuota("visitQuota", request.VisitQuota),
+		QuotaPeriod: v.QuotaPeriod("quotaPeriod", request.QuotaPeriod),
+		GuestPasses: v.GuestPasses("guestPasses", request.GuestPasses),
+	}
+	for i, window := range request.Windows {
+		if window == nil {
+			v.Violation(fmt.Sprintf("windows[%d]", i), "must not be null")
+			continue
+		}
+		cmd.Windows = append(cmd.Windows,
+			v.OpeningInterval(fmt.Sprintf("windows[%d]", i), window.Weekday, window.Opens, window.Closes))
+	}
+	v.OpeningIntervals("windows", cmd.Windows)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	terms, err := h.AbonementAccessUseCase.SetAbonementServiceTerms(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toAbonementServiceTermsObject(terms))
+}
+
+// CheckAccess answers for the at query parameter, now by default.
+func (h *AbonementAccessHTTP) CheckAccess(w http.ResponseWriter, r *http.Request) {
+	v := validation.New()
+	abonementId := v.UUID("abonementId", r.PathValue("abonementId"))
+	serviceId := v.UUID("serviceId", r.PathValue("serviceId"))
+	at := v.Timestamp("at", r.URL.Query().Get("at"), time.Now())
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	decision, err := h.AbonementAccessUseCase.CheckAccess(r.Context(), abonementId, serviceId, at)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	object := &accessDecisionObject{
+		Granted: decision.Granted,
+		Reason:  decision.Reason,
+		At:      decision.At.UTC().Format(time.RFC3339),
+	}
+	if decision.Terms != nil {
+		object.Terms = toAbonementServiceTermsObject(decision.Terms)
+		object.RemainingVisits = decision.RemainingVisits
+		object.RemainingGuestPasses = &decision.RemainingGuestPasses
+	}
+
+	writeJSON(w, http.StatusOK, object)
+}
+
+// RecordVisit answers 201 for a new visit and 200 for a repeated event.
+func (h *AbonementAccessHTTP) RecordVisit(w http.ResponseWriter, r *http.Request) {
+	var request recordVisitRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.RecordVisitCommand{
+		AbonementId: v.UUID("abonementId", r.PathValue("abonementId")),
+		ServiceId:   v.UUID("serviceId", r.PathValue("serviceId")),
+		MemberId:    v.UUID("memberId", request.MemberId),
+		EventId:     v.EventId("eventId", request.EventId),
+		At:          v.Timestamp("at", request.At, time.Now()),
+		Guest:       request.Guest,
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	receipt, err := h.AbonementAccessUseCase.RecordVisit(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	statusCode := http.StatusCreated
+	if receipt.Duplicate {
+		statusCode = http.StatusOK
+	}
+
+	writeJSON(w, statusCode, &visitReceiptObject{
+		Visit:                toVisitObject(receipt.Visit),
+		Duplicate:            receipt.Duplicate,
+		RemainingVisits:      receipt.RemainingVisits,
+		RemainingGuestPasses: receipt.RemainingGuestPasses,
+	})
+}
+
+// GetVisits lists the visits between the from and to query parameters,
+// optionally of a single service.
+func (h *AbonementAccessHTTP) GetVisits(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	v := validation.New()
+	abonementId := v.UUID("abonementId", r.PathValue("abonementId"))
+	serviceId := v.OptionalUUID("serviceId", query.Get("serviceId"))
+	from := v.Timestamp("from", query.Get("from"), time.Time{})
+	to := v.Timestamp("to", query.Get("to"), time.Time{})
+	v.TimeRange("to", from, to, validation.MaxVisitsRange)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	visits, err := h.AbonementAccessUseCase.GetVisits(r.Context(), abonementId, serviceId, from, to)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	objects := make([]*visitObject, 0, len(visits))
+	for _, visit := range visits {
+		objects = append(objects, toVisitObject(visit))
+	}
+
+	writeJSON(w, http.StatusOK, objects)
+}
+
+func toAbonementServiceTermsObject(terms *models.AbonementServiceTerms) *abonementServiceTermsObject {
+	object := &abonementServiceTermsObject{
+		AbonementId: terms.AbonementId.String(),
+		ServiceId:   terms.ServiceId.String(),
+		VisitQuota:  terms.VisitQuota,
+		QuotaPeriod: terms.QuotaPeriod,
+		GuestPasses: terms.GuestPasses,
+		Windows:     make([]*openingIntervalObject, 0, len(terms.Windows)),
+		UpdatedTime: terms.UpdatedTime.Format(time.RFC3339),
+	}
+	for _, window := range terms.Windows {
+		object.Windows = append(object.Windows, toOpeningIntervalObject(window))
+	}
+
+	return object
+}
+
+func toVisitObject(visit *models.Visit) *visitObject {
+	return &visitObject{
+		Id:           visit.Id.String(),
+		EventId:      visit.EventId,
+		AbonementId:  visit.AbonementId.String(),
+		ServiceId:    visit.ServiceId.String(),
+		MemberId:     visit.MemberId.String(),
+		Guest:        visit.Guest,
+		VisitedTime:  visit.VisitedTime.UTC().Format(time.RFC3339),
+		RecordedTime: visit.RecordedTime.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,224 @@
+package http
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type BookingHTTP struct {
+	BookingUseCase usecase.BookingUseCase
+}
+
+type bookingSettingsObject struct {
+	ServiceId   string `json:"serviceId"`
+	SlotMinutes int    `json:"slotMinutes"`
+	Capacity    int    `json:"capacity"`
+	UpdatedTime string `json:"updatedTime"`
+}
+
+type bookingSettingsRequest struct {
+	SlotMinutes int `json:"slotMinutes"`
+	Capacity    int `json:"capacity"`
+}
+
+type slotObject struct {
+	Start     string `json:"start"`
+	End       string `json:"end"`
+	Capacity  int    `json:"capacity"`
+	Booked    int    `json:"booked"`
+	Available int    `json:"available"`
+}
+
+type bookingObject struct {
+	Id            string `json:"id"`
+	ServiceId     string `json:"serviceId"`
+	MemberId      string `json:"memberId"`
+	SlotStart     string `json:"slotStart"`
+	SlotEnd       string `json:"slotEnd"`
+	Status        string `json:"status"`
+	CreatedTime   string `json:"createdTime"`
+	CancelledTime string `json:"cancelledTime,omitempty"`
+}
+
+type bookSlotRequest struct {
+	MemberId  string `json:"memberId"`
+	SlotStart string `json:"slotStart"`
+}
+
+func RegisterBooking(mux *http.ServeMux, bookingUseCase usecase.BookingUseCase) {
+	h := &BookingHTTP{BookingUseCase: bookingUseCase}
+
+	mux.HandleFunc("GET /v1/services/{id}/booking-settings", h.GetBookingSettings)
+	mux.HandleFunc("PUT /v1/services/{id}/booking-settings", h.SetBookingSettings)
+
+	mux.HandleFunc("GET /v1/services/{id}/availability", h.GetAvailability)
+	mux.HandleFunc("POST /v1/services/{id}/bookings", h.BookSlot)
+
+	mux.HandleFunc("GET /v1/bookings/{bookingId}", h.GetBooking)
+	mux.HandleFunc("POST /v1/bookings/{bookingId}/cancel", h.CancelBooking)
+}
+
+func (h *BookingHTTP) GetBookingSettings(w http.ResponseWriter, r *http.Request) {
+	serviceId, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	settings, err := h.BookingUseCase.GetBookingSettings(r.Context(), serviceId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toBookingSettingsObject(settings))
+}
+
+func (h *BookingHTTP) SetBookingSettings(w http.ResponseWriter, r *http.Request) {
+	var request bookingSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.SetBookingSettingsCommand{
+		ServiceId:   v.UUID("id", r.PathValue("id")),
+		SlotMinutes: v.SlotMinutes("slotMinutes", request.SlotMinutes),
+		Capacity:    v.SlotCapacity("capacity", request.Capacity),
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	settings, err := h.BookingUseCase.SetBookingSettings(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toBookingSettingsObject(settings))
+}
+
+func (h *BookingHTTP) GetAvailability(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	v := validation.New()
+	serviceId := v.UUID("id", r.PathValue("id"))
+	from := v.Timestamp("from", query.Get("from"), time.Time{})
+	to := v.Timestamp("to", query.Get("to"), time.Time{})
+	v.TimeRange("to", from, to, validation.MaxWindowsRange)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	slots, err := h.BookingUseCase.GetAvailability(r.Context(), serviceId, from, to)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	objects := make([]*slotObject, 0, len(slots))
+	for _, slot := range slots {
+		objects = append(objects, &slotObject{
+			Start:     slot.Start.Format(time.RFC3339),
+			End:       slot.End.Format(time.RFC3339),
+			Capacity:  slot.Capacity,
+			Booked:    slot.Booked,
+			Available: slot.Available(),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, objects)
+}
+
+func (h *BookingHTTP) BookSlot(w http.ResponseWriter, r *http.Request) {
+	var request bookSlotRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.BookSlotCommand{
+		ServiceId: v.UUID("id", r.PathValue("id")),
+		MemberId:  v.UUID("memberId", request.MemberId),
+		SlotStart: v.Timestamp("slotStart", request.SlotStart, time.Time{}),
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	booking, err := h.BookingUseCase.BookSlot(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toBookingObject(booking))
+}
+
+func (h *BookingHTTP) GetBooking(w http.ResponseWriter, r *http.Request) {
+	bookingId, ok := pathUUID(w, r, "bookingId")
+	if !ok {
+		return
+	}
+
+	booking, err := h.BookingUseCase.GetBooking(r.Context(), bookingId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toBookingObject(booking))
+}
+
+// CancelBooking frees the place taken in the slot. Cancelling twice returns
+// the booking unchanged.
+func (h *BookingHTTP) CancelBooking(w http.ResponseWriter, r *http.Request) {
+	bookingId, ok := pathUUID(w, r, "bookingId")
+	if !ok {
+		return
+	}
+
+	booking, err := h.BookingUseCase.CancelBooking(r.Context(), bookingId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toBookingObject(booking))
+}
+
+func toBookingSettingsObject(settings *models.BookingSettings) *bookingSettingsObject {
+	return &bookingSettingsObject{
+		ServiceId:   settings.ServiceId.String(),
+		SlotMinutes: settings.SlotMinutes,
+		Capacity:    settings.Capacity,
+		UpdatedTime: settings.UpdatedTime.Format(time.RFC3339),
+	}
+}
+
+func toBookingObject(booking *models.Booking) *bookingObject {
+	object := &bookingObject{
+		Id:          booking.Id.String(),
+		ServiceId:   booking.ServiceId.String(),
+		MemberId:    booking.MemberId.String(),
+		SlotStart:   booking.SlotStart.UTC().Format(time.RFC3339),
+		SlotEnd:     booking.SlotEnd.UTC().Format(time.RFC3339),
+		Status:      booking.Status,
+		CreatedTime: booking.CreatedTime.Format(time.RFC3339),
+	}
+	if booking.CancelledTime != nil {
+		object.CancelledTime = booking.CancelledTime.Format(time.RFC3339)
+	}
+
+	return object
+}
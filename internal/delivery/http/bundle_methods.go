@@ -0,0 +1,79 @@
+package http
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"encoding/json"
+	"net/http"
+)
+
+type ServiceBundlesHTTP struct {
+	ServiceUseCase usecase.ServiceUseCase
+	cloudUseCase   usecase.CloudUseCase
+}
+
+type bundleComponentsRequest struct {
+	ComponentIds []string `json:"componentIds"`
+}
+
+type bundleComponentsObject struct {
+	BundleId   string           `json:"bundleId"`
+	Components []*serviceObject `json:"components"`
+}
+
+func RegisterServiceBundles(mux *http.ServeMux, serviceUseCase usecase.ServiceUseCase, cloudUseCase usecase.CloudUseCase) {
+	h := &ServiceBundlesHTTP{ServiceUseCase: serviceUseCase, cloudUseCase: cloudUseCase}
+
+	mux.HandleFunc("GET /v1/services/{id}/components", h.GetBundleComponents)
+	mux.HandleFunc("PUT /v1/services/{id}/components", h.SetBundleComponents)
+}
+
+func (h *ServiceBundlesHTTP) GetBundleComponents(w http.ResponseWriter, r *http.Request) {
+	bundleId, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	components, err := h.ServiceUseCase.GetBundleComponents(r.Context(), bundleId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &bundleComponentsObject{
+		BundleId:   bundleId.String(),
+		Components: toServiceObjects(r.Context(), h.cloudUseCase, components),
+	})
+}
+
+// SetBundleComponents replaces every component, an empty list turns the
+// bundle back into a plain service.
+func (h *ServiceBundlesHTTP) SetBundleComponents(w http.ResponseWriter, r *http.Request) {
+	var request bundleComponentsRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.SetBundleComponentsCommand{
+		BundleId:     v.UUID("id", r.PathValue("id")),
+		ComponentIds: v.UUIDs("componentIds", request.ComponentIds, validation.MaxLinkedServices, false),
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	components, err := h.ServiceUseCase.SetBundleComponents(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &bundleComponentsObject{
+		BundleId:   cmd.BundleId.String(),
+		Components: toServiceObjects(r.Context(), h.cloudUseCase, components),
+	})
+}
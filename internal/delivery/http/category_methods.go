@@ -0,0 +1,231 @@
+package http
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type CategoryHTTP struct {
+	CategoryUseCase usecase.CategoryUseCase
+}
+
+type categoryObject struct {
+	Id          string `json:"id"`
+	ParentId    string `json:"parentId,omitempty"`
+	Name        string `json:"name"`
+	CreatedTime string `json:"createdTime"`
+	UpdatedTime string `json:"updatedTime"`
+}
+
+// categoryRequest is used for both create and update. An empty parentId makes
+// a root category.
+type categoryRequest struct {
+	Name     string `json:"name"`
+	ParentId string `json:"parentId"`
+}
+
+type serviceCategoryRequest struct {
+	CategoryId string `json:"categoryId"`
+}
+
+type serviceTagsRequest struct {
+	Tags []string `json:"tags"`
+}
+
+type serviceClassificationObject struct {
+	ServiceId  string   `json:"serviceId"`
+	CategoryId string   `json:"categoryId,omitempty"`
+	Tags       []string `json:"tags"`
+}
+
+func RegisterCategories(mux *http.ServeMux, categoryUseCase usecase.CategoryUseCase) {
+	h := &CategoryHTTP{CategoryUseCase: categoryUseCase}
+
+	mux.HandleFunc("GET /v1/categories", h.GetCategories)
+	mux.HandleFunc("POST /v1/categories", h.CreateCategory)
+	mux.HandleFunc("GET /v1/categories/{id}", h.GetCategoryById)
+	mux.HandleFunc("PUT /v1/categories/{id}", h.UpdateCategory)
+	mux.HandleFunc("DELETE /v1/categories/{id}", h.DeleteCategory)
+
+	mux.HandleFunc("PUT /v1/services/{id}/category", h.SetServiceCategory)
+	mux.HandleFunc("PUT /v1/services/{id}/tags", h.SetServiceTags)
+}
+
+func (h *CategoryHTTP) GetCategories(w http.ResponseWriter, r *http.Request) {
+	categories, err := h.CategoryUseCase.GetCategories(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	objects := make([]*categoryObject, 0, len(categories))
+	for _, category := range categories {
+		objects = append(objects, toCategoryObject(category))
+	}
+
+	writeJSON(w, http.StatusOK, objects)
+}
+
+func (h *CategoryHTTP) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	var request categoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.CreateCategoryCommand{
+		Name:     v.CategoryName("name", request.Name),
+		ParentId: v.OptionalUUID("parentId", request.ParentId),
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	category, err := h.CategoryUseCase.CreateCategory(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toCategoryObject(category))
+}
+
+func (h *CategoryHTTP) GetCategoryById(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	category, err := h.CategoryUseCase.GetCategoryById(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCategoryObject(category))
+}
+
+func (h *CategoryHTTP) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+	var request categoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.UpdateCategoryCommand{
+		Id:       v.UUID("id", r.PathValue("id")),
+		Name:     v.CategoryName("name", request.Name),
+		ParentId: v.OptionalUUID("parentId", request.ParentId),
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	category, err := h.CategoryUseCase.UpdateCategory(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCategoryObject(category))
+}
+
+func (h *CategoryHTTP) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	err := h.CategoryUseCase.DeleteCategory(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetServiceCategory assigns the service to a category, an empty categoryId
+// removes it from its category.
+func (h *CategoryHTTP) SetServiceCategory(w http.ResponseWriter, r *http.Request) {
+	var request serviceCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	serviceId := v.UUID("id", r.PathValue("id"))
+	categoryId := v.OptionalUUID("categoryId", request.CategoryId)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	classification, err := h.CategoryUseCase.SetServiceCategory(r.Context(), serviceId, categoryId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceClassificationObject(classification))
+}
+
+func (h *CategoryHTTP) SetServiceTags(w http.ResponseWriter, r *http.Request) {
+	var request serviceTagsRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	serviceId := v.UUID("id", r.PathValue("id"))
+	tags := v.Tags("tags", request.Tags)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	classification, err := h.CategoryUseCase.SetServiceTags(r.Context(), serviceId, tags)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceClassificationObject(classification))
+}
+
+func toCategoryObject(category *models.ServiceCategory) *categoryObject {
+	object := &categoryObject{
+		Id:          category.Id.String(),
+		Name:        category.Name,
+		CreatedTime: category.CreatedTime.Format(time.RFC3339),
+		UpdatedTime: category.UpdatedTime.Format(time.RFC3339),
+	}
+	if category.ParentId != nil {
+		object.ParentId = category.ParentId.String()
+	}
+
+	return object
+}
+
+func toServiceClassificationObject(classification *models.ServiceClassification) *serviceClassificationObject {
+	object := &serviceClassificationObject{
+		ServiceId: classification.ServiceId.String(),
+		Tags:      classification.Tags,
+	}
+	if classification.CategoryId != nil {
+		object.CategoryId = classification.CategoryId.String()
+	}
+
+	return object
+}
@@ -0,0 +1,155 @@
+package http
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type CoachServiceLinksHTTP struct {
+	CoachServiceLinkUseCase usecase.CoachServiceLinkUseCase
+}
+
+type coachServiceLinkObject struct {
+	CoachId              string `json:"coachId"`
+	ServiceId            string `json:"serviceId"`
+	QualificationLevel   string `json:"qualificationLevel"`
+	CertificationExpiry  string `json:"certificationExpiry,omitempty"`
+	CertificationExpired bool   `json:"certificationExpired"`
+	PriceOverride        *int64 `json:"priceOverride,omitempty"`
+	UpdatedTime          string `json:"updatedTime"`
+}
+
+type coachServiceLinkRequest struct {
+	ServiceId           string `json:"serviceId"`
+	QualificationLevel  string `json:"qualificationLevel"`
+	CertificationExpiry string `json:"certificationExpiry"`
+	PriceOverride       *int64 `json:"priceOverride"`
+}
+
+func RegisterCoachServiceLinks(mux *http.ServeMux, coachServiceLinkUseCase usecase.CoachServiceLinkUseCase) {
+	h := &CoachServiceLinksHTTP{CoachServiceLinkUseCase: coachServiceLinkUseCase}
+
+	mux.HandleFunc("GET /v1/coaches/{coachId}/service-links", h.GetCoachServiceLinks)
+	mux.HandleFunc("POST /v1/coaches/{coachId}/service-links", h.CreateCoachServiceLink)
+	mux.HandleFunc("GET /v1/coaches/{coachId}/service-links/{serviceId}", h.GetCoachServiceLink)
+	mux.HandleFunc("PUT /v1/coaches/{coachId}/service-links/{serviceId}", h.UpdateCoachServiceLink)
+}
+
+func (h *CoachServiceLinksHTTP) GetCoachServiceLinks(w http.ResponseWriter, r *http.Request) {
+	coachId, ok := pathUUID(w, r, "coachId")
+	if !ok {
+		return
+	}
+
+	links, err := h.CoachServiceLinkUseCase.GetCoachServiceLinks(r.Context(), coachId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	objects := make([]*coachServiceLinkObject, 0, len(links))
+	for _, link := range links {
+		objects = append(objects, toCoachServiceLinkObject(link))
+	}
+
+	writeJSON(w, http.StatusOK, objects)
+}
+
+func (h *CoachServiceLinksHTTP) CreateCoachServiceLink(w http.ResponseWriter, r *http.Request) {
+	var request coachServiceLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.CreateCoachServiceLinkCommand{
+		CoachId:             v.UUID("coachId", r.PathValue("coachId")),
+		ServiceId:           v.UUID("serviceId", request.ServiceId),
+		QualificationLevel:  v.QualificationLevel("qualificationLevel", request.QualificationLevel),
+		CertificationExpiry: v.OptionalDate("certificationExpiry", request.CertificationExpiry),
+		PriceOverride:       v.PriceOverride("priceOverride", request.PriceOverride),
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	link, err := h.CoachServiceLinkUseCase.CreateCoachServiceLink(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toCoachServiceLinkObject(link))
+}
+
+func (h *CoachServiceLinksHTTP) GetCoachServiceLink(w http.ResponseWriter, r *http.Request) {
+	v := validation.New()
+	coachId := v.UUID("coachId", r.PathValue("coachId"))
+	serviceId := v.UUID("serviceId", r.PathValue("serviceId"))
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	link, err := h.CoachServiceLinkUseCase.GetCoachServiceLink(r.Context(), coachId, serviceId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCoachServiceLinkObject(link))
+}
+
+// UpdateCoachServiceLink replaces every attribute of the link, the service
+// id of the body is ignored.
+func (h *CoachServiceLinksHTTP) UpdateCoachServiceLink(w http.ResponseWriter, r *http.Request) {
+	var request coachServiceLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.UpdateCoachServiceLinkCommand{
+		CoachId:             v.UUID("coachId", r.PathValue("coachId")),
+		ServiceId:           v.UUID("serviceId", r.PathValue("serviceId")),
+		QualificationLevel:  v.QualificationLevel("qualificationLevel", request.QualificationLevel),
+		CertificationExpiry: v.OptionalDate("certificationExpiry", request.CertificationExpiry),
+		PriceOverride:       v.PriceOverride("priceOverride", request.PriceOverride),
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	link, err := h.CoachServiceLinkUseCase.UpdateCoachServiceLink(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCoachServiceLinkObject(link))
+}
+
+func toCoachServiceLinkObject(link *models.CoachServiceLink) *coachServiceLinkObject {
+	object := &coachServiceLinkObject{
+		CoachId:              link.CoachId.String(),
+		ServiceId:            link.ServiceId.String(),
+		QualificationLevel:   link.QualificationLevel,
+		CertificationExpired: link.CertificationExpired,
+		PriceOverride:        link.PriceOverride,
+		UpdatedTime:          link.UpdatedTime.Format(time.RFC3339),
+	}
+	if link.CertificationExpiry != nil {
+		object.CertificationExpiry = link.CertificationExpiry.Format(models.DateLayout)
+	}
+
+	return object
+}
@@ -0,0 +1,146 @@
+package http
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/pkg/logger"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+type problem struct {
+	Status     int              `json:"status"`
+	Title      string           `json:"title"`
+	Detail     string           `json:"detail,omitempty"`
+	Violations []fieldViolation `json:"violations,omitempty"`
+	Conflicts  []conflict       `json:"conflicts,omitempty"`
+}
+
+type conflict struct {
+	Type        string `json:"type"`
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+}
+
+type fieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.ErrorLogger.Printf("Failed to write http response: %v", err)
+	}
+}
+
+func writeProblem(w http.ResponseWriter, statusCode int, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(statusCode)
+
+	_ = json.NewEncoder(w).Encode(&problem{
+		Status: statusCode,
+		Title:  http.StatusText(statusCode),
+		Detail: detail,
+	})
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	statusCode := httpStatus(err)
+	if statusCode == http.StatusInternalServerError {
+		logger.ErrorLogger.Printf("Unhandled http error: %v", err)
+		writeProblem(w, statusCode, "internal error")
+		return
+	}
+
+	var validationErr *customErrors.ValidationError
+	if errors.As(err, &validationErr) {
+		p := &problem{Status: statusCode, Title: http.StatusText(statusCode), Detail: customErrors.InvalidArgument.Error()}
+		for _, violation := range validationErr.Violations {
+			p.Violations = append(p.Violations, fieldViolation{Field: violation.Field, Description: violation.Description})
+		}
+
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(statusCode)
+		_ = json.NewEncoder(w).Encode(p)
+		return
+	}
+
+	var preconditionErr *customErrors.PreconditionError
+	if errors.As(err, &preconditionErr) {
+		p := &problem{Status: statusCode, Title: http.StatusText(statusCode), Detail: preconditionErr.Err.Error()}
+		for _, violation := range preconditionErr.Violations {
+			p.Conflicts = append(p.Conflicts, conflict{Type: violation.Type, Subject: violation.Subject, Description: violation.Description})
+		}
+
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(statusCode)
+		_ = json.NewEncoder(w).Encode(p)
+		return
+	}
+
+	writeProblem(w, statusCode, err.Error())
+}
+
+func httpStatus(err error) int {
+	switch {
+	case errors.Is(err, customErrors.InvalidArgument),
+		errors.Is(err, customErrors.InvalidReplacementService),
+		errors.Is(err, customErrors.InvalidMediaOrder),
+		errors.Is(err, customErrors.InvalidCategoryParent),
+		errors.Is(err, customErrors.BundleCycle),
+		errors.Is(err, customErrors.VoidServiceData):
+		return http.StatusBadRequest
+	case errors.Is(err, customErrors.ServiceNotFound),
+		errors.Is(err, customErrors.ServiceTranslationNotFound),
+		errors.Is(err, customErrors.PhotoUploadNotFound),
+		errors.Is(err, customErrors.ServicePhotoNotFound),
+		errors.Is(err, customErrors.ServiceMediaNotFound),
+		errors.Is(err, customErrors.CategoryNotFound),
+		errors.Is(err, customErrors.ServiceHoursNotFound),
+		errors.Is(err, customErrors.ServiceClosureNotFound),
+		errors.Is(err, customErrors.SlotNotFound),
+		errors.Is(err, customErrors.BookingNotFound),
+		errors.Is(err, customErrors.CoachServiceLinkNotFound),
+		errors.Is(err, customErrors.AbonementServiceLinkNotFound),
+		errors.Is(err, customErrors.ServiceRuleNotFound),
+		errors.Is(err, customErrors.CoachNotFound),
+		errors.Is(err, customErrors.AbonementNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, customErrors.ServiceAlreadyExists),
+		errors.Is(err, customErrors.ServiceLinkAlreadyExists),
+		errors.Is(err, customErrors.ServiceInUse),
+		errors.Is(err, customErrors.ServiceMediaLimitReached),
+		errors.Is(err, customErrors.CategoryAlreadyExists),
+		errors.Is(err, customErrors.CategoryInUse),
+		errors.Is(err, customErrors.ServiceNotBookable),
+		errors.Is(err, customErrors.SlotInPast),
+		errors.Is(err, customErrors.SlotFull),
+		errors.Is(err, customErrors.BookingAlreadyExists),
+		errors.Is(err, customErrors.AccessOutsideHours),
+		errors.Is(err, customErrors.VisitQuotaUsedUp),
+		errors.Is(err, customErrors.GuestPassesUsedUp),
+		errors.Is(err, customErrors.VisitEventConflict),
+		errors.Is(err, customErrors.ServiceRuleAlreadyExists),
+		errors.Is(err, customErrors.ServiceRuleConflict),
+		errors.Is(err, customErrors.ServiceRulesViolated),
+		errors.Is(err, customErrors.ReconciliationInProgress):
+		return http.StatusConflict
+	case errors.Is(err, customErrors.PhotoChanged):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, customErrors.InvalidPhotoRange):
+		return http.StatusRequestedRangeNotSatisfiable
+	case errors.Is(err, customErrors.PhotoUploadExpired):
+		return http.StatusGone
+	case errors.Is(err, customErrors.PhotoUploadIncomplete),
+		errors.Is(err, customErrors.PhotoUploadMismatch):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, customErrors.InternalCoachServerError),
+		errors.Is(err, customErrors.InternalAbonementServerError):
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"Service/internal/validation"
+	"encoding/json"
+	"github.com/google/uuid"
+	"net/http"
+	"strings"
+)
+
+const (
+	coachEventPrefix     = "coach."
+	abonementEventPrefix = "abonement."
+)
+
+// peerEvent is what the coach and abonement services post when one of their
+// entities is created, updated or deleted, e.g. {"type": "coach.deleted", "id": "..."}.
+type peerEvent struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+func (h *ServiceHTTP) ReceivePeerEvent(w http.ResponseWriter, r *http.Request) {
+	var event peerEvent
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	id := v.UUID("id", event.Id)
+	if !strings.HasPrefix(event.Type, coachEventPrefix) && !strings.HasPrefix(event.Type, abonementEventPrefix) {
+		v.Violation("type", "must start with coach. or abonement.")
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if strings.HasPrefix(event.Type, coachEventPrefix) {
+		h.ServiceUseCase.InvalidatePeerCache(r.Context(), []uuid.UUID{id}, nil, false)
+	} else {
+		h.ServiceUseCase.InvalidatePeerCache(r.Context(), nil, []uuid.UUID{id}, false)
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,37 @@
+package http
+
+import (
+	"Service/pkg/resilience"
+	"expvar"
+	"net/http"
+)
+
+type healthResponse struct {
+	Status string            `json:"status"`
+	Peers  map[string]string `json:"peers"`
+}
+
+// RegisterHealth exposes liveness with the circuit breaker state of every
+// peer.
+func RegisterHealth(mux *http.ServeMux, breakers ...*resilience.Breaker) {
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, _ *http.Request) {
+		response := &healthResponse{Status: "ok", Peers: map[string]string{}}
+
+		for _, breaker := range breakers {
+			state := breaker.State()
+			response.Peers[breaker.Name()] = state.String()
+
+			if state == resilience.StateOpen {
+				response.Status = "degraded"
+			}
+		}
+
+		writeJSON(w, http.StatusOK, response)
+	})
+}
+
+// RegisterDebug exposes the expvar metrics. It belongs on the internal debug
+// listener, never on the public gateway.
+func RegisterDebug(mux *http.ServeMux) {
+	mux.Handle("GET /debug/vars", expvar.Handler())
+}
@@ -0,0 +1,24 @@
+package http
+
+import (
+	"Service/pkg/locale"
+	"net/http"
+)
+
+// WithLocale stores the caller's preferred locales in the request context.
+// A locale query parameter takes precedence over the Accept-Language header.
+func WithLocale(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		preferred := r.URL.Query().Get("locale")
+		if preferred == "" {
+			preferred = r.Header.Get("Accept-Language")
+		}
+
+		if preferred != "" {
+			r = r.WithContext(locale.NewContext(r.Context(), locale.ParseAcceptLanguage(preferred)))
+		}
+
+		w.Header().Add("Vary", "Accept-Language")
+		next.ServeHTTP(w, r)
+	})
+}
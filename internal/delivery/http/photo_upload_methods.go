@@ -0,0 +1,85 @@
+package http
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type PhotoUploadHTTP struct {
+	PhotoUploadUseCase usecase.PhotoUploadUseCase
+	cloudUseCase       usecase.CloudUseCase
+}
+
+type createPhotoUploadRequest struct {
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+}
+
+type photoUploadObject struct {
+	UploadToken string `json:"uploadToken"`
+	UploadURL   string `json:"uploadUrl"`
+	ExpiresTime string `json:"expiresTime"`
+}
+
+func RegisterPhotoUpload(mux *http.ServeMux, photoUploadUseCase usecase.PhotoUploadUseCase, cloudUseCase usecase.CloudUseCase) {
+	h := &PhotoUploadHTTP{PhotoUploadUseCase: photoUploadUseCase, cloudUseCase: cloudUseCase}
+
+	mux.HandleFunc("POST /v1/services/{id}/photo-uploads", h.CreatePhotoUpload)
+	mux.HandleFunc("POST /v1/services/{id}/photo-uploads/{token}/confirm", h.ConfirmPhotoUpload)
+}
+
+func (h *PhotoUploadHTTP) CreatePhotoUpload(w http.ResponseWriter, r *http.Request) {
+	var request createPhotoUploadRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.CreatePhotoUploadCommand{
+		ServiceId:   v.UUID("id", r.PathValue("id")),
+		ContentType: v.PhotoContentType("contentType", request.ContentType),
+		Size:        request.Size,
+	}
+	v.PhotoSize("size", request.Size)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	upload, err := h.PhotoUploadUseCase.CreatePhotoUpload(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, &photoUploadObject{
+		UploadToken: upload.Token.String(),
+		UploadURL:   upload.UploadURL,
+		ExpiresTime: upload.ExpiresTime.Format(time.RFC3339),
+	})
+}
+
+func (h *PhotoUploadHTTP) ConfirmPhotoUpload(w http.ResponseWriter, r *http.Request) {
+	v := validation.New()
+	cmd := &dtos.ConfirmPhotoUploadCommand{
+		ServiceId: v.UUID("id", r.PathValue("id")),
+		Token:     v.UUID("token", r.PathValue("token")),
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	service, err := h.PhotoUploadUseCase.ConfirmPhotoUpload(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceObject(r.Context(), h.cloudUseCase, service))
+}
@@ -0,0 +1,171 @@
+package http
+
+import (
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type ReportHTTP struct {
+	ReportUseCase usecase.ReportUseCase
+}
+
+type serviceUsageObject struct {
+	ServiceId   string `json:"serviceId"`
+	Title       string `json:"title"`
+	Coaches     int    `json:"coaches"`
+	Abonements  int    `json:"abonements"`
+	Visits      int    `json:"visits"`
+	GuestVisits int    `json:"guestVisits"`
+	Members     int    `json:"members"`
+}
+
+type trendPointObject struct {
+	PeriodStart string `json:"periodStart"`
+	Visits      int    `json:"visits"`
+	GuestVisits int    `json:"guestVisits"`
+	Members     int    `json:"members"`
+}
+
+// RegisterReports serves every report as JSON, or as CSV with format=csv.
+func RegisterReports(mux *http.ServeMux, reportUseCase usecase.ReportUseCase) {
+	h := &ReportHTTP{ReportUseCase: reportUseCase}
+
+	mux.HandleFunc("GET /v1/reports/service-usage", h.GetServiceUsage)
+	mux.HandleFunc("GET /v1/reports/coverage-gaps", h.GetCoverageGaps)
+	mux.HandleFunc("GET /v1/reports/visit-trend", h.GetVisitTrend)
+}
+
+func (h *ReportHTTP) GetServiceUsage(w http.ResponseWriter, r *http.Request) {
+	query, csv, err := parseReportQuery(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if csv {
+		h.exportReport(w, r, models.ReportServiceUsage, query)
+		return
+	}
+
+	usage, err := h.ReportUseCase.GetServiceUsage(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceUsageObjects(usage))
+}
+
+func (h *ReportHTTP) GetCoverageGaps(w http.ResponseWriter, r *http.Request) {
+	query, csv, err := parseReportQuery(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if csv {
+		h.exportReport(w, r, models.ReportCoverageGaps, query)
+		return
+	}
+
+	usage, err := h.ReportUseCase.GetCoverageGaps(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceUsageObjects(usage))
+}
+
+func (h *ReportHTTP) GetVisitTrend(w http.ResponseWriter, r *http.Request) {
+	query, csv, err := parseReportQuery(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if csv {
+		h.exportReport(w, r, models.ReportVisitTrend, query)
+		return
+	}
+
+	points, err := h.ReportUseCase.GetVisitTrend(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	objects := make([]*trendPointObject, 0, len(points))
+	for _, point := range points {
+		objects = append(objects, &trendPointObject{
+			PeriodStart: point.PeriodStart.Format(time.RFC3339),
+			Visits:      point.Visits,
+			GuestVisits: point.GuestVisits,
+			Members:     point.Members,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, objects)
+}
+
+// exportReport renders the whole report before answering, so that a failure
+// is still reported as a problem instead of a cut off file.
+func (h *ReportHTTP) exportReport(w http.ResponseWriter, r *http.Request, report string, query *models.ReportQuery) {
+	var buffer bytes.Buffer
+	if err := h.ReportUseCase.ExportReport(r.Context(), report, query, &buffer); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", report+".csv"))
+	w.WriteHeader(http.StatusOK)
+	_, _ = buffer.WriteTo(w)
+}
+
+// parseReportQuery also tells whether CSV was asked for with format=csv.
+func parseReportQuery(r *http.Request) (*models.ReportQuery, bool, error) {
+	query := r.URL.Query()
+
+	v := validation.New()
+	reportQuery := &models.ReportQuery{
+		From:        v.Timestamp("from", query.Get("from"), time.Time{}),
+		To:          v.Timestamp("to", query.Get("to"), time.Time{}),
+		ServiceId:   v.OptionalUUID("serviceId", query.Get("serviceId")),
+		Granularity: v.TrendGranularity("granularity", query.Get("granularity")),
+		TimeZone:    "UTC",
+	}
+	v.TimeRange("to", reportQuery.From, reportQuery.To, validation.MaxReportRange)
+	if timeZone := query.Get("timeZone"); timeZone != "" {
+		reportQuery.TimeZone = v.TimeZone("timeZone", timeZone)
+	}
+
+	format := query.Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		v.Violation("format", "must be json or csv")
+	}
+
+	return reportQuery, format == "csv", v.Err()
+}
+
+func toServiceUsageObjects(usage []*models.ServiceUsage) []*serviceUsageObject {
+	objects := make([]*serviceUsageObject, 0, len(usage))
+	for _, service := range usage {
+		objects = append(objects, &serviceUsageObject{
+			ServiceId:   service.ServiceId.String(),
+			Title:       service.Title,
+			Coaches:     service.Coaches,
+			Abonements:  service.Abonements,
+			Visits:      service.Visits,
+			GuestVisits: service.GuestVisits,
+			Members:     service.Members,
+		})
+	}
+
+	return objects
+}
@@ -0,0 +1,294 @@
+package http
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type ServiceHoursHTTP struct {
+	ServiceHoursUseCase usecase.ServiceHoursUseCase
+}
+
+type openingIntervalObject struct {
+	Weekday string `json:"weekday"`
+	Opens   string `json:"opens"`
+	Closes  string `json:"closes"`
+}
+
+type serviceHoursObject struct {
+	ServiceId   string                   `json:"serviceId"`
+	TimeZone    string                   `json:"timeZone"`
+	Intervals   []*openingIntervalObject `json:"intervals"`
+	UpdatedTime string                   `json:"updatedTime"`
+}
+
+type serviceHoursRequest struct {
+	TimeZone  string                   `json:"timeZone"`
+	Intervals []*openingIntervalObject `json:"intervals"`
+}
+
+type serviceClosureObject struct {
+	Id          string `json:"id"`
+	ServiceId   string `json:"serviceId"`
+	StartDate   string `json:"startDate"`
+	EndDate     string `json:"endDate"`
+	Kind        string `json:"kind"`
+	Reason      string `json:"reason,omitempty"`
+	CreatedTime string `json:"createdTime"`
+}
+
+type serviceClosureRequest struct {
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+	Kind      string `json:"kind"`
+	Reason    string `json:"reason"`
+}
+
+type openingWindowObject struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+type openStatusObject struct {
+	At     string               `json:"at"`
+	Open   bool                 `json:"open"`
+	Window *openingWindowObject `json:"window,omitempty"`
+}
+
+func RegisterServiceHours(mux *http.ServeMux, serviceHoursUseCase usecase.ServiceHoursUseCase) {
+	h := &ServiceHoursHTTP{ServiceHoursUseCase: serviceHoursUseCase}
+
+	mux.HandleFunc("GET /v1/services/{id}/hours", h.GetServiceHours)
+	mux.HandleFunc("PUT /v1/services/{id}/hours", h.SetServiceHours)
+
+	mux.HandleFunc("GET /v1/services/{id}/closures", h.GetServiceClosures)
+	mux.HandleFunc("POST /v1/services/{id}/closures", h.AddServiceClosure)
+	mux.HandleFunc("DELETE /v1/services/{id}/closures/{closureId}", h.RemoveServiceClosure)
+
+	mux.HandleFunc("GET /v1/services/{id}/open", h.GetOpenStatus)
+	mux.HandleFunc("GET /v1/services/{id}/opening-windows", h.GetOpeningWindows)
+}
+
+func (h *ServiceHoursHTTP) GetServiceHours(w http.ResponseWriter, r *http.Request) {
+	serviceId, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	hours, err := h.ServiceHoursUseCase.GetServiceHours(r.Context(), serviceId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceHoursObject(hours))
+}
+
+func (h *ServiceHoursHTTP) SetServiceHours(w http.ResponseWriter, r *http.Request) {
+	var request serviceHoursRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.SetServiceHoursCommand{
+		ServiceId: v.UUID("id", r.PathValue("id")),
+		TimeZone:  v.TimeZone("timeZone", request.TimeZone),
+	}
+	for i, interval := range request.Intervals {
+		if interval == nil {
+			v.Violation(fmt.Sprintf("intervals[%d]", i), "must not be null")
+			continue
+		}
+		cmd.Intervals = append(cmd.Intervals,
+			v.OpeningInterval(fmt.Sprintf("intervals[%d]", i), interval.Weekday, interval.Opens, interval.Closes))
+	}
+	v.OpeningIntervals("intervals", cmd.Intervals)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	hours, err := h.ServiceHoursUseCase.SetServiceHours(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceHoursObject(hours))
+}
+
+// GetServiceClosures takes optional from and to dates, the year ahead by
+// default.
+func (h *ServiceHoursHTTP) GetServiceClosures(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	v := validation.New()
+	serviceId := v.UUID("id", r.PathValue("id"))
+	from, to := v.ClosureDates("from", query.Get("from"), "to", query.Get("to"))
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	closures, err := h.ServiceHoursUseCase.GetServiceClosures(r.Context(), serviceId, from, to)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	objects := make([]*serviceClosureObject, 0, len(closures))
+	for _, closure := range closures {
+		objects = append(objects, toServiceClosureObject(closure))
+	}
+
+	writeJSON(w, http.StatusOK, objects)
+}
+
+func (h *ServiceHoursHTTP) AddServiceClosure(w http.ResponseWriter, r *http.Request) {
+	var request serviceClosureRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.AddServiceClosureCommand{
+		ServiceId: v.UUID("id", r.PathValue("id")),
+		StartDate: v.Date("startDate", request.StartDate),
+		EndDate:   v.Date("endDate", request.EndDate),
+		Kind:      v.ClosureKind("kind", request.Kind),
+		Reason:    v.ClosureReason("reason", request.Reason),
+	}
+	v.DateRange("endDate", cmd.StartDate, cmd.EndDate, validation.MaxClosureDays)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	closure, err := h.ServiceHoursUseCase.AddServiceClosure(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toServiceClosureObject(closure))
+}
+
+func (h *ServiceHoursHTTP) RemoveServiceClosure(w http.ResponseWriter, r *http.Request) {
+	v := validation.New()
+	serviceId := v.UUID("id", r.PathValue("id"))
+	closureId := v.UUID("closureId", r.PathValue("closureId"))
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	err := h.ServiceHoursUseCase.RemoveServiceClosure(r.Context(), serviceId, closureId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetOpenStatus answers for the at query parameter, now by default.
+func (h *ServiceHoursHTTP) GetOpenStatus(w http.ResponseWriter, r *http.Request) {
+	v := validation.New()
+	serviceId := v.UUID("id", r.PathValue("id"))
+	at := v.Timestamp("at", r.URL.Query().Get("at"), time.Now())
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	openStatus, err := h.ServiceHoursUseCase.GetOpenStatus(r.Context(), serviceId, at)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	object := &openStatusObject{At: openStatus.At.UTC().Format(time.RFC3339), Open: openStatus.Open}
+	if openStatus.Window != nil {
+		object.Window = toOpeningWindowObject(openStatus.Window)
+	}
+
+	writeJSON(w, http.StatusOK, object)
+}
+
+func (h *ServiceHoursHTTP) GetOpeningWindows(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	v := validation.New()
+	serviceId := v.UUID("id", r.PathValue("id"))
+	from := v.Timestamp("from", query.Get("from"), time.Time{})
+	to := v.Timestamp("to", query.Get("to"), time.Time{})
+	v.TimeRange("to", from, to, validation.MaxWindowsRange)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	windows, err := h.ServiceHoursUseCase.GetOpeningWindows(r.Context(), serviceId, from, to)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	objects := make([]*openingWindowObject, 0, len(windows))
+	for _, window := range windows {
+		objects = append(objects, toOpeningWindowObject(window))
+	}
+
+	writeJSON(w, http.StatusOK, objects)
+}
+
+func toServiceHoursObject(hours *models.ServiceHours) *serviceHoursObject {
+	object := &serviceHoursObject{
+		ServiceId:   hours.ServiceId.String(),
+		TimeZone:    hours.TimeZone,
+		Intervals:   make([]*openingIntervalObject, 0, len(hours.Intervals)),
+		UpdatedTime: hours.UpdatedTime.Format(time.RFC3339),
+	}
+
+	for _, interval := range hours.Intervals {
+		object.Intervals = append(object.Intervals, toOpeningIntervalObject(interval))
+	}
+
+	return object
+}
+
+func toOpeningIntervalObject(interval models.OpeningInterval) *openingIntervalObject {
+	return &openingIntervalObject{
+		Weekday: models.WeekdayName(interval.Weekday),
+		Opens:   models.Clock(interval.OpensMinute),
+		Closes:  models.Clock(interval.ClosesMinute),
+	}
+}
+
+func toServiceClosureObject(closure *models.ServiceClosure) *serviceClosureObject {
+	return &serviceClosureObject{
+		Id:          closure.Id.String(),
+		ServiceId:   closure.ServiceId.String(),
+		StartDate:   closure.StartDate.Format(models.DateLayout),
+		EndDate:     closure.EndDate.Format(models.DateLayout),
+		Kind:        closure.Kind,
+		Reason:      closure.Reason,
+		CreatedTime: closure.CreatedTime.Format(time.RFC3339),
+	}
+}
+
+func toOpeningWindowObject(window *models.OpeningWindow) *openingWindowObject {
+	return &openingWindowObject{
+		Start: window.Start.Format(time.RFC3339),
+		End:   window.End.Format(time.RFC3339),
+	}
+}
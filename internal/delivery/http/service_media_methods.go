@@ -0,0 +1,207 @@
+package http
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"Service/pkg/logger"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type ServiceMediaHTTP struct {
+	ServiceMediaUseCase usecase.ServiceMediaUseCase
+	cloudUseCase        usecase.CloudUseCase
+}
+
+type serviceMediaObject struct {
+	Id          string `json:"id"`
+	ServiceId   string `json:"serviceId"`
+	URL         string `json:"url"`
+	MediaType   string `json:"mediaType"`
+	ContentType string `json:"contentType"`
+	AltText     string `json:"altText"`
+	Position    int    `json:"position"`
+	Cover       bool   `json:"cover"`
+	CreatedTime string `json:"createdTime"`
+}
+
+type reorderMediaRequest struct {
+	MediaIds []string `json:"mediaIds"`
+}
+
+func RegisterServiceMedia(mux *http.ServeMux, serviceMediaUseCase usecase.ServiceMediaUseCase, cloudUseCase usecase.CloudUseCase) {
+	h := &ServiceMediaHTTP{ServiceMediaUseCase: serviceMediaUseCase, cloudUseCase: cloudUseCase}
+
+	mux.HandleFunc("GET /v1/services/{id}/media", h.GetServiceMedia)
+	mux.HandleFunc("POST /v1/services/{id}/media", h.AddServiceMedia)
+	mux.HandleFunc("PUT /v1/services/{id}/media/order", h.ReorderServiceMedia)
+	mux.HandleFunc("PUT /v1/services/{id}/media/{mediaId}/cover", h.SetServiceMediaCover)
+	mux.HandleFunc("DELETE /v1/services/{id}/media/{mediaId}", h.RemoveServiceMedia)
+}
+
+func (h *ServiceMediaHTTP) GetServiceMedia(w http.ResponseWriter, r *http.Request) {
+	serviceId, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	media, err := h.ServiceMediaUseCase.GetServiceMedia(r.Context(), serviceId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.toServiceMediaObjects(r.Context(), media))
+}
+
+// AddServiceMedia takes a multipart form with the file, mediaType, altText
+// and cover fields.
+func (h *ServiceMediaHTTP) AddServiceMedia(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, validation.MaxPhotoSize+1<<20)
+
+	if err := r.ParseMultipartForm(validation.MaxPhotoSize); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid multipart form")
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil && !errors.Is(err, http.ErrMissingFile) {
+		writeProblem(w, http.StatusBadRequest, "invalid file")
+		return
+	}
+
+	var content []byte
+	if file != nil {
+		defer file.Close()
+
+		content, err = io.ReadAll(file)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "failed to read file")
+			return
+		}
+	}
+
+	v := validation.New()
+	cmd := &dtos.AddServiceMediaCommand{
+		ServiceId: v.UUID("id", r.PathValue("id")),
+		MediaType: v.MediaType("mediaType", r.FormValue("mediaType")),
+		AltText:   v.AltText("altText", r.FormValue("altText")),
+		Content:   content,
+	}
+	if cover := r.FormValue("cover"); cover != "" {
+		parsed, err := strconv.ParseBool(cover)
+		if err != nil {
+			v.Violation("cover", "must be a boolean")
+		}
+		cmd.Cover = parsed
+	}
+	v.PhotoSize("file", int64(len(content)))
+	cmd.ContentType = v.PhotoContentType("file", http.DetectContentType(content))
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	media, err := h.ServiceMediaUseCase.AddServiceMedia(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, h.toServiceMediaObject(r.Context(), media))
+}
+
+func (h *ServiceMediaHTTP) ReorderServiceMedia(w http.ResponseWriter, r *http.Request) {
+	var request reorderMediaRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	serviceId := v.UUID("id", r.PathValue("id"))
+	mediaIds := v.UUIDs("mediaIds", request.MediaIds, validation.MaxServiceMedia, false)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	media, err := h.ServiceMediaUseCase.ReorderServiceMedia(r.Context(), serviceId, mediaIds)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.toServiceMediaObjects(r.Context(), media))
+}
+
+func (h *ServiceMediaHTTP) SetServiceMediaCover(w http.ResponseWriter, r *http.Request) {
+	v := validation.New()
+	serviceId := v.UUID("id", r.PathValue("id"))
+	mediaId := v.UUID("mediaId", r.PathValue("mediaId"))
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	media, err := h.ServiceMediaUseCase.SetServiceMediaCover(r.Context(), serviceId, mediaId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.toServiceMediaObjects(r.Context(), media))
+}
+
+func (h *ServiceMediaHTTP) RemoveServiceMedia(w http.ResponseWriter, r *http.Request) {
+	v := validation.New()
+	serviceId := v.UUID("id", r.PathValue("id"))
+	mediaId := v.UUID("mediaId", r.PathValue("mediaId"))
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	err := h.ServiceMediaUseCase.RemoveServiceMedia(r.Context(), serviceId, mediaId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ServiceMediaHTTP) toServiceMediaObjects(ctx context.Context, media []*models.ServiceMedia) []*serviceMediaObject {
+	objects := make([]*serviceMediaObject, 0, len(media))
+	for _, item := range media {
+		objects = append(objects, h.toServiceMediaObject(ctx, item))
+	}
+
+	return objects
+}
+
+func (h *ServiceMediaHTTP) toServiceMediaObject(ctx context.Context, media *models.ServiceMedia) *serviceMediaObject {
+	url, err := h.cloudUseCase.PhotoURL(ctx, media.ObjectKey)
+	if err != nil {
+		logger.ErrorLogger.Printf("Failed to render url of media %s: %v", media.ObjectKey, err)
+	}
+
+	return &serviceMediaObject{
+		Id:          media.Id.String(),
+		ServiceId:   media.ServiceId.String(),
+		URL:         url,
+		MediaType:   media.MediaType,
+		ContentType: media.ContentType,
+		AltText:     media.AltText,
+		Position:    media.Position,
+		Cover:       media.Cover,
+		CreatedTime: media.CreatedTime.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,163 @@
+package http
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"encoding/json"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+type ServiceRulesHTTP struct {
+	ServiceRuleUseCase usecase.ServiceRuleUseCase
+}
+
+type serviceRuleObject struct {
+	Id             string `json:"id"`
+	Kind           string `json:"kind"`
+	ServiceId      string `json:"serviceId"`
+	OtherServiceId string `json:"otherServiceId"`
+	Description    string `json:"description,omitempty"`
+	CreatedTime    string `json:"createdTime"`
+}
+
+type serviceRuleRequest struct {
+	Kind           string `json:"kind"`
+	ServiceId      string `json:"serviceId"`
+	OtherServiceId string `json:"otherServiceId"`
+	Description    string `json:"description"`
+}
+
+type serviceSetRequest struct {
+	ServiceIds []string `json:"serviceIds"`
+}
+
+type serviceSetCheckObject struct {
+	Valid      bool                 `json:"valid"`
+	Violations []*serviceRuleObject `json:"violations"`
+}
+
+func RegisterServiceRules(mux *http.ServeMux, serviceRuleUseCase usecase.ServiceRuleUseCase) {
+	h := &ServiceRulesHTTP{ServiceRuleUseCase: serviceRuleUseCase}
+
+	mux.HandleFunc("GET /v1/service-rules", h.GetServiceRules)
+	mux.HandleFunc("POST /v1/service-rules", h.CreateServiceRule)
+	mux.HandleFunc("DELETE /v1/service-rules/{id}", h.DeleteServiceRule)
+	mux.HandleFunc("POST /v1/service-rules/check", h.CheckServiceSet)
+}
+
+// GetServiceRules lists every rule, or only the rules naming the serviceId
+// query parameter on either side.
+func (h *ServiceRulesHTTP) GetServiceRules(w http.ResponseWriter, r *http.Request) {
+	v := validation.New()
+	serviceId := v.OptionalUUID("serviceId", r.URL.Query().Get("serviceId"))
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	rules, err := h.ServiceRuleUseCase.GetServiceRules(r.Context(), serviceId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceRuleObjects(rules))
+}
+
+func (h *ServiceRulesHTTP) CreateServiceRule(w http.ResponseWriter, r *http.Request) {
+	var request serviceRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.CreateServiceRuleCommand{
+		Kind:           v.ServiceRuleKind("kind", request.Kind),
+		ServiceId:      v.UUID("serviceId", request.ServiceId),
+		OtherServiceId: v.UUID("otherServiceId", request.OtherServiceId),
+		Description:    v.RuleDescription("description", request.Description),
+	}
+	if cmd.ServiceId != uuid.Nil && cmd.ServiceId == cmd.OtherServiceId {
+		v.Violation("otherServiceId", "must differ from serviceId")
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	rule, err := h.ServiceRuleUseCase.CreateServiceRule(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toServiceRuleObject(rule))
+}
+
+func (h *ServiceRulesHTTP) DeleteServiceRule(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	err := h.ServiceRuleUseCase.DeleteServiceRule(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CheckServiceSet evaluates the rules against a would-be set of abonement
+// services without changing anything.
+func (h *ServiceRulesHTTP) CheckServiceSet(w http.ResponseWriter, r *http.Request) {
+	var request serviceSetRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	servicesIds := v.UUIDs("serviceIds", request.ServiceIds, validation.MaxLinkedServices, false)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	check, err := h.ServiceRuleUseCase.CheckServiceSet(r.Context(), servicesIds)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &serviceSetCheckObject{
+		Valid:      check.Valid(),
+		Violations: toServiceRuleObjects(check.Violations),
+	})
+}
+
+func toServiceRuleObjects(rules []*models.ServiceRule) []*serviceRuleObject {
+	objects := make([]*serviceRuleObject, 0, len(rules))
+	for _, rule := range rules {
+		objects = append(objects, toServiceRuleObject(rule))
+	}
+
+	return objects
+}
+
+func toServiceRuleObject(rule *models.ServiceRule) *serviceRuleObject {
+	return &serviceRuleObject{
+		Id:             rule.Id.String(),
+		Kind:           rule.Kind,
+		ServiceId:      rule.ServiceId.String(),
+		OtherServiceId: rule.OtherServiceId.String(),
+		Description:    rule.Description,
+		CreatedTime:    rule.CreatedTime.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,101 @@
+package http
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/validation"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type serviceTranslationObject struct {
+	ServiceId   string `json:"serviceId"`
+	Locale      string `json:"locale"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	CreatedTime string `json:"createdTime"`
+	UpdatedTime string `json:"updatedTime"`
+}
+
+type upsertTranslationRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+func (h *ServiceHTTP) GetServiceTranslations(w http.ResponseWriter, r *http.Request) {
+	serviceId, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	translations, err := h.ServiceUseCase.GetServiceTranslations(r.Context(), serviceId)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	translationObjects := make([]*serviceTranslationObject, 0, len(translations))
+	for _, translation := range translations {
+		translationObjects = append(translationObjects, toServiceTranslationObject(translation))
+	}
+
+	writeJSON(w, http.StatusOK, translationObjects)
+}
+
+func (h *ServiceHTTP) UpsertServiceTranslation(w http.ResponseWriter, r *http.Request) {
+	var request upsertTranslationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v := validation.New()
+	cmd := &dtos.UpsertServiceTranslationCommand{
+		ServiceId:   v.UUID("id", r.PathValue("id")),
+		Locale:      v.Locale("locale", r.PathValue("locale"), true),
+		Title:       v.Title("title", request.Title, true),
+		Description: v.Description("description", request.Description),
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	translation, err := h.ServiceUseCase.UpsertServiceTranslation(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceTranslationObject(translation))
+}
+
+func (h *ServiceHTTP) DeleteServiceTranslation(w http.ResponseWriter, r *http.Request) {
+	v := validation.New()
+	serviceId := v.UUID("id", r.PathValue("id"))
+	serviceLocale := v.Locale("locale", r.PathValue("locale"), true)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	err := h.ServiceUseCase.DeleteServiceTranslation(r.Context(), serviceId, serviceLocale)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toServiceTranslationObject(translation *models.ServiceTranslation) *serviceTranslationObject {
+	return &serviceTranslationObject{
+		ServiceId:   translation.ServiceId.String(),
+		Locale:      translation.Locale,
+		Title:       translation.Title,
+		Description: translation.Description,
+		CreatedTime: translation.CreatedTime.Format(time.RFC3339),
+		UpdatedTime: translation.UpdatedTime.Format(time.RFC3339),
+	}
+}
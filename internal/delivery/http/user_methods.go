@@ -0,0 +1,495 @@
+package http
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"Service/pkg/logger"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"io"
+	"net/http"
+	"time"
+)
+
+//go:embed openapi.json
+var openAPIDocument []byte
+
+type ServiceHTTP struct {
+	ServiceUseCase         usecase.ServiceUseCase
+	AbonementAccessUseCase usecase.AbonementAccessUseCase
+	cloudUseCase           usecase.CloudUseCase
+}
+
+type serviceObject struct {
+	Id          string   `json:"id"`
+	Title       string   `json:"title"`
+	Slug        string   `json:"slug"`
+	Description string   `json:"description,omitempty"`
+	Locale      string   `json:"locale,omitempty"`
+	Photo       string   `json:"photo"`
+	CategoryId  string   `json:"categoryId,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	CreatedTime string   `json:"createdTime"`
+	UpdatedTime string   `json:"updatedTime"`
+	// Terms is only set in the services of an abonement.
+	Terms *abonementServiceTermsObject `json:"terms,omitempty"`
+	// ComponentIds and BundleId are only set in the services of coaches and
+	// abonements: the components of a bundle, and the linked bundle of a
+	// flattened component.
+	ComponentIds []string `json:"componentIds,omitempty"`
+	BundleId     string   `json:"bundleId,omitempty"`
+}
+
+type servicesLinkRequest struct {
+	ServiceIds []string `json:"serviceIds"`
+}
+
+type ownerServicesResponse struct {
+	OwnerId  string           `json:"ownerId"`
+	Services []*serviceObject `json:"services"`
+}
+
+func Register(
+	mux *http.ServeMux,
+	ServiceUseCase usecase.ServiceUseCase,
+	abonementAccessUseCase usecase.AbonementAccessUseCase,
+	cloudUseCase usecase.CloudUseCase,
+) {
+	h := &ServiceHTTP{ServiceUseCase: ServiceUseCase, AbonementAccessUseCase: abonementAccessUseCase, cloudUseCase: cloudUseCase}
+
+	mux.HandleFunc("GET /openapi.json", h.OpenAPI)
+
+	mux.HandleFunc("GET /v1/services", h.GetServices)
+	mux.HandleFunc("POST /v1/services", h.CreateService)
+	mux.HandleFunc("GET /v1/services/{id}", h.GetServiceById)
+	mux.HandleFunc("GET /v1/services/by-slug/{slug}", h.GetServiceBySlug)
+	mux.HandleFunc("PATCH /v1/services/{id}", h.UpdateService)
+	mux.HandleFunc("DELETE /v1/services/{id}", h.DeleteServiceById)
+
+	mux.HandleFunc("GET /v1/services/{id}/translations", h.GetServiceTranslations)
+	mux.HandleFunc("PUT /v1/services/{id}/translations/{locale}", h.UpsertServiceTranslation)
+	mux.HandleFunc("DELETE /v1/services/{id}/translations/{locale}", h.DeleteServiceTranslation)
+
+	mux.HandleFunc("GET /v1/coaches/{coachId}/services", h.GetCoachServices)
+	mux.HandleFunc("POST /v1/coaches/{coachId}/services", h.CreateCoachServices)
+	mux.HandleFunc("PUT /v1/coaches/{coachId}/services", h.UpdateCoachServices)
+
+	mux.HandleFunc("GET /v1/abonements/{abonementId}/services", h.GetAbonementServices)
+	mux.HandleFunc("POST /v1/abonements/{abonementId}/services", h.CreateAbonementServices)
+	mux.HandleFunc("PUT /v1/abonements/{abonementId}/services", h.UpdateAbonementServices)
+
+	mux.HandleFunc("POST /v1/events", h.ReceivePeerEvent)
+}
+
+func (h *ServiceHTTP) OpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(openAPIDocument)
+}
+
+// GetServices takes the optional category and repeated tag query parameters.
+func (h *ServiceHTTP) GetServices(w http.ResponseWriter, r *http.Request) {
+	filter, ok := parseServiceFilter(w, r)
+	if !ok {
+		return
+	}
+
+	services, err := h.ServiceUseCase.GetServices(r.Context(), filter)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceObjects(r.Context(), h.cloudUseCase, services))
+}
+
+func (h *ServiceHTTP) GetServiceById(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	service, err := h.ServiceUseCase.GetServiceById(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceObject(r.Context(), h.cloudUseCase, service))
+}
+
+// GetServiceBySlug answers old slugs with a permanent redirect to the current
+// one, so bookmarked links keep working after a rename.
+func (h *ServiceHTTP) GetServiceBySlug(w http.ResponseWriter, r *http.Request) {
+	v := validation.New()
+	slug := v.Slug("slug", r.PathValue("slug"))
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	service, redirected, err := h.ServiceUseCase.GetServiceBySlug(r.Context(), slug)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if redirected {
+		http.Redirect(w, r, "/v1/services/by-slug/"+service.Slug, http.StatusMovedPermanently)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceObject(r.Context(), h.cloudUseCase, service))
+}
+
+func (h *ServiceHTTP) CreateService(w http.ResponseWriter, r *http.Request) {
+	title, photo, ok := parseServiceForm(w, r, true)
+	if !ok {
+		return
+	}
+
+	cmd := &dtos.CreateServiceCommand{
+		Id:           uuid.New(),
+		Title:        title,
+		PhotoContent: photo,
+	}
+
+	service, err := h.ServiceUseCase.CreateService(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toServiceObject(r.Context(), h.cloudUseCase, service))
+}
+
+func (h *ServiceHTTP) UpdateService(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	title, photo, ok := parseServiceForm(w, r, false)
+	if !ok {
+		return
+	}
+
+	cmd := &dtos.UpdateServiceCommand{
+		Id:           id,
+		Title:        title,
+		PhotoContent: photo,
+		UpdatedTime:  time.Now(),
+	}
+
+	service, err := h.ServiceUseCase.UpdateService(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceObject(r.Context(), h.cloudUseCase, service))
+}
+
+func (h *ServiceHTTP) DeleteServiceById(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	v := validation.New()
+	cmd := &dtos.DeleteServiceCommand{
+		Id:     v.UUID("id", r.PathValue("id")),
+		Policy: v.DeletePolicy("policy", query.Get("policy")),
+	}
+	if cmd.Policy == models.DeletePolicyReassign {
+		cmd.ReplacementServiceId = v.UUID("replacementServiceId", query.Get("replacementServiceId"))
+	} else if query.Has("replacementServiceId") {
+		v.Violation("replacementServiceId", "is only allowed with the reassign policy")
+	}
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	service, err := h.ServiceUseCase.DeleteServiceById(r.Context(), cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toServiceObject(r.Context(), h.cloudUseCase, service))
+}
+
+func (h *ServiceHTTP) GetCoachServices(w http.ResponseWriter, r *http.Request) {
+	coachId, ok := pathUUID(w, r, "coachId")
+	if !ok {
+		return
+	}
+
+	filter, expansion, ok := parseOwnerServicesQuery(w, r)
+	if !ok {
+		return
+	}
+
+	coachesServices, err := h.ServiceUseCase.GetCoachesServices(r.Context(), []uuid.UUID{coachId}, filter, expansion)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &ownerServicesResponse{
+		OwnerId:  coachId.String(),
+		Services: toServiceObjects(r.Context(), h.cloudUseCase, coachesServices[coachId]),
+	})
+}
+
+func (h *ServiceHTTP) CreateCoachServices(w http.ResponseWriter, r *http.Request) {
+	coachId, servicesIds, ok := parseLinkRequest(w, r, "coachId", true)
+	if !ok {
+		return
+	}
+
+	services, err := h.ServiceUseCase.CreateCoachServices(r.Context(), &dtos.CreateCoachServicesCommand{
+		CoachId:     coachId,
+		ServicesIds: servicesIds,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, &ownerServicesResponse{OwnerId: coachId.String(), Services: toServiceObjects(r.Context(), h.cloudUseCase, services)})
+}
+
+func (h *ServiceHTTP) UpdateCoachServices(w http.ResponseWriter, r *http.Request) {
+	coachId, servicesIds, ok := parseLinkRequest(w, r, "coachId", false)
+	if !ok {
+		return
+	}
+
+	services, err := h.ServiceUseCase.UpdateCoachServices(r.Context(), coachId, servicesIds)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &ownerServicesResponse{OwnerId: coachId.String(), Services: toServiceObjects(r.Context(), h.cloudUseCase, services)})
+}
+
+func (h *ServiceHTTP) GetAbonementServices(w http.ResponseWriter, r *http.Request) {
+	abonementId, ok := pathUUID(w, r, "abonementId")
+	if !ok {
+		return
+	}
+
+	filter, expansion, ok := parseOwnerServicesQuery(w, r)
+	if !ok {
+		return
+	}
+
+	abonementsServices, err := h.ServiceUseCase.GetAbonementsServices(r.Context(), []uuid.UUID{abonementId}, filter, expansion)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	abonementsTerms, err := h.AbonementAccessUseCase.GetAbonementsServicesTerms(r.Context(), []uuid.UUID{abonementId})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	services := toServiceObjects(r.Context(), h.cloudUseCase, abonementsServices[abonementId])
+	for i, service := range abonementsServices[abonementId] {
+		linkedId := service.Id
+		if service.BundleId != nil {
+			linkedId = *service.BundleId
+		}
+		if terms, ok := abonementsTerms[abonementId][linkedId]; ok {
+			services[i].Terms = toAbonementServiceTermsObject(terms)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, &ownerServicesResponse{
+		OwnerId:  abonementId.String(),
+		Services: services,
+	})
+}
+
+func (h *ServiceHTTP) CreateAbonementServices(w http.ResponseWriter, r *http.Request) {
+	abonementId, servicesIds, ok := parseLinkRequest(w, r, "abonementId", true)
+	if !ok {
+		return
+	}
+
+	services, err := h.ServiceUseCase.CreateAbonemntServices(r.Context(), &dtos.CreateAbonementServicesCommand{
+		AbonementId: abonementId,
+		ServicesIds: servicesIds,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, &ownerServicesResponse{OwnerId: abonementId.String(), Services: toServiceObjects(r.Context(), h.cloudUseCase, services)})
+}
+
+func (h *ServiceHTTP) UpdateAbonementServices(w http.ResponseWriter, r *http.Request) {
+	abonementId, servicesIds, ok := parseLinkRequest(w, r, "abonementId", false)
+	if !ok {
+		return
+	}
+
+	services, err := h.ServiceUseCase.UpdateAbonementServices(r.Context(), abonementId, servicesIds)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &ownerServicesResponse{OwnerId: abonementId.String(), Services: toServiceObjects(r.Context(), h.cloudUseCase, services)})
+}
+
+func parseServiceForm(w http.ResponseWriter, r *http.Request, titleRequired bool) (string, []byte, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, validation.MaxPhotoSize+1<<20)
+
+	if err := r.ParseMultipartForm(validation.MaxPhotoSize); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid multipart form")
+		return "", nil, false
+	}
+
+	v := validation.New()
+	title := v.Title("title", r.FormValue("title"), titleRequired)
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return "", nil, false
+	}
+
+	file, _, err := r.FormFile("photo")
+	if errors.Is(err, http.ErrMissingFile) {
+		return title, nil, true
+	}
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid photo")
+		return "", nil, false
+	}
+	defer file.Close()
+
+	photo, err := io.ReadAll(file)
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, "failed to read photo")
+		return "", nil, false
+	}
+
+	v.PhotoSize("photo", int64(len(photo)))
+	v.PhotoContentType("photo", http.DetectContentType(photo))
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return "", nil, false
+	}
+
+	return title, photo, true
+}
+
+func parseLinkRequest(w http.ResponseWriter, r *http.Request, ownerParam string, requireServices bool) (uuid.UUID, []uuid.UUID, bool) {
+	var request servicesLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return uuid.Nil, nil, false
+	}
+
+	v := validation.New()
+
+	ownerId := v.UUID(ownerParam, r.PathValue(ownerParam))
+	if requireServices {
+		v.NotEmpty("serviceIds", len(request.ServiceIds))
+	}
+	servicesIds := v.UUIDs("serviceIds", request.ServiceIds, validation.MaxLinkedServices, false)
+
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return uuid.Nil, nil, false
+	}
+
+	return ownerId, servicesIds, true
+}
+
+func parseServiceFilter(w http.ResponseWriter, r *http.Request) (*models.ServiceFilter, bool) {
+	query := r.URL.Query()
+
+	v := validation.New()
+	filter := v.ServiceFilter("category", query.Get("category"), "tag", query["tag"])
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return nil, false
+	}
+
+	return filter, true
+}
+
+// parseOwnerServicesQuery takes the service filter and the bundles query
+// parameter, bundle or flatten.
+func parseOwnerServicesQuery(w http.ResponseWriter, r *http.Request) (*models.ServiceFilter, models.BundleExpansion, bool) {
+	query := r.URL.Query()
+
+	v := validation.New()
+	filter := v.ServiceFilter("category", query.Get("category"), "tag", query["tag"])
+	expansion := v.BundleExpansion("bundles", query.Get("bundles"))
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return nil, "", false
+	}
+
+	return filter, expansion, true
+}
+
+func pathUUID(w http.ResponseWriter, r *http.Request, name string) (uuid.UUID, bool) {
+	v := validation.New()
+
+	id := v.UUID(name, r.PathValue(name))
+	if err := v.Err(); err != nil {
+		writeError(w, err)
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+func toServiceObject(ctx context.Context, cloudUseCase usecase.CloudUseCase, service *models.Service) *serviceObject {
+	photo, err := cloudUseCase.PhotoURL(ctx, service.Photo)
+	if err != nil {
+		logger.ErrorLogger.Printf("Failed to render url of photo %s: %v", service.Photo, err)
+	}
+
+	object := &serviceObject{
+		Id:          service.Id.String(),
+		Title:       service.Title,
+		Slug:        service.Slug,
+		Description: service.Description,
+		Locale:      service.Locale,
+		Photo:       photo,
+		Tags:        service.Tags,
+		CreatedTime: service.CreatedTime.Format(time.RFC3339),
+		UpdatedTime: service.UpdatedTime.Format(time.RFC3339),
+	}
+	if service.CategoryId != nil {
+		object.CategoryId = service.CategoryId.String()
+	}
+	for _, componentId := range service.ComponentIds {
+		object.ComponentIds = append(object.ComponentIds, componentId.String())
+	}
+	if service.BundleId != nil {
+		object.BundleId = service.BundleId.String()
+	}
+
+	return object
+}
+
+func toServiceObjects(ctx context.Context, cloudUseCase usecase.CloudUseCase, services []*models.Service) []*serviceObject {
+	serviceObjects := make([]*serviceObject, 0, len(services))
+	for _, service := range services {
+		serviceObjects = append(serviceObjects, toServiceObject(ctx, cloudUseCase, service))
+	}
+
+	return serviceObjects
+}
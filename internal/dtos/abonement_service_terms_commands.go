@@ -0,0 +1,17 @@
+package dtos
+
+import (
+	"Service/internal/models"
+	"github.com/google/uuid"
+)
+
+// SetAbonementServiceTermsCommand replaces every term of an existing
+// abonement-service link.
+type SetAbonementServiceTermsCommand struct {
+	AbonementId uuid.UUID
+	ServiceId   uuid.UUID
+	VisitQuota  *int
+	QuotaPeriod string
+	GuestPasses int
+	Windows     []models.OpeningInterval
+}
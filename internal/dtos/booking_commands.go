@@ -0,0 +1,18 @@
+package dtos
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SetBookingSettingsCommand struct {
+	ServiceId   uuid.UUID
+	SlotMinutes int
+	Capacity    int
+}
+
+type BookSlotCommand struct {
+	ServiceId uuid.UUID
+	MemberId  uuid.UUID
+	SlotStart time.Time
+}
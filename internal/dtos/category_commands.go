@@ -0,0 +1,16 @@
+package dtos
+
+import "github.com/google/uuid"
+
+type CreateCategoryCommand struct {
+	Name     string
+	ParentId *uuid.UUID
+}
+
+// UpdateCategoryCommand replaces the name and the parent of a category. A nil
+// parent moves the category to the root.
+type UpdateCategoryCommand struct {
+	Id       uuid.UUID
+	Name     string
+	ParentId *uuid.UUID
+}
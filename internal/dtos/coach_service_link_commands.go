@@ -0,0 +1,23 @@
+package dtos
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type CreateCoachServiceLinkCommand struct {
+	CoachId             uuid.UUID
+	ServiceId           uuid.UUID
+	QualificationLevel  string
+	CertificationExpiry *time.Time
+	PriceOverride       *int64
+}
+
+// UpdateCoachServiceLinkCommand replaces every attribute of the link.
+type UpdateCoachServiceLinkCommand struct {
+	CoachId             uuid.UUID
+	ServiceId           uuid.UUID
+	QualificationLevel  string
+	CertificationExpiry *time.Time
+	PriceOverride       *int64
+}
@@ -2,8 +2,11 @@ package dtos
 
 import "github.com/google/uuid"
 
+// CreateServiceCommand creates a service. PhotoContent is uploaded as the
+// photo of the new service, Photo points at an already stored one instead.
 type CreateServiceCommand struct {
-	Id    uuid.UUID `json:"id"`
-	Title string    `db:"title"`
-	Photo string    `db:"photo"`
+	Id           uuid.UUID `json:"id"`
+	Title        string    `db:"title"`
+	Photo        string    `db:"photo"`
+	PhotoContent []byte    `db:"-"`
 }
@@ -0,0 +1,12 @@
+package dtos
+
+import (
+	"Service/internal/models"
+	"github.com/google/uuid"
+)
+
+type DeleteServiceCommand struct {
+	Id                   uuid.UUID
+	Policy               models.DeletePolicy
+	ReplacementServiceId uuid.UUID
+}
@@ -0,0 +1,14 @@
+package dtos
+
+import "github.com/google/uuid"
+
+type CreatePhotoUploadCommand struct {
+	ServiceId   uuid.UUID
+	ContentType string
+	Size        int64
+}
+
+type ConfirmPhotoUploadCommand struct {
+	ServiceId uuid.UUID
+	Token     uuid.UUID
+}
@@ -0,0 +1,10 @@
+package dtos
+
+import "github.com/google/uuid"
+
+// SetBundleComponentsCommand replaces the components of a bundle. No
+// components turn the bundle back into a plain service.
+type SetBundleComponentsCommand struct {
+	BundleId     uuid.UUID
+	ComponentIds []uuid.UUID
+}
@@ -0,0 +1,22 @@
+package dtos
+
+import (
+	"Service/internal/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+// SetServiceHoursCommand replaces the whole week of the service.
+type SetServiceHoursCommand struct {
+	ServiceId uuid.UUID
+	TimeZone  string
+	Intervals []models.OpeningInterval
+}
+
+type AddServiceClosureCommand struct {
+	ServiceId uuid.UUID
+	StartDate time.Time
+	EndDate   time.Time
+	Kind      string
+	Reason    string
+}
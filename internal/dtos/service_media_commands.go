@@ -0,0 +1,12 @@
+package dtos
+
+import "github.com/google/uuid"
+
+type AddServiceMediaCommand struct {
+	ServiceId   uuid.UUID
+	MediaType   string
+	ContentType string
+	AltText     string
+	Cover       bool
+	Content     []byte
+}
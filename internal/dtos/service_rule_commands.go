@@ -0,0 +1,10 @@
+package dtos
+
+import "github.com/google/uuid"
+
+type CreateServiceRuleCommand struct {
+	Kind           string
+	ServiceId      uuid.UUID
+	OtherServiceId uuid.UUID
+	Description    string
+}
@@ -5,9 +5,15 @@ import (
 	"time"
 )
 
+// UpdateServiceCommand changes a service. PhotoContent, when set, is uploaded
+// and replaces the photo.
 type UpdateServiceCommand struct {
-	Id          uuid.UUID `db:"id"`
-	Title       string    `db:"title"`
-	Photo       string    `db:"photo"`
-	UpdatedTime time.Time `db:"updated_time"`
+	Id              uuid.UUID `db:"id"`
+	Title           string    `db:"title"`
+	NormalizedTitle string    `db:"normalized_title"`
+	Slug            string    `db:"slug"`
+	PreviousSlug    string    `db:"-"`
+	Photo           string    `db:"photo"`
+	PhotoContent    []byte    `db:"-"`
+	UpdatedTime     time.Time `db:"updated_time"`
 }
@@ -0,0 +1,10 @@
+package dtos
+
+import "github.com/google/uuid"
+
+type UpsertServiceTranslationCommand struct {
+	ServiceId   uuid.UUID `db:"service_id"`
+	Locale      string    `db:"locale"`
+	Title       string    `db:"title"`
+	Description string    `db:"description"`
+}
@@ -0,0 +1,17 @@
+package dtos
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// RecordVisitCommand reports a turnstile pass. EventId identifies the
+// turnstile event, so a retried report is recorded once.
+type RecordVisitCommand struct {
+	AbonementId uuid.UUID
+	ServiceId   uuid.UUID
+	MemberId    uuid.UUID
+	EventId     string
+	At          time.Time
+	Guest       bool
+}
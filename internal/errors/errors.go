@@ -1,13 +1,124 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 var (
 	VoidServiceData              = errors.New("void service data")
 	ServiceAlreadyExists         = errors.New("service already exists")
 	ServiceNotFound              = errors.New("service not found")
+	ServiceInUse                 = errors.New("service is in use")
+	ServiceLinkAlreadyExists     = errors.New("service link already exists")
+	ServiceTranslationNotFound   = errors.New("service translation not found")
 	CoachNotFound                = errors.New("coach not found")
 	AbonementNotFound            = errors.New("abonement not found")
 	InternalCoachServerError     = errors.New("internal coach server error")
 	InternalAbonementServerError = errors.New("internal abonement server error")
+	InvalidArgument              = errors.New("invalid argument")
+	ReconciliationInProgress     = errors.New("reconciliation is already in progress")
+	InvalidReplacementService    = errors.New("replacement service must differ from the deleted one")
+	ServicePhotoNotFound         = errors.New("service photo not found")
+	PhotoChanged                 = errors.New("photo changed since the given etag")
+	InvalidPhotoRange            = errors.New("offset is beyond the end of the photo")
+	ServiceMediaNotFound         = errors.New("service media not found")
+	ServiceMediaLimitReached     = errors.New("service media limit reached")
+	InvalidMediaOrder            = errors.New("media order must list every media of the service exactly once")
+	PhotoUploadNotFound          = errors.New("photo upload not found")
+	PhotoUploadExpired           = errors.New("photo upload expired")
+	PhotoUploadIncomplete        = errors.New("photo was not uploaded")
+	PhotoUploadMismatch          = errors.New("uploaded photo does not match the declared size or content type")
+	CategoryNotFound             = errors.New("category not found")
+	CategoryAlreadyExists        = errors.New("category with this name already exists under the parent")
+	CategoryInUse                = errors.New("category has subcategories or services")
+	InvalidCategoryParent        = errors.New("category cannot be moved under itself or its subcategories")
+	ServiceHoursNotFound         = errors.New("service has no opening hours")
+	ServiceClosureNotFound       = errors.New("service closure not found")
+	ServiceNotBookable           = errors.New("service has no booking settings")
+	SlotNotFound                 = errors.New("service has no slot starting at the given time")
+	SlotInPast                   = errors.New("slot has already started")
+	SlotFull                     = errors.New("slot is fully booked")
+	BookingNotFound              = errors.New("booking not found")
+	BookingAlreadyExists         = errors.New("member already booked the slot")
+	CoachServiceLinkNotFound     = errors.New("coach does not offer the service")
+	AbonementServiceLinkNotFound = errors.New("abonement does not include the service")
+	AccessOutsideHours           = errors.New("abonement does not grant the service at this time")
+	VisitQuotaUsedUp             = errors.New("visit quota of the period is used up")
+	GuestPassesUsedUp            = errors.New("guest passes of the period are used up")
+	VisitEventConflict           = errors.New("turnstile event was recorded for another abonement or service")
+	BundleCycle                  = errors.New("bundle cannot contain itself, directly or through other bundles")
+	ServiceRuleNotFound          = errors.New("service rule not found")
+	ServiceRuleAlreadyExists     = errors.New("service rule already exists")
+	ServiceRuleConflict          = errors.New("services cannot both require and exclude each other")
+	ServiceRulesViolated         = errors.New("services break service rules")
 )
+
+// ResourceError attaches the name (usually the id) of the resource a domain
+// error is about, so transports can report it without parsing messages.
+type ResourceError struct {
+	Err          error
+	ResourceName string
+}
+
+func NewResourceError(err error, resourceName string) error {
+	return &ResourceError{Err: err, ResourceName: resourceName}
+}
+
+func (e *ResourceError) Error() string {
+	return e.Err.Error() + ": " + e.ResourceName
+}
+
+func (e *ResourceError) Unwrap() error {
+	return e.Err
+}
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError collects every invalid field of a request. It unwraps to
+// InvalidArgument.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Add(field, description string) {
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Description: description})
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.Field+": "+violation.Description)
+	}
+
+	return InvalidArgument.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return InvalidArgument
+}
+
+type PreconditionViolation struct {
+	Type        string
+	Subject     string
+	Description string
+}
+
+// PreconditionError explains which state prevents an operation. It unwraps to
+// the domain error it details.
+type PreconditionError struct {
+	Err        error
+	Violations []PreconditionViolation
+}
+
+func (e *PreconditionError) Error() string {
+	return fmt.Sprintf("%s: %d violation(s)", e.Err.Error(), len(e.Violations))
+}
+
+func (e *PreconditionError) Unwrap() error {
+	return e.Err
+}
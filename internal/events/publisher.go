@@ -0,0 +1,76 @@
+package events
+
+import (
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Publisher interface {
+	Publish(ctx context.Context, event *models.ChangeEvent) error
+}
+
+// LogPublisher only records events in the log. It is used when no webhook
+// is configured.
+type LogPublisher struct{}
+
+func (LogPublisher) Publish(_ context.Context, event *models.ChangeEvent) error {
+	logger.InfoLogger.Printf("Change event %s for service %s: coaches=%v abonements=%v",
+		event.Type, event.ServiceId, event.CoachIds, event.AbonementIds)
+	return nil
+}
+
+// WebhookPublisher posts every event as JSON to each of the configured urls.
+type WebhookPublisher struct {
+	urls   []string
+	client *http.Client
+}
+
+func NewWebhookPublisher(urls []string, timeout time.Duration) *WebhookPublisher {
+	return &WebhookPublisher{
+		urls:   urls,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *WebhookPublisher) Publish(ctx context.Context, event *models.ChangeEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode change event: %w", err)
+	}
+
+	var errs []error
+	for _, url := range p.urls {
+		if err := p.post(ctx, url, body); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", url, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *WebhookPublisher) post(ctx context.Context, url string, body []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+
+	return nil
+}
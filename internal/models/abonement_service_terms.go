@@ -0,0 +1,107 @@
+package models
+
+import (
+	"bytes"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QuotaPeriodDay   = "day"
+	QuotaPeriodWeek  = "week"
+	QuotaPeriodMonth = "month"
+	// QuotaPeriodTotal never resets: the quota covers the whole abonement.
+	QuotaPeriodTotal = "total"
+)
+
+const (
+	AccessReasonNotIncluded  = "not_included"
+	AccessReasonOutsideHours = "outside_allowed_hours"
+	AccessReasonQuotaUsedUp  = "quota_used_up"
+)
+
+// AbonementServiceTerms limits how an abonement grants a service. A nil
+// VisitQuota means unlimited visits; VisitQuota and GuestPasses both renew
+// every QuotaPeriod. Windows are in the local time of the service, no
+// windows means any time.
+type AbonementServiceTerms struct {
+	AbonementId uuid.UUID
+	ServiceId   uuid.UUID
+	VisitQuota  *int
+	QuotaPeriod string
+	GuestPasses int
+	Windows     []OpeningInterval
+	UpdatedTime time.Time
+}
+
+// AllowsAt reports whether the local time falls into one of the windows.
+func (t *AbonementServiceTerms) AllowsAt(local time.Time) bool {
+	if len(t.Windows) == 0 {
+		return true
+	}
+
+	minute := local.Hour()*60 + local.Minute()
+	for _, window := range t.Windows {
+		if window.Weekday == local.Weekday() && window.OpensMinute <= minute && minute < window.ClosesMinute {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AbonementServiceGrant is the link through which an abonement grants a
+// service: the link of the service itself or of a bundle containing it. The
+// visits of every service in ServiceIds count against the quota of Terms.
+type AbonementServiceGrant struct {
+	Terms      *AbonementServiceTerms
+	ServiceIds []uuid.UUID
+}
+
+// GrantingLinks maps every service included through the linked services to
+// the linked service granting it: the service itself when it is linked, else
+// the bundle reaching it through the fewest levels, the smallest id on ties.
+// components lists the components of the bundles.
+func GrantingLinks(linkedIds []uuid.UUID, components map[uuid.UUID][]uuid.UUID) map[uuid.UUID]uuid.UUID {
+	granted := make(map[uuid.UUID]uuid.UUID, len(linkedIds))
+	level := make(map[uuid.UUID]uuid.UUID, len(linkedIds))
+	for _, linkedId := range linkedIds {
+		level[linkedId] = linkedId
+	}
+
+	for len(level) > 0 {
+		for serviceId, linkId := range level {
+			granted[serviceId] = linkId
+		}
+
+		next := make(map[uuid.UUID]uuid.UUID)
+		for bundleId, linkId := range level {
+			for _, componentId := range components[bundleId] {
+				if _, ok := granted[componentId]; ok {
+					continue
+				}
+				if current, ok := next[componentId]; ok && bytes.Compare(current[:], linkId[:]) <= 0 {
+					continue
+				}
+				next[componentId] = linkId
+			}
+		}
+		level = next
+	}
+
+	return granted
+}
+
+// AccessDecision answers whether an abonement grants a service at a time.
+// Reason is empty when access is granted. The remaining counts are those of
+// the quota period of At.
+type AccessDecision struct {
+	AbonementId          uuid.UUID
+	ServiceId            uuid.UUID
+	At                   time.Time
+	Granted              bool
+	Reason               string
+	Terms                *AbonementServiceTerms
+	RemainingVisits      *int
+	RemainingGuestPasses int
+}
@@ -0,0 +1,136 @@
+package models
+
+import (
+	"bytes"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestAbonementServiceTermsAllowsAt(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	local := func(value string) time.Time {
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", value, berlin)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+
+	windows := []OpeningInterval{
+		{Weekday: time.Monday, OpensMinute: 9 * 60, ClosesMinute: 12 * 60},
+		{Weekday: time.Monday, OpensMinute: 12 * 60, ClosesMinute: 15 * 60},
+		{Weekday: time.Sunday, OpensMinute: 1 * 60, ClosesMinute: 4 * 60},
+		{Weekday: time.Friday, OpensMinute: 20 * 60, ClosesMinute: 24 * 60},
+		{Weekday: time.Saturday, OpensMinute: 0, ClosesMinute: 2 * 60},
+	}
+
+	tests := []struct {
+		name    string
+		windows []OpeningInterval
+		at      time.Time
+		want    bool
+	}{
+		{name: "no windows", at: local("2026-01-06 03:00"), want: true},
+		{name: "at the opening", windows: windows, at: local("2026-01-05 09:00"), want: true},
+		{name: "before the opening", windows: windows, at: local("2026-01-05 08:59"), want: false},
+		{name: "where touching windows meet", windows: windows, at: local("2026-01-05 12:00"), want: true},
+		{name: "at the closing", windows: windows, at: local("2026-01-05 15:00"), want: false},
+		{name: "other weekday", windows: windows, at: local("2026-01-06 10:00"), want: false},
+		{name: "before midnight", windows: windows, at: local("2026-01-09 23:59"), want: true},
+		{name: "after midnight", windows: windows, at: local("2026-01-10 01:59"), want: true},
+		{name: "after the window past midnight", windows: windows, at: local("2026-01-10 02:00"), want: false},
+		{name: "clocks go forward", windows: windows, at: local("2026-03-29 03:30"), want: true},
+		{name: "clocks go back, first pass", windows: windows, at: time.Date(2026, 10, 25, 0, 30, 0, 0, time.UTC), want: true},
+		{name: "clocks go back, second pass", windows: windows, at: time.Date(2026, 10, 25, 1, 30, 0, 0, time.UTC), want: true},
+		{name: "clocks go back, after the window", windows: windows, at: time.Date(2026, 10, 25, 3, 0, 0, 0, time.UTC), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			terms := &AbonementServiceTerms{Windows: tt.windows}
+			if got := terms.AllowsAt(tt.at.In(berlin)); got != tt.want {
+				t.Errorf("AllowsAt(%s) = %v, want %v", tt.at.In(berlin), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuotaUsageRemaining(t *testing.T) {
+	quota := func(visits int) *int {
+		return &visits
+	}
+
+	tests := []struct {
+		name       string
+		terms      *AbonementServiceTerms
+		usage      *QuotaUsage
+		wantVisits *int
+		wantPasses int
+	}{
+		{name: "unlimited", terms: &AbonementServiceTerms{GuestPasses: 2}, usage: &QuotaUsage{Visits: 40, GuestVisits: 1}, wantPasses: 1},
+		{name: "partly used", terms: &AbonementServiceTerms{VisitQuota: quota(8), GuestPasses: 2}, usage: &QuotaUsage{Visits: 3}, wantVisits: quota(5), wantPasses: 2},
+		{name: "used up", terms: &AbonementServiceTerms{VisitQuota: quota(8)}, usage: &QuotaUsage{Visits: 8}, wantVisits: quota(0)},
+		{name: "quota lowered below usage", terms: &AbonementServiceTerms{VisitQuota: quota(4), GuestPasses: 1}, usage: &QuotaUsage{Visits: 6, GuestVisits: 3}, wantVisits: quota(0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visits, passes := tt.usage.Remaining(tt.terms)
+
+			if (visits == nil) != (tt.wantVisits == nil) || visits != nil && *visits != *tt.wantVisits {
+				t.Errorf("remaining visits = %v, want %v", visits, tt.wantVisits)
+			}
+			if passes != tt.wantPasses {
+				t.Errorf("remaining guest passes = %d, want %d", passes, tt.wantPasses)
+			}
+		})
+	}
+}
+
+func TestGrantingLinks(t *testing.T) {
+	spa, wellness, sauna, pool, steam, gym := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	smaller, larger := uuid.New(), uuid.New()
+	if bytes.Compare(smaller[:], larger[:]) > 0 {
+		smaller, larger = larger, smaller
+	}
+
+	components := map[uuid.UUID][]uuid.UUID{
+		spa:      {sauna, wellness},
+		wellness: {steam, pool},
+		smaller:  {gym},
+		larger:   {gym},
+	}
+
+	tests := []struct {
+		name      string
+		linkedIds []uuid.UUID
+		serviceId uuid.UUID
+		want      uuid.UUID
+		wantOk    bool
+	}{
+		{name: "linked directly", linkedIds: []uuid.UUID{spa}, serviceId: spa, want: spa, wantOk: true},
+		{name: "through a bundle", linkedIds: []uuid.UUID{spa}, serviceId: sauna, want: spa, wantOk: true},
+		{name: "through a nested bundle", linkedIds: []uuid.UUID{spa}, serviceId: steam, want: spa, wantOk: true},
+		{name: "direct link over the bundle", linkedIds: []uuid.UUID{spa, sauna}, serviceId: sauna, want: sauna, wantOk: true},
+		{name: "fewest levels", linkedIds: []uuid.UUID{spa, wellness}, serviceId: pool, want: wellness, wantOk: true},
+		{name: "smaller id on ties", linkedIds: []uuid.UUID{larger, smaller}, serviceId: gym, want: smaller, wantOk: true},
+		{name: "not reachable", linkedIds: []uuid.UUID{wellness}, serviceId: sauna},
+		{name: "nothing linked", serviceId: sauna},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := GrantingLinks(tt.linkedIds, components)[tt.serviceId]
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("granting link = %s, %v, want %s, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
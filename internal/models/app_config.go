@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+type ReconcileConfig struct {
+	Interval time.Duration
+	Options  ReconcileOptions
+}
+
+type AppConfig struct {
+	Cloud              *CloudConfig
+	ServerTLS          *TLSConfig
+	ClientTLS          *TLSConfig
+	Gateway            *GatewayConfig
+	Resilience         *ResilienceConfig
+	PeerCache          *PeerCacheConfig
+	Reconcile          *ReconcileConfig
+	Events             *EventsConfig
+	PhotoGC            *PhotoGCConfig
+	PhotoUpload        *PhotoUploadConfig
+	CertificationCheck *CertificationCheckConfig
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	ServiceDeletedEvent    = "service.deleted"
+	ServiceReassignedEvent = "service.reassigned"
+)
+
+type ChangeEvent struct {
+	Type                 string      `json:"type"`
+	ServiceId            uuid.UUID   `json:"serviceId"`
+	ReplacementServiceId *uuid.UUID  `json:"replacementServiceId,omitempty"`
+	CoachIds             []uuid.UUID `json:"coachIds,omitempty"`
+	AbonementIds         []uuid.UUID `json:"abonementIds,omitempty"`
+	OccurredTime         time.Time   `json:"occurredTime"`
+}
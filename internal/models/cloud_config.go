@@ -1,9 +1,23 @@
 package models
 
+import "time"
+
+const (
+	PhotoURLPublic    = "public"
+	PhotoURLPresigned = "presigned"
+)
+
 type CloudConfig struct {
 	EndPoint string
 	Region   string
 	Bucket   string
 	Key      string
 	Secret   string
+
+	// PhotoURLMode selects how photo keys are turned into urls for clients:
+	// PhotoURLPublic prefixes them with PublicBaseURL (e.g. a CDN), while
+	// PhotoURLPresigned signs a GET url valid for PresignTTL.
+	PhotoURLMode  string
+	PublicBaseURL string
+	PresignTTL    time.Duration
 }
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QualificationAssistant        = "assistant"
+	QualificationInstructor       = "instructor"
+	QualificationSeniorInstructor = "senior_instructor"
+	QualificationMaster           = "master"
+)
+
+// CoachServiceLink describes how a coach offers a service. PriceOverride is
+// in minor currency units and replaces the usual price for this coach only.
+type CoachServiceLink struct {
+	CoachId             uuid.UUID  `db:"coach_id"`
+	ServiceId           uuid.UUID  `db:"service_id"`
+	QualificationLevel  string     `db:"qualification_level"`
+	CertificationExpiry *time.Time `db:"certification_expiry"`
+	PriceOverride       *int64     `db:"price_override"`
+	// CertificationExpired is set by the certification check once the expiry
+	// date has passed, and cleared when a later expiry is saved.
+	CertificationExpired bool      `db:"certification_expired"`
+	UpdatedTime          time.Time `db:"updated_time"`
+}
+
+// CertificationExpiredOn tells whether a certification valid through expiry
+// has expired by the given day.
+func CertificationExpiredOn(expiry *time.Time, day time.Time) bool {
+	return expiry != nil && expiry.Format(DateLayout) < day.Format(DateLayout)
+}
+
+type CertificationCheckConfig struct {
+	Interval time.Duration
+}
+
+type CertificationCheckReport struct {
+	StartedTime  time.Time
+	Duration     time.Duration
+	FlaggedLinks []*CoachServiceLink
+}
@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+// EventsConfig lists the webhooks change events are posted to. Events are only
+// logged when no url is set.
+type EventsConfig struct {
+	WebhookURLs []string
+	Timeout     time.Duration
+}
@@ -0,0 +1,12 @@
+package models
+
+// GatewayConfig sets up the HTTP gateway. It shares the gRPC server
+// certificate and serves TLS only, clients either present a certificate signed
+// by the server's client CA or send AuthToken as a bearer token. DebugAddr
+// serves the expvar metrics on a separate listener that should only be
+// reachable from inside the deployment.
+type GatewayConfig struct {
+	Addr      string
+	AuthToken string
+	DebugAddr string
+}
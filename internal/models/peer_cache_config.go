@@ -0,0 +1,9 @@
+package models
+
+import "time"
+
+type PeerCacheConfig struct {
+	Capacity    int
+	PositiveTTL time.Duration
+	NegativeTTL time.Duration
+}
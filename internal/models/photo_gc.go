@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+type PhotoGCOptions struct {
+	DryRun bool
+	// GracePeriod protects objects that were uploaded but whose service row is
+	// not committed yet.
+	GracePeriod time.Duration
+}
+
+type PhotoGCConfig struct {
+	Interval time.Duration
+	Options  PhotoGCOptions
+}
+
+type PhotoGCReport struct {
+	DryRun         bool
+	StartedTime    time.Time
+	Duration       time.Duration
+	ObjectsScanned int
+	OrphanKeys     []string
+	DeletedObjects int
+}
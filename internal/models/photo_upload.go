@@ -0,0 +1,24 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// PhotoUpload is a photo a client was allowed to put straight into the
+// bucket. It is attached to the service only once the upload is confirmed.
+type PhotoUpload struct {
+	Token       uuid.UUID `db:"token"`
+	ServiceId   uuid.UUID `db:"service_id"`
+	ObjectKey   string    `db:"object_key"`
+	ContentType string    `db:"content_type"`
+	MaxSize     int64     `db:"max_size"`
+	ExpiresTime time.Time `db:"expires_time"`
+	CreatedTime time.Time `db:"created_time"`
+	UploadURL   string    `db:"-"`
+}
+
+type PhotoUploadConfig struct {
+	TTL             time.Duration
+	CleanupInterval time.Duration
+}
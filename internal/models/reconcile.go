@@ -0,0 +1,24 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ReconcileOptions struct {
+	DryRun         bool
+	BatchSize      int
+	CallsPerSecond int
+}
+
+type ReconcileReport struct {
+	DryRun              bool
+	StartedTime         time.Time
+	Duration            time.Duration
+	CoachIdsChecked     int
+	AbonementIdsChecked int
+	OrphanCoachIds      []uuid.UUID
+	OrphanAbonementIds  []uuid.UUID
+	Unverified          int
+	RemovedLinks        int64
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+type ResilienceConfig struct {
+	CallTimeout     time.Duration
+	MaxAttempts     int
+	BaseBackoff     time.Duration
+	MaxBackoff      time.Duration
+	FailureLimit    int
+	BreakerCooldown time.Duration
+}
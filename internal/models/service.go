@@ -6,9 +6,19 @@ import (
 )
 
 type Service struct {
-	Id          uuid.UUID `db:"id"`
-	Title       string    `db:"title"`
-	Photo       string    `db:"photo"`
-	UpdatedTime time.Time `db:"updated_time"`
-	CreatedTime time.Time `db:"created_time"`
+	Id              uuid.UUID  `db:"id"`
+	Title           string     `db:"title"`
+	NormalizedTitle string     `db:"normalized_title"`
+	Slug            string     `db:"slug"`
+	Photo           string     `db:"photo"`
+	CategoryId      *uuid.UUID `db:"category_id"`
+	Tags            []string   `db:"-"`
+	Description     string     `db:"-"`
+	Locale          string     `db:"-"`
+	UpdatedTime     time.Time  `db:"updated_time"`
+	CreatedTime     time.Time  `db:"created_time"`
+	// ComponentIds and BundleId are only set in the service lists of coaches
+	// and abonements, see BundleExpansion.
+	ComponentIds []uuid.UUID `db:"-"`
+	BundleId     *uuid.UUID  `db:"-"`
 }
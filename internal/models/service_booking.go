@@ -0,0 +1,50 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	BookingStatusActive    = "active"
+	BookingStatusCancelled = "cancelled"
+)
+
+// BookingSettings makes a service bookable. Opening windows are cut into
+// consecutive slots of SlotMinutes, each taking Capacity bookings.
+type BookingSettings struct {
+	ServiceId   uuid.UUID `db:"service_id"`
+	SlotMinutes int       `db:"slot_minutes"`
+	Capacity    int       `db:"capacity"`
+	UpdatedTime time.Time `db:"updated_time"`
+}
+
+func (s *BookingSettings) SlotDuration() time.Duration {
+	return time.Duration(s.SlotMinutes) * time.Minute
+}
+
+type Slot struct {
+	Start    time.Time `db:"slot_start"`
+	End      time.Time `db:"slot_end"`
+	Capacity int       `db:"capacity"`
+	Booked   int       `db:"booked"`
+}
+
+func (s *Slot) Available() int {
+	if s.Booked >= s.Capacity {
+		return 0
+	}
+
+	return s.Capacity - s.Booked
+}
+
+type Booking struct {
+	Id            uuid.UUID  `db:"id"`
+	ServiceId     uuid.UUID  `db:"service_id"`
+	MemberId      uuid.UUID  `db:"member_id"`
+	SlotStart     time.Time  `db:"slot_start"`
+	SlotEnd       time.Time  `db:"slot_end"`
+	Status        string     `db:"status"`
+	CreatedTime   time.Time  `db:"created_time"`
+	CancelledTime *time.Time `db:"cancelled_time"`
+}
@@ -0,0 +1,12 @@
+package models
+
+// BundleExpansion tells how bundles linked to coaches and abonements are
+// listed. BundleExpansionBundle lists the bundle itself with the ids of its
+// components, BundleExpansionFlatten replaces it by the services it is
+// ultimately made of, each carrying the id of the linked bundle.
+type BundleExpansion string
+
+const (
+	BundleExpansionBundle  BundleExpansion = "bundle"
+	BundleExpansionFlatten BundleExpansion = "flatten"
+)
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ServiceCategory is a node of the category tree. Root categories have no
+// parent.
+type ServiceCategory struct {
+	Id          uuid.UUID  `db:"id"`
+	ParentId    *uuid.UUID `db:"parent_id"`
+	Name        string     `db:"name"`
+	CreatedTime time.Time  `db:"created_time"`
+	UpdatedTime time.Time  `db:"updated_time"`
+}
+
+// ServiceClassification is the category and the tags of one service.
+type ServiceClassification struct {
+	ServiceId  uuid.UUID
+	CategoryId *uuid.UUID
+	Tags       []string
+}
+
+// ServiceFilter narrows service lists. A category matches its whole subtree,
+// and a service has to carry every tag to match.
+type ServiceFilter struct {
+	CategoryId *uuid.UUID
+	Tags       []string
+}
+
+func (f *ServiceFilter) Empty() bool {
+	return f == nil || f.CategoryId == nil && len(f.Tags) == 0
+}
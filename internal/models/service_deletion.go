@@ -0,0 +1,29 @@
+package models
+
+import "github.com/google/uuid"
+
+type DeletePolicy string
+
+const (
+	DeletePolicyRestrict DeletePolicy = "restrict"
+	DeletePolicyCascade  DeletePolicy = "cascade"
+	DeletePolicyReassign DeletePolicy = "reassign"
+)
+
+// ServiceReferences lists the owners whose links pointed at a service, the
+// bundles it was a component of, how many visits were recorded against it and
+// how many bookings of upcoming slots are still active. BrokenRules is only
+// set when moving the links to a replacement would break service rules.
+type ServiceReferences struct {
+	CoachIds       []uuid.UUID
+	AbonementIds   []uuid.UUID
+	BundleIds      []uuid.UUID
+	Visits         int64
+	ActiveBookings int64
+	BrokenRules    []*ServiceRuleViolation
+}
+
+func (r *ServiceReferences) Empty() bool {
+	return len(r.CoachIds) == 0 && len(r.AbonementIds) == 0 && len(r.BundleIds) == 0 &&
+		r.Visits == 0 && r.ActiveBookings == 0
+}
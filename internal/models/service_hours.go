@@ -0,0 +1,77 @@
+package models
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+const (
+	ClosureKindHoliday     = "holiday"
+	ClosureKindMaintenance = "maintenance"
+
+	// DateLayout is the format of closure dates.
+	DateLayout = "2006-01-02"
+
+	MinutesPerDay = 24 * 60
+)
+
+// OpeningInterval is a span of one weekday in the local time of the service.
+// ClosesMinute 1440 means the interval runs until midnight.
+type OpeningInterval struct {
+	Weekday      time.Weekday `db:"weekday"`
+	OpensMinute  int          `db:"opens_minute"`
+	ClosesMinute int          `db:"closes_minute"`
+}
+
+type ServiceHours struct {
+	ServiceId   uuid.UUID         `db:"service_id"`
+	TimeZone    string            `db:"time_zone"`
+	Intervals   []OpeningInterval `db:"-"`
+	UpdatedTime time.Time         `db:"updated_time"`
+}
+
+// ServiceClosure closes the service for whole local days. Dates carry no time
+// zone, they are read in the time zone of the service.
+type ServiceClosure struct {
+	Id          uuid.UUID `db:"id"`
+	ServiceId   uuid.UUID `db:"service_id"`
+	StartDate   time.Time `db:"start_date"`
+	EndDate     time.Time `db:"end_date"`
+	Kind        string    `db:"kind"`
+	Reason      string    `db:"reason"`
+	CreatedTime time.Time `db:"created_time"`
+}
+
+// Covers reports whether the closure includes the local date, given as
+// DateLayout.
+func (c *ServiceClosure) Covers(date string) bool {
+	return c.StartDate.Format(DateLayout) <= date && date <= c.EndDate.Format(DateLayout)
+}
+
+// Clock formats minutes since midnight as HH:MM.
+func Clock(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+func WeekdayName(day time.Weekday) string {
+	return strings.ToLower(day.String())
+}
+
+// OpeningWindow is a span the service is open, in absolute time. Intervals
+// that touch, like 22:00-24:00 and 00:00-02:00 of the next day, form one
+// window.
+type OpeningWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// OpenStatus answers whether a service is open at a moment. Window is the
+// current window when open, the next one otherwise; nil when there is none
+// within the lookahead.
+type OpenStatus struct {
+	At     time.Time
+	Open   bool
+	Window *OpeningWindow
+}
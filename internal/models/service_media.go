@@ -0,0 +1,40 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+const (
+	MediaTypeImage       = "image"
+	MediaTypeVideoPoster = "video_poster"
+
+	ServiceMediaPrefix = "service-media/"
+)
+
+type ServiceMedia struct {
+	Id          uuid.UUID `db:"id"`
+	ServiceId   uuid.UUID `db:"service_id"`
+	ObjectKey   string    `db:"object_key"`
+	MediaType   string    `db:"media_type"`
+	ContentType string    `db:"content_type"`
+	AltText     string    `db:"alt_text"`
+	Position    int       `db:"position"`
+	Cover       bool      `db:"is_cover"`
+	CreatedTime time.Time `db:"created_time"`
+}
+
+// ServiceMediaKey is the object name of a gallery item. Gallery objects live
+// under their own prefix and are owned by the gallery, not by Service.Photo.
+func ServiceMediaKey(serviceId uuid.UUID, mediaId uuid.UUID) string {
+	return ServiceMediaPrefix + serviceId.String() + "/" + mediaId.String()
+}
+
+func ServiceMediaPrefixOf(serviceId uuid.UUID) string {
+	return ServiceMediaPrefix + serviceId.String() + "/"
+}
+
+func IsServiceMediaKey(key string) bool {
+	return strings.HasPrefix(key, ServiceMediaPrefix)
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"io"
+	"time"
+)
+
+const ServicePhotoPrefix = "service/"
+
+// ServicePhotoKey returns a new object name for a photo of the service. Every
+// upload gets its own version under the service id, so a replaced photo is
+// never overwritten in place and cached urls change with the photo.
+func ServicePhotoKey(serviceId uuid.UUID) string {
+	return ServicePhotoPrefix + serviceId.String() + "/" + uuid.NewString()
+}
+
+type StoredObject struct {
+	Key          string
+	LastModified time.Time
+	Size         int64
+	ContentType  string
+}
+
+type ObjectReadOptions struct {
+	Offset int64
+	// IfMatch makes a resumed read fail when the object changed meanwhile.
+	IfMatch string
+	// IfNoneMatch skips the body when the client already has this version.
+	IfNoneMatch string
+}
+
+// ObjectStream is an opened object. Body is nil when NotModified is set.
+type ObjectStream struct {
+	Body        io.ReadCloser
+	Size        int64
+	Offset      int64
+	ContentType string
+	ETag        string
+	NotModified bool
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	ReportServiceUsage = "service_usage"
+	ReportCoverageGaps = "coverage_gaps"
+	ReportVisitTrend   = "visit_trend"
+)
+
+const (
+	TrendGranularityDay   = "day"
+	TrendGranularityWeek  = "week"
+	TrendGranularityMonth = "month"
+)
+
+// ReportQuery scopes a report. Visits are counted within [From, To); trends
+// are bucketed by Granularity in TimeZone and may be narrowed to one service.
+type ReportQuery struct {
+	From        time.Time
+	To          time.Time
+	ServiceId   *uuid.UUID
+	Granularity string
+	TimeZone    string
+}
+
+// ServiceUsage sums up how a service is covered and used. Members counts the
+// distinct members among the visits.
+type ServiceUsage struct {
+	ServiceId   uuid.UUID `db:"service_id"`
+	Title       string    `db:"title"`
+	Coaches     int       `db:"coaches"`
+	Abonements  int       `db:"abonements"`
+	Visits      int       `db:"visits"`
+	GuestVisits int       `db:"guest_visits"`
+	Members     int       `db:"members"`
+}
+
+// TrendPoint counts the visits of the period starting at PeriodStart.
+type TrendPoint struct {
+	PeriodStart time.Time `db:"period_start"`
+	Visits      int       `db:"visits"`
+	GuestVisits int       `db:"guest_visits"`
+	Members     int       `db:"members"`
+}
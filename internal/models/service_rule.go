@@ -0,0 +1,87 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	ServiceRuleRequires = "requires"
+	ServiceRuleExcludes = "excludes"
+)
+
+// ServiceRule constrains the services of one abonement. ServiceRuleRequires
+// asks for OtherServiceId wherever ServiceId is included, ServiceRuleExcludes
+// forbids the two together in either direction.
+type ServiceRule struct {
+	Id             uuid.UUID `db:"id"`
+	Kind           string    `db:"kind"`
+	ServiceId      uuid.UUID `db:"service_id"`
+	OtherServiceId uuid.UUID `db:"other_service_id"`
+	Description    string    `db:"description"`
+	CreatedTime    time.Time `db:"created_time"`
+}
+
+// ViolatedBy tells whether the rule is broken by a set of services.
+func (r *ServiceRule) ViolatedBy(services map[uuid.UUID]bool) bool {
+	switch r.Kind {
+	case ServiceRuleRequires:
+		return services[r.ServiceId] && !services[r.OtherServiceId]
+	case ServiceRuleExcludes:
+		return services[r.ServiceId] && services[r.OtherServiceId]
+	default:
+		return false
+	}
+}
+
+// BrokenRules returns the rules broken by a set of services, in the order of
+// rules.
+func BrokenRules(services map[uuid.UUID]bool, rules []*ServiceRule) []*ServiceRule {
+	var broken []*ServiceRule
+	for _, rule := range rules {
+		if rule.ViolatedBy(services) {
+			broken = append(broken, rule)
+		}
+	}
+
+	return broken
+}
+
+// ServiceRuleViolation is a rule broken by the services of an abonement.
+type ServiceRuleViolation struct {
+	AbonementId uuid.UUID
+	Rule        *ServiceRule
+}
+
+// AddedViolations returns the violations of after that were not already in
+// before, so a change is only blamed for what it breaks.
+func AddedViolations(before []*ServiceRuleViolation, after []*ServiceRuleViolation) []*ServiceRuleViolation {
+	type key struct {
+		abonementId uuid.UUID
+		ruleId      uuid.UUID
+	}
+
+	existing := make(map[key]bool, len(before))
+	for _, violation := range before {
+		existing[key{violation.AbonementId, violation.Rule.Id}] = true
+	}
+
+	var added []*ServiceRuleViolation
+	for _, violation := range after {
+		if !existing[key{violation.AbonementId, violation.Rule.Id}] {
+			added = append(added, violation)
+		}
+	}
+
+	return added
+}
+
+// ServiceSetCheck is the outcome of checking a set of services against the
+// rules. Services contained in bundles of the set count as included.
+type ServiceSetCheck struct {
+	Violations []*ServiceRule
+}
+
+func (c *ServiceSetCheck) Valid() bool {
+	return len(c.Violations) == 0
+}
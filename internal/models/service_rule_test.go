@@ -0,0 +1,144 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"testing"
+)
+
+func TestServiceRuleViolatedBy(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	requires := &ServiceRule{Kind: ServiceRuleRequires, ServiceId: a, OtherServiceId: b}
+	excludes := &ServiceRule{Kind: ServiceRuleExcludes, ServiceId: a, OtherServiceId: b}
+	unknown := &ServiceRule{Kind: "unknown", ServiceId: a, OtherServiceId: b}
+
+	tests := []struct {
+		name     string
+		rule     *ServiceRule
+		services []uuid.UUID
+		want     bool
+	}{
+		{name: "requires, both present", rule: requires, services: []uuid.UUID{a, b}, want: false},
+		{name: "requires, other missing", rule: requires, services: []uuid.UUID{a, c}, want: true},
+		{name: "requires, service missing", rule: requires, services: []uuid.UUID{b}, want: false},
+		{name: "requires, only the other", rule: requires, services: []uuid.UUID{b, c}, want: false},
+		{name: "excludes, both present", rule: excludes, services: []uuid.UUID{a, b}, want: true},
+		{name: "excludes, reversed order", rule: excludes, services: []uuid.UUID{b, a}, want: true},
+		{name: "excludes, one present", rule: excludes, services: []uuid.UUID{a, c}, want: false},
+		{name: "empty set", rule: requires, want: false},
+		{name: "unknown kind", rule: unknown, services: []uuid.UUID{a}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			services := make(map[uuid.UUID]bool, len(tt.services))
+			for _, id := range tt.services {
+				services[id] = true
+			}
+
+			if got := tt.rule.ViolatedBy(services); got != tt.want {
+				t.Errorf("ViolatedBy = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBrokenRules(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	rules := []*ServiceRule{
+		{Id: uuid.New(), Kind: ServiceRuleRequires, ServiceId: a, OtherServiceId: c},
+		{Id: uuid.New(), Kind: ServiceRuleExcludes, ServiceId: a, OtherServiceId: b},
+		{Id: uuid.New(), Kind: ServiceRuleRequires, ServiceId: b, OtherServiceId: a},
+	}
+
+	tests := []struct {
+		name     string
+		services []uuid.UUID
+		want     []*ServiceRule
+	}{
+		{name: "nothing broken", services: []uuid.UUID{a, c}},
+		{name: "broken in rule order", services: []uuid.UUID{b, a}, want: []*ServiceRule{rules[0], rules[1]}},
+		{name: "one broken", services: []uuid.UUID{b}, want: []*ServiceRule{rules[2]}},
+		{name: "empty set"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			services := make(map[uuid.UUID]bool, len(tt.services))
+			for _, id := range tt.services {
+				services[id] = true
+			}
+
+			got := BrokenRules(services, rules)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d broken rules, want %d", len(got), len(tt.want))
+			}
+			for i, rule := range got {
+				if rule != tt.want[i] {
+					t.Errorf("broken rule %d = %s, want %s", i, rule.Id, tt.want[i].Id)
+				}
+			}
+		})
+	}
+}
+
+func TestAddedViolations(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	requires := &ServiceRule{Id: uuid.New(), Kind: ServiceRuleRequires}
+	excludes := &ServiceRule{Id: uuid.New(), Kind: ServiceRuleExcludes}
+
+	violation := func(abonementId uuid.UUID, rule *ServiceRule) *ServiceRuleViolation {
+		return &ServiceRuleViolation{AbonementId: abonementId, Rule: rule}
+	}
+
+	tests := []struct {
+		name   string
+		before []*ServiceRuleViolation
+		after  []*ServiceRuleViolation
+		want   []*ServiceRuleViolation
+	}{
+		{name: "nothing broken"},
+		{
+			name:  "newly broken",
+			after: []*ServiceRuleViolation{violation(first, requires)},
+			want:  []*ServiceRuleViolation{violation(first, requires)},
+		},
+		{
+			name:   "already broken",
+			before: []*ServiceRuleViolation{violation(first, requires)},
+			after:  []*ServiceRuleViolation{violation(first, requires)},
+		},
+		{
+			name:   "fixed",
+			before: []*ServiceRuleViolation{violation(first, requires)},
+		},
+		{
+			name:   "same rule in another abonement",
+			before: []*ServiceRuleViolation{violation(first, requires)},
+			after:  []*ServiceRuleViolation{violation(first, requires), violation(second, requires)},
+			want:   []*ServiceRuleViolation{violation(second, requires)},
+		},
+		{
+			name:   "another rule in the same abonement",
+			before: []*ServiceRuleViolation{violation(first, requires)},
+			after:  []*ServiceRuleViolation{violation(first, excludes), violation(first, requires)},
+			want:   []*ServiceRuleViolation{violation(first, excludes)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AddedViolations(tt.before, tt.after)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d added violations, want %d", len(got), len(tt.want))
+			}
+			for i, added := range got {
+				if added.AbonementId != tt.want[i].AbonementId || added.Rule != tt.want[i].Rule {
+					t.Errorf("added violation %d = %s/%s, want %s/%s",
+						i, added.AbonementId, added.Rule.Id, tt.want[i].AbonementId, tt.want[i].Rule.Id)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ServiceTranslation struct {
+	ServiceId   uuid.UUID `db:"service_id"`
+	Locale      string    `db:"locale"`
+	Title       string    `db:"title"`
+	Description string    `db:"description"`
+	CreatedTime time.Time `db:"created_time"`
+	UpdatedTime time.Time `db:"updated_time"`
+}
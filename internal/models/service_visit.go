@@ -0,0 +1,76 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Visit is a use of a service under an abonement, reported by a turnstile.
+// EventId is the id of the turnstile event and makes recording idempotent.
+type Visit struct {
+	Id           uuid.UUID `db:"id"`
+	EventId      string    `db:"event_id"`
+	AbonementId  uuid.UUID `db:"abonement_id"`
+	ServiceId    uuid.UUID `db:"service_id"`
+	MemberId     uuid.UUID `db:"member_id"`
+	Guest        bool      `db:"guest"`
+	VisitedTime  time.Time `db:"visited_time"`
+	RecordedTime time.Time `db:"recorded_time"`
+}
+
+// QuotaPeriodBounds is the quota period a visit is counted in. Nil bounds are
+// open, as for QuotaPeriodTotal.
+type QuotaPeriodBounds struct {
+	Start *time.Time
+	End   *time.Time
+}
+
+// PeriodBounds returns the quota period containing local. Weeks start on
+// Monday; total quotas are not bounded.
+func PeriodBounds(period string, local time.Time) *QuotaPeriodBounds {
+	year, month, day := local.Date()
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, local.Location())
+
+	var start, end time.Time
+	switch period {
+	case QuotaPeriodDay:
+		start, end = midnight, midnight.AddDate(0, 0, 1)
+	case QuotaPeriodWeek:
+		start = midnight.AddDate(0, 0, -(int(local.Weekday())+6)%7)
+		end = start.AddDate(0, 0, 7)
+	case QuotaPeriodMonth:
+		start = time.Date(year, month, 1, 0, 0, 0, 0, local.Location())
+		end = start.AddDate(0, 1, 0)
+	default:
+		return &QuotaPeriodBounds{}
+	}
+
+	return &QuotaPeriodBounds{Start: &start, End: &end}
+}
+
+// QuotaUsage counts the visits and guest visits of a quota period.
+type QuotaUsage struct {
+	Visits      int `db:"visits"`
+	GuestVisits int `db:"guest_visits"`
+}
+
+// Remaining returns the visits and guest passes left in the period, nil
+// visits when they are unlimited.
+func (usage *QuotaUsage) Remaining(terms *AbonementServiceTerms) (*int, int) {
+	var remainingVisits *int
+	if terms.VisitQuota != nil {
+		remaining := max(*terms.VisitQuota-usage.Visits, 0)
+		remainingVisits = &remaining
+	}
+
+	return remainingVisits, max(terms.GuestPasses-usage.GuestVisits, 0)
+}
+
+// VisitReceipt is the answer to a recorded visit. Duplicate is set when the
+// turnstile event was already recorded, Visit is then the original visit.
+type VisitReceipt struct {
+	Visit                *Visit
+	Duplicate            bool
+	RemainingVisits      *int
+	RemainingGuestPasses int
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestPeriodBounds(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	local := func(value string) time.Time {
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", value, berlin)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+
+	tests := []struct {
+		name   string
+		period string
+		at     string
+		start  string
+		end    string
+		hours  float64
+	}{
+		{name: "day", period: QuotaPeriodDay, at: "2026-01-05 12:00", start: "2026-01-05 00:00", end: "2026-01-06 00:00", hours: 24},
+		{name: "day at midnight", period: QuotaPeriodDay, at: "2026-01-05 00:00", start: "2026-01-05 00:00", end: "2026-01-06 00:00", hours: 24},
+		{name: "day clocks go forward", period: QuotaPeriodDay, at: "2026-03-29 12:00", start: "2026-03-29 00:00", end: "2026-03-30 00:00", hours: 23},
+		{name: "day clocks go back", period: QuotaPeriodDay, at: "2026-10-25 12:00", start: "2026-10-25 00:00", end: "2026-10-26 00:00", hours: 25},
+		{name: "week on monday", period: QuotaPeriodWeek, at: "2026-01-05 00:00", start: "2026-01-05 00:00", end: "2026-01-12 00:00", hours: 168},
+		{name: "week on sunday", period: QuotaPeriodWeek, at: "2026-01-11 23:59", start: "2026-01-05 00:00", end: "2026-01-12 00:00", hours: 168},
+		{name: "week clocks go forward", period: QuotaPeriodWeek, at: "2026-03-29 12:00", start: "2026-03-23 00:00", end: "2026-03-30 00:00", hours: 167},
+		{name: "week clocks go back", period: QuotaPeriodWeek, at: "2026-10-26 08:00", start: "2026-10-26 00:00", end: "2026-11-02 00:00", hours: 168},
+		{name: "week across the new year", period: QuotaPeriodWeek, at: "2026-12-31 12:00", start: "2026-12-28 00:00", end: "2027-01-04 00:00", hours: 168},
+		{name: "month first day", period: QuotaPeriodMonth, at: "2026-02-01 00:00", start: "2026-02-01 00:00", end: "2026-03-01 00:00", hours: 28 * 24},
+		{name: "month last minute", period: QuotaPeriodMonth, at: "2026-02-28 23:59", start: "2026-02-01 00:00", end: "2026-03-01 00:00", hours: 28 * 24},
+		{name: "month clocks go forward", period: QuotaPeriodMonth, at: "2026-03-31 12:00", start: "2026-03-01 00:00", end: "2026-04-01 00:00", hours: 31*24 - 1},
+		{name: "month across the new year", period: QuotaPeriodMonth, at: "2026-12-15 12:00", start: "2026-12-01 00:00", end: "2027-01-01 00:00", hours: 31 * 24},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bounds := PeriodBounds(tt.period, local(tt.at))
+
+			if bounds.Start == nil || bounds.End == nil {
+				t.Fatalf("bounds are open, want [%s, %s)", tt.start, tt.end)
+			}
+			if !bounds.Start.Equal(local(tt.start)) || !bounds.End.Equal(local(tt.end)) {
+				t.Errorf("bounds = [%s, %s), want [%s, %s)", bounds.Start, bounds.End, tt.start, tt.end)
+			}
+			if hours := bounds.End.Sub(*bounds.Start).Hours(); hours != tt.hours {
+				t.Errorf("period lasts %v hours, want %v", hours, tt.hours)
+			}
+		})
+	}
+
+	t.Run("total", func(t *testing.T) {
+		bounds := PeriodBounds(QuotaPeriodTotal, local("2026-01-05 12:00"))
+		if bounds.Start != nil || bounds.End != nil {
+			t.Errorf("bounds = %v, want open", bounds)
+		}
+	})
+}
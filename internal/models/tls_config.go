@@ -0,0 +1,43 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+type TLSConfig struct {
+	CertFile       string
+	KeyFile        string
+	CAFile         string
+	ReloadInterval time.Duration
+}
+
+// Enabled tells whether any TLS setting is present. Partial settings count as
+// enabled so that Validate rejects them instead of falling back to plaintext.
+func (c *TLSConfig) Enabled() bool {
+	return c != nil && (c.CertFile != "" || c.KeyFile != "" || c.CAFile != "")
+}
+
+// HasKeyPair tells whether a certificate is presented to the other side.
+func (c *TLSConfig) HasKeyPair() bool {
+	return c != nil && c.CertFile != "" && c.KeyFile != ""
+}
+
+// Validate checks that the settings form a usable set. A client may trust a CA
+// without presenting a certificate of its own, a server always needs its key
+// pair.
+func (c *TLSConfig) Validate(requireKeyPair bool) error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return errors.New("TLS certificate and key files must be set together")
+	}
+
+	if requireKeyPair && !c.HasKeyPair() {
+		return errors.New("TLS CA file is set without a certificate and key")
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package postgres
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+const abonementServiceTermsColumns = `
+	abonement_id, service_id, visit_quota, quota_period, guest_passes, access_windows, updated_time`
+
+// accessWindow is the stored form of an access window.
+type accessWindow struct {
+	Weekday int `json:"weekday"`
+	Opens   int `json:"opens"`
+	Closes  int `json:"closes"`
+}
+
+type abonementServiceTermsRow struct {
+	AbonementId   uuid.UUID `db:"abonement_id"`
+	ServiceId     uuid.UUID `db:"service_id"`
+	VisitQuota    *int      `db:"visit_quota"`
+	QuotaPeriod   string    `db:"quota_period"`
+	GuestPasses   int       `db:"guest_passes"`
+	AccessWindows []byte    `db:"access_windows"`
+	UpdatedTime   time.Time `db:"updated_time"`
+}
+
+func (row *abonementServiceTermsRow) toTerms() (*models.AbonementServiceTerms, error) {
+	var windows []accessWindow
+	if err := json.Unmarshal(row.AccessWindows, &windows); err != nil {
+		return nil, fmt.Errorf("failed to decode access windows: %w", err)
+	}
+
+	terms := &models.AbonementServiceTerms{
+		AbonementId: row.AbonementId,
+		ServiceId:   row.ServiceId,
+		VisitQuota:  row.VisitQuota,
+		QuotaPeriod: row.QuotaPeriod,
+		GuestPasses: row.GuestPasses,
+		Windows:     make([]models.OpeningInterval, 0, len(windows)),
+		UpdatedTime: row.UpdatedTime,
+	}
+
+	for _, window := range windows {
+		terms.Windows = append(terms.Windows, models.OpeningInterval{
+			Weekday:      time.Weekday(window.Weekday),
+			OpensMinute:  window.Opens,
+			ClosesMinute: window.Closes,
+		})
+	}
+
+	return terms, nil
+}
+
+func (serviceRep *ServiceRepository) GetAbonementServiceTerms(
+	ctx context.Context,
+	abonementId uuid.UUID,
+	serviceId uuid.UUID,
+) (*models.AbonementServiceTerms, error) {
+
+	row := &abonementServiceTermsRow{}
+
+	err := serviceRep.db.GetContext(ctx, row, `
+		SELECT `+abonementServiceTermsColumns+` FROM "abonement_service"
+		WHERE abonement_id = $1 AND service_id = $2
+		LIMIT 1`, abonementId, serviceId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.AbonementServiceLinkNotFound
+		}
+		logger.ErrorLogger.Printf("Error GetAbonementServiceTerms: %v", err)
+		return nil, err
+	}
+
+	return row.toTerms()
+}
+
+// GetAbonementServiceGrant resolves the link through which the abonement
+// grants the service, directly or through bundles.
+func (serviceRep *ServiceRepository) GetAbonementServiceGrant(
+	ctx context.Context,
+	abonementId uuid.UUID,
+	serviceId uuid.UUID,
+) (*models.AbonementServiceGrant, error) {
+
+	return abonementServiceGrant(ctx, serviceRep.db, abonementId, serviceId, "")
+}
+
+// abonementServiceGrant resolves the granting link, see models.GrantingLinks,
+// and reads its terms with the row lock clause given.
+func abonementServiceGrant(
+	ctx context.Context,
+	q sqlx.QueryerContext,
+	abonementId uuid.UUID,
+	serviceId uuid.UUID,
+	lock string,
+) (*models.AbonementServiceGrant, error) {
+
+	var linkedIds []uuid.UUID
+	err := sqlx.SelectContext(ctx, q, &linkedIds,
+		`SELECT service_id FROM "abonement_service" WHERE abonement_id = $1`, abonementId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get abonement services: %w", err)
+	}
+
+	var edges []struct {
+		BundleId    uuid.UUID `db:"bundle_id"`
+		ComponentId uuid.UUID `db:"component_id"`
+	}
+	err = sqlx.SelectContext(ctx, q, &edges, `
+		WITH RECURSIVE reachable AS (
+			SELECT unnest($1::uuid[]) AS id
+			UNION
+			SELECT c.component_id FROM "service_bundle_component" c JOIN reachable ON c.bundle_id = reachable.id
+		)
+		SELECT c.bundle_id, c.component_id
+		FROM "service_bundle_component" c JOIN reachable ON c.bundle_id = reachable.id`, pq.Array(linkedIds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bundle components: %w", err)
+	}
+
+	components := make(map[uuid.UUID][]uuid.UUID)
+	for _, edge := range edges {
+		components[edge.BundleId] = append(components[edge.BundleId], edge.ComponentId)
+	}
+
+	granted := models.GrantingLinks(linkedIds, components)
+
+	linkId, ok := granted[serviceId]
+	if !ok {
+		return nil, customErrors.AbonementServiceLinkNotFound
+	}
+
+	row := &abonementServiceTermsRow{}
+	err = sqlx.GetContext(ctx, q, row, `
+		SELECT `+abonementServiceTermsColumns+` FROM "abonement_service"
+		WHERE abonement_id = $1 AND service_id = $2
+		LIMIT 1 `+lock, abonementId, linkId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.AbonementServiceLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to get abonement service terms: %w", err)
+	}
+
+	grant := &models.AbonementServiceGrant{}
+	grant.Terms, err = row.toTerms()
+	if err != nil {
+		return nil, err
+	}
+
+	for grantedId, grantingId := range granted {
+		if grantingId == linkId {
+			grant.ServiceIds = append(grant.ServiceIds, grantedId)
+		}
+	}
+
+	return grant, nil
+}
+
+func (serviceRep *ServiceRepository) SetAbonementServiceTerms(ctx context.Context, terms *models.AbonementServiceTerms) error {
+	windows := make([]accessWindow, 0, len(terms.Windows))
+	for _, window := range terms.Windows {
+		windows = append(windows, accessWindow{
+			Weekday: int(window.Weekday),
+			Opens:   window.OpensMinute,
+			Closes:  window.ClosesMinute,
+		})
+	}
+
+	encodedWindows, err := json.Marshal(windows)
+	if err != nil {
+		return fmt.Errorf("failed to encode access windows: %w", err)
+	}
+
+	result, err := serviceRep.db.ExecContext(ctx, `
+		UPDATE "abonement_service"
+		SET visit_quota = $1, quota_period = $2, guest_passes = $3, access_windows = $4::jsonb, updated_time = $5
+		WHERE abonement_id = $6 AND service_id = $7`,
+		terms.VisitQuota, terms.QuotaPeriod, terms.GuestPasses, string(encodedWindows), terms.UpdatedTime,
+		terms.AbonementId, terms.ServiceId)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error SetAbonementServiceTerms: %v", err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return customErrors.AbonementServiceLinkNotFound
+	}
+
+	return nil
+}
+
+// GetAbonementsServicesTerms returns the terms of every service of the
+// abonements, by abonement and then by service.
+func (serviceRep *ServiceRepository) GetAbonementsServicesTerms(
+	ctx context.Context,
+	abonementIds []uuid.UUID,
+) (map[uuid.UUID]map[uuid.UUID]*models.AbonementServiceTerms, error) {
+
+	termsByAbonement := make(map[uuid.UUID]map[uuid.UUID]*models.AbonementServiceTerms, len(abonementIds))
+
+	if len(abonementIds) == 0 {
+		return termsByAbonement, nil
+	}
+
+	var rows []*abonementServiceTermsRow
+
+	err := serviceRep.db.SelectContext(ctx, &rows, `
+		SELECT `+abonementServiceTermsColumns+` FROM "abonement_service"
+		WHERE abonement_id = ANY($1)`, pq.Array(abonementIds))
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetAbonementsServicesTerms: %v", err)
+		return nil, err
+	}
+
+	for _, row := range rows {
+		terms, err := row.toTerms()
+		if err != nil {
+			return nil, err
+		}
+
+		if termsByAbonement[terms.AbonementId] == nil {
+			termsByAbonement[terms.AbonementId] = make(map[uuid.UUID]*models.AbonementServiceTerms)
+		}
+		termsByAbonement[terms.AbonementId][terms.ServiceId] = terms
+	}
+
+	return termsByAbonement, nil
+}
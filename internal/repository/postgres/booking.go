@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+func (serviceRep *ServiceRepository) GetBookingSettings(ctx context.Context, serviceId uuid.UUID) (*models.BookingSettings, error) {
+	settings := &models.BookingSettings{}
+
+	err := serviceRep.db.GetContext(ctx, settings, `
+		SELECT service_id, slot_minutes, capacity, updated_time
+		FROM "service_booking_settings"
+		WHERE service_id = $1`, serviceId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.ServiceNotBookable
+		}
+		logger.ErrorLogger.Printf("Error GetBookingSettings: %v", err)
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (serviceRep *ServiceRepository) SetBookingSettings(ctx context.Context, settings *models.BookingSettings) error {
+	_, err := serviceRep.db.NamedExecContext(ctx, `
+		INSERT INTO "service_booking_settings" (service_id, slot_minutes, capacity, updated_time)
+		VALUES (:service_id, :slot_minutes, :capacity, :updated_time)
+		ON CONFLICT (service_id) DO UPDATE
+		SET slot_minutes = excluded.slot_minutes, capacity = excluded.capacity, updated_time = excluded.updated_time`, settings)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error SetBookingSettings: %v", err)
+		return mapConstraintError(err, nil, customErrors.ServiceNotFound)
+	}
+
+	return nil
+}
+
+// GetBookedSlots returns the slots starting within [from, to) that have a
+// counter row.
+func (serviceRep *ServiceRepository) GetBookedSlots(ctx context.Context, serviceId uuid.UUID, from time.Time, to time.Time) ([]*models.Slot, error) {
+	var slots []*models.Slot
+
+	err := serviceRep.db.SelectContext(ctx, &slots, `
+		SELECT slot_start, slot_end, capacity, booked
+		FROM "service_slot"
+		WHERE service_id = $1 AND slot_start >= $2 AND slot_start < $3
+		ORDER BY slot_start`, serviceId, from, to)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetBookedSlots: %v", err)
+		return nil, err
+	}
+
+	return slots, nil
+}
+
+// CreateBooking takes a place in the slot and stores the booking in one
+// transaction. The conditional increment locks the counter row, so concurrent
+// bookings of the same slot are serialized and cannot go over capacity.
+func (serviceRep *ServiceRepository) CreateBooking(ctx context.Context, booking *models.Booking, capacity int) error {
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	// The capacity of the settings applies to slots booked before it changed,
+	// a lowered capacity only stops further bookings.
+	_, err = txx.ExecContext(ctx, `
+		INSERT INTO "service_slot" (service_id, slot_start, slot_end, capacity)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (service_id, slot_start) DO UPDATE SET capacity = excluded.capacity`,
+		booking.ServiceId, booking.SlotStart, booking.SlotEnd, capacity)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error CreateBooking: %v", err)
+		err = mapConstraintError(err, nil, customErrors.ServiceNotFound)
+		return err
+	}
+
+	var booked int
+	err = txx.GetContext(ctx, &booked, `
+		UPDATE "service_slot" SET booked = booked + 1
+		WHERE service_id = $1 AND slot_start = $2 AND booked < capacity
+		RETURNING booked`, booking.ServiceId, booking.SlotStart)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = customErrors.SlotFull
+			return err
+		}
+		return fmt.Errorf("failed to take slot place: %w", err)
+	}
+
+	_, err = txx.NamedExecContext(ctx, `
+		INSERT INTO "service_booking" (id, service_id, member_id, slot_start, slot_end, status, created_time)
+		VALUES (:id, :service_id, :member_id, :slot_start, :slot_end, :status, :created_time)`, booking)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error CreateBooking: %v", err)
+		err = mapConstraintError(err, customErrors.BookingAlreadyExists, customErrors.ServiceNotFound)
+		return err
+	}
+
+	if err = txx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CancelBooking frees the place of an active booking. Cancelling a cancelled
+// booking returns it unchanged.
+func (serviceRep *ServiceRepository) CancelBooking(ctx context.Context, bookingId uuid.UUID, cancelledTime time.Time) (*models.Booking, error) {
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	booking := &models.Booking{}
+	err = txx.GetContext(ctx, booking, `
+		UPDATE "service_booking" SET status = $1, cancelled_time = $2
+		WHERE id = $3 AND status = $4
+		RETURNING id, service_id, member_id, slot_start, slot_end, status, created_time, cancelled_time`,
+		models.BookingStatusCancelled, cancelledTime, bookingId, models.BookingStatusActive)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			_ = txx.Rollback()
+			err = nil
+			return serviceRep.GetBooking(ctx, bookingId)
+		}
+		return nil, fmt.Errorf("failed to cancel booking: %w", err)
+	}
+
+	_, err = txx.ExecContext(ctx, `
+		UPDATE "service_slot" SET booked = booked - 1
+		WHERE service_id = $1 AND slot_start = $2`, booking.ServiceId, booking.SlotStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to free slot place: %w", err)
+	}
+
+	if err = txx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return booking, nil
+}
+
+func (serviceRep *ServiceRepository) GetBooking(ctx context.Context, bookingId uuid.UUID) (*models.Booking, error) {
+	booking := &models.Booking{}
+
+	err := serviceRep.db.GetContext(ctx, booking, `
+		SELECT id, service_id, member_id, slot_start, slot_end, status, created_time, cancelled_time
+		FROM "service_booking"
+		WHERE id = $1`, bookingId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.BookingNotFound
+		}
+		logger.ErrorLogger.Printf("Error GetBooking: %v", err)
+		return nil, err
+	}
+
+	return booking, nil
+}
@@ -0,0 +1,296 @@
+package postgres
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+// categorySubtree selects the ids of the category $1 and of all its
+// descendants.
+const categorySubtree = `
+	WITH RECURSIVE subtree AS (
+		SELECT id FROM "service_category" WHERE id = $1
+		UNION ALL
+		SELECT child.id FROM "service_category" child JOIN subtree ON child.parent_id = subtree.id
+	)`
+
+func (serviceRep *ServiceRepository) CreateCategory(ctx context.Context, category *models.ServiceCategory) error {
+	_, err := serviceRep.db.NamedExecContext(ctx, `
+		INSERT INTO "service_category" (id, parent_id, name, created_time, updated_time)
+		VALUES (:id, :parent_id, :name, :created_time, :updated_time)`, category)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error CreateCategory: %v", err)
+		return mapConstraintError(err, customErrors.CategoryAlreadyExists, customErrors.CategoryNotFound)
+	}
+
+	return nil
+}
+
+func (serviceRep *ServiceRepository) GetCategoryById(ctx context.Context, id uuid.UUID) (*models.ServiceCategory, error) {
+	category := &models.ServiceCategory{}
+
+	err := serviceRep.db.GetContext(ctx, category,
+		`SELECT id, parent_id, name, created_time, updated_time FROM "service_category" WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.CategoryNotFound
+		}
+		logger.ErrorLogger.Printf("Error GetCategoryById: %v", err)
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (serviceRep *ServiceRepository) GetCategories(ctx context.Context) ([]*models.ServiceCategory, error) {
+	var categories []*models.ServiceCategory
+
+	err := serviceRep.db.SelectContext(ctx, &categories,
+		`SELECT id, parent_id, name, created_time, updated_time FROM "service_category" ORDER BY lower(name), id`)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetCategories: %v", err)
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// UpdateCategory renames and moves the category. Moves are serialized by a
+// table lock, otherwise two concurrent moves could both pass the cycle check
+// and close a loop together.
+func (serviceRep *ServiceRepository) UpdateCategory(ctx context.Context, cmd *dtos.UpdateCategoryCommand, updatedTime time.Time) error {
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	_, err = txx.ExecContext(ctx, `LOCK TABLE "service_category" IN SHARE ROW EXCLUSIVE MODE`)
+	if err != nil {
+		return fmt.Errorf("failed to lock categories: %w", err)
+	}
+
+	if cmd.ParentId != nil {
+		var cycle bool
+		err = txx.GetContext(ctx, &cycle, categorySubtree+`
+			SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $2)`, cmd.Id, *cmd.ParentId)
+		if err != nil {
+			return fmt.Errorf("failed to check category cycle: %w", err)
+		}
+		if cycle {
+			err = customErrors.InvalidCategoryParent
+			return err
+		}
+	}
+
+	result, err := txx.ExecContext(ctx, `
+		UPDATE "service_category" SET name = $1, parent_id = $2, updated_time = $3 WHERE id = $4`,
+		cmd.Name, cmd.ParentId, updatedTime, cmd.Id)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error UpdateCategory: %v", err)
+		err = mapConstraintError(err, customErrors.CategoryAlreadyExists, customErrors.CategoryNotFound)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		err = customErrors.CategoryNotFound
+		return err
+	}
+
+	if err = txx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteCategory relies on the restricting foreign keys: a category with
+// subcategories or services cannot be deleted.
+func (serviceRep *ServiceRepository) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	result, err := serviceRep.db.ExecContext(ctx, `DELETE FROM "service_category" WHERE id = $1`, id)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error DeleteCategory: %v", err)
+		return mapConstraintError(err, nil, customErrors.CategoryInUse)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return customErrors.CategoryNotFound
+	}
+
+	return nil
+}
+
+func (serviceRep *ServiceRepository) SetServiceCategory(ctx context.Context, serviceId uuid.UUID, categoryId *uuid.UUID, updatedTime time.Time) error {
+	result, err := serviceRep.db.ExecContext(ctx,
+		`UPDATE "service" SET category_id = $1, updated_time = $2 WHERE id = $3`, categoryId, updatedTime, serviceId)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error SetServiceCategory: %v", err)
+		return mapConstraintError(err, nil, customErrors.CategoryNotFound)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return customErrors.ServiceNotFound
+	}
+
+	return nil
+}
+
+// SetServiceTags replaces every tag of the service.
+func (serviceRep *ServiceRepository) SetServiceTags(ctx context.Context, serviceId uuid.UUID, tags []string, updatedTime time.Time) error {
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	result, err := txx.ExecContext(ctx, `UPDATE "service" SET updated_time = $1 WHERE id = $2`, updatedTime, serviceId)
+	if err != nil {
+		return fmt.Errorf("failed to touch service: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		err = customErrors.ServiceNotFound
+		return err
+	}
+
+	_, err = txx.ExecContext(ctx, `DELETE FROM "service_tag" WHERE service_id = $1`, serviceId)
+	if err != nil {
+		return fmt.Errorf("failed to delete service tags: %w", err)
+	}
+
+	if len(tags) > 0 {
+		_, err = txx.ExecContext(ctx, `
+			INSERT INTO "service_tag" (service_id, tag)
+			SELECT $1, tag FROM unnest($2::text[]) AS tag`, serviceId, pq.Array(tags))
+		if err != nil {
+			logger.ErrorLogger.Printf("Error SetServiceTags: %v", err)
+			return err
+		}
+	}
+
+	if err = txx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (serviceRep *ServiceRepository) GetServicesClassifications(
+	ctx context.Context,
+	servicesIds []uuid.UUID,
+) (map[uuid.UUID]*models.ServiceClassification, error) {
+
+	classifications := make(map[uuid.UUID]*models.ServiceClassification, len(servicesIds))
+
+	if len(servicesIds) == 0 {
+		return classifications, nil
+	}
+
+	rows, err := serviceRep.db.QueryContext(ctx, `
+		SELECT service.id, service.category_id,
+		       COALESCE(array_agg(service_tag.tag ORDER BY service_tag.tag) FILTER (WHERE service_tag.tag IS NOT NULL), '{}')
+		FROM "service"
+		LEFT JOIN "service_tag" ON service_tag.service_id = service.id
+		WHERE service.id = ANY($1)
+		GROUP BY service.id`, pq.Array(servicesIds))
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetServicesClassifications: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		classification := &models.ServiceClassification{}
+		var tags pq.StringArray
+
+		err = rows.Scan(&classification.ServiceId, &classification.CategoryId, &tags)
+		if err != nil {
+			return nil, err
+		}
+
+		classification.Tags = tags
+		classifications[classification.ServiceId] = classification
+	}
+
+	return classifications, rows.Err()
+}
+
+// FilterServicesIds returns which of the given services match the filter.
+func (serviceRep *ServiceRepository) FilterServicesIds(
+	ctx context.Context,
+	servicesIds []uuid.UUID,
+	filter *models.ServiceFilter,
+) (map[uuid.UUID]bool, error) {
+
+	if filter.CategoryId != nil {
+		_, err := serviceRep.GetCategoryById(ctx, *filter.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	matched := make(map[uuid.UUID]bool, len(servicesIds))
+
+	if len(servicesIds) == 0 {
+		return matched, nil
+	}
+
+	tags := filter.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	var matchedIds []uuid.UUID
+
+	err := serviceRep.db.SelectContext(ctx, &matchedIds, categorySubtree+`
+		SELECT service.id FROM "service"
+		WHERE service.id = ANY($2)
+		  AND ($1::uuid IS NULL OR service.category_id IN (SELECT id FROM subtree))
+		  AND (SELECT count(*) FROM "service_tag" WHERE service_id = service.id AND tag = ANY($3)) = cardinality($3::text[])`,
+		filter.CategoryId, pq.Array(servicesIds), pq.Array(tags))
+	if err != nil {
+		logger.ErrorLogger.Printf("Error FilterServicesIds: %v", err)
+		return nil, err
+	}
+
+	for _, id := range matchedIds {
+		matched[id] = true
+	}
+
+	return matched, nil
+}
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+const coachServiceLinkColumns = `
+	coach_id, service_id, qualification_level, certification_expiry, price_override, certification_expired, updated_time`
+
+func (serviceRep *ServiceRepository) CreateCoachServiceLink(ctx context.Context, link *models.CoachServiceLink) error {
+	_, err := serviceRep.db.ExecContext(ctx, `
+		INSERT INTO "coach_service" (`+coachServiceLinkColumns+`)
+		VALUES ($1, $2, $3, $4::date, $5, $6, $7)`,
+		link.CoachId, link.ServiceId, link.QualificationLevel, formatDate(link.CertificationExpiry), link.PriceOverride,
+		link.CertificationExpired, link.UpdatedTime)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error CreateCoachServiceLink: %v", err)
+		return mapConstraintError(err, customErrors.ServiceLinkAlreadyExists, customErrors.ServiceNotFound)
+	}
+
+	return nil
+}
+
+func (serviceRep *ServiceRepository) UpdateCoachServiceLink(ctx context.Context, link *models.CoachServiceLink) error {
+	result, err := serviceRep.db.ExecContext(ctx, `
+		UPDATE "coach_service"
+		SET qualification_level = $1, certification_expiry = $2::date, price_override = $3,
+		    certification_expired = $4, updated_time = $5
+		WHERE coach_id = $6 AND service_id = $7`,
+		link.QualificationLevel, formatDate(link.CertificationExpiry), link.PriceOverride,
+		link.CertificationExpired, link.UpdatedTime, link.CoachId, link.ServiceId)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error UpdateCoachServiceLink: %v", err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return customErrors.CoachServiceLinkNotFound
+	}
+
+	return nil
+}
+
+func (serviceRep *ServiceRepository) GetCoachServiceLink(
+	ctx context.Context,
+	coachId uuid.UUID,
+	serviceId uuid.UUID,
+) (*models.CoachServiceLink, error) {
+
+	link := &models.CoachServiceLink{}
+
+	err := serviceRep.db.GetContext(ctx, link, `
+		SELECT `+coachServiceLinkColumns+` FROM "coach_service" WHERE coach_id = $1 AND service_id = $2`,
+		coachId, serviceId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.CoachServiceLinkNotFound
+		}
+		logger.ErrorLogger.Printf("Error GetCoachServiceLink: %v", err)
+		return nil, err
+	}
+
+	return link, nil
+}
+
+func (serviceRep *ServiceRepository) GetCoachServiceLinks(ctx context.Context, coachId uuid.UUID) ([]*models.CoachServiceLink, error) {
+	var links []*models.CoachServiceLink
+
+	err := serviceRep.db.SelectContext(ctx, &links, `
+		SELECT `+coachServiceLinkColumns+` FROM "coach_service" WHERE coach_id = $1 ORDER BY service_id`, coachId)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetCoachServiceLinks: %v", err)
+		return nil, err
+	}
+
+	return links, nil
+}
+
+// FlagExpiredCertifications flags the links whose certification was valid
+// through a day before today and returns them. Links already flagged are
+// skipped, so each link is reported once per expiry.
+func (serviceRep *ServiceRepository) FlagExpiredCertifications(
+	ctx context.Context,
+	today time.Time,
+	updatedTime time.Time,
+) ([]*models.CoachServiceLink, error) {
+
+	var links []*models.CoachServiceLink
+
+	err := serviceRep.db.SelectContext(ctx, &links, `
+		UPDATE "coach_service"
+		SET certification_expired = TRUE, updated_time = $2
+		WHERE NOT certification_expired AND certification_expiry < $1::date
+		RETURNING `+coachServiceLinkColumns,
+		today.Format(models.DateLayout), updatedTime)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error FlagExpiredCertifications: %v", err)
+		return nil, err
+	}
+
+	return links, nil
+}
+
+// formatDate sends dates as text, so that the session time zone cannot move
+// them to another day.
+func formatDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+
+	formatted := date.Format(models.DateLayout)
+	return &formatted
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"errors"
+	"github.com/lib/pq"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// mapConstraintError replaces unique and foreign key violations with the given
+// domain errors. A nil replacement leaves that kind of violation untouched.
+func mapConstraintError(err error, onUnique error, onForeignKey error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch {
+	case pqErr.Code == uniqueViolation && onUnique != nil:
+		return onUnique
+	case pqErr.Code == foreignKeyViolation && onForeignKey != nil:
+		return onForeignKey
+	default:
+		return err
+	}
+}
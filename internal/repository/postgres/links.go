@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"Service/pkg/logger"
+	"context"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+func (serviceRep *ServiceRepository) GetLinkedCoachIds(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	var coachIds []uuid.UUID
+
+	err := serviceRep.db.SelectContext(ctx, &coachIds, `
+		SELECT DISTINCT coach_id
+		FROM "coach_service"
+		WHERE coach_id > $1
+		ORDER BY coach_id
+		LIMIT $2`, after, limit)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetLinkedCoachIds: %v", err)
+		return nil, err
+	}
+
+	return coachIds, nil
+}
+
+func (serviceRep *ServiceRepository) GetLinkedAbonementIds(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	var abonementIds []uuid.UUID
+
+	err := serviceRep.db.SelectContext(ctx, &abonementIds, `
+		SELECT DISTINCT abonement_id
+		FROM "abonement_service"
+		WHERE abonement_id > $1
+		ORDER BY abonement_id
+		LIMIT $2`, after, limit)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetLinkedAbonementIds: %v", err)
+		return nil, err
+	}
+
+	return abonementIds, nil
+}
+
+func (serviceRep *ServiceRepository) DeleteCoachesLinks(ctx context.Context, coachIds []uuid.UUID) (int64, error) {
+	result, err := serviceRep.db.ExecContext(ctx, `DELETE FROM "coach_service" WHERE coach_id = ANY($1)`, pq.Array(coachIds))
+	if err != nil {
+		logger.ErrorLogger.Printf("Error DeleteCoachesLinks: %v", err)
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (serviceRep *ServiceRepository) DeleteAbonementsLinks(ctx context.Context, abonementIds []uuid.UUID) (int64, error) {
+	result, err := serviceRep.db.ExecContext(ctx, `DELETE FROM "abonement_service" WHERE abonement_id = ANY($1)`, pq.Array(abonementIds))
+	if err != nil {
+		logger.ErrorLogger.Printf("Error DeleteAbonementsLinks: %v", err)
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
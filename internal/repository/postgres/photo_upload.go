@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+func (serviceRep *ServiceRepository) CreatePhotoUpload(ctx context.Context, upload *models.PhotoUpload) error {
+	_, err := serviceRep.db.NamedExecContext(ctx, `
+		INSERT INTO "service_photo_upload" (token, service_id, object_key, content_type, max_size, expires_time, created_time)
+		VALUES (:token, :service_id, :object_key, :content_type, :max_size, :expires_time, :created_time)`, upload)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error CreatePhotoUpload: %v", err)
+		return mapConstraintError(err, nil, customErrors.ServiceNotFound)
+	}
+
+	return nil
+}
+
+func (serviceRep *ServiceRepository) GetPhotoUpload(ctx context.Context, token uuid.UUID) (*models.PhotoUpload, error) {
+	upload := &models.PhotoUpload{}
+
+	err := serviceRep.db.GetContext(ctx, upload, `
+		SELECT token, service_id, object_key, content_type, max_size, expires_time, created_time
+		FROM "service_photo_upload"
+		WHERE token = $1`, token)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.PhotoUploadNotFound
+		}
+
+		logger.ErrorLogger.Printf("Error GetPhotoUpload: %v", err)
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+func (serviceRep *ServiceRepository) DeletePhotoUpload(ctx context.Context, token uuid.UUID) error {
+	_, err := serviceRep.db.ExecContext(ctx, `DELETE FROM "service_photo_upload" WHERE token = $1`, token)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error DeletePhotoUpload: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// AttachPhotoUpload consumes the upload and points the service at its object
+// in one transaction, the gallery loses its cover since the photo no longer
+// comes from it. It returns the photo the service had before.
+func (serviceRep *ServiceRepository) AttachPhotoUpload(ctx context.Context, upload *models.PhotoUpload, updatedTime time.Time) (string, error) {
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	result, err := txx.ExecContext(ctx, `DELETE FROM "service_photo_upload" WHERE token = $1`, upload.Token)
+	if err != nil {
+		return "", fmt.Errorf("failed to consume photo upload: %w", err)
+	}
+
+	consumed, err := result.RowsAffected()
+	if err != nil {
+		return "", err
+	}
+	if consumed == 0 {
+		err = customErrors.PhotoUploadNotFound
+		return "", err
+	}
+
+	var previousPhoto string
+	err = txx.GetContext(ctx, &previousPhoto, `SELECT photo FROM "service" WHERE id = $1 FOR UPDATE`, upload.ServiceId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = customErrors.ServiceNotFound
+			return "", err
+		}
+		return "", fmt.Errorf("failed to lock service: %w", err)
+	}
+
+	_, err = txx.ExecContext(ctx, `UPDATE "service" SET photo = $1, updated_time = $2 WHERE id = $3`,
+		upload.ObjectKey, updatedTime, upload.ServiceId)
+	if err != nil {
+		return "", fmt.Errorf("failed to attach photo: %w", err)
+	}
+
+	err = resetCover(ctx, txx, upload.ServiceId)
+	if err != nil {
+		return "", err
+	}
+
+	if err = txx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return previousPhoto, nil
+}
+
+// DeleteExpiredPhotoUploads forgets expired uploads and returns the keys of
+// their objects that no service points at.
+func (serviceRep *ServiceRepository) DeleteExpiredPhotoUploads(ctx context.Context, now time.Time) ([]string, error) {
+	var keys []string
+
+	err := serviceRep.db.SelectContext(ctx, &keys, `
+		WITH expired AS (
+			DELETE FROM "service_photo_upload"
+			WHERE expires_time < $1
+			RETURNING object_key
+		)
+		SELECT object_key FROM expired
+		WHERE NOT EXISTS (SELECT 1 FROM "service" WHERE photo = expired.object_key)`, now)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error DeleteExpiredPhotoUploads: %v", err)
+		return nil, err
+	}
+
+	return keys, nil
+}
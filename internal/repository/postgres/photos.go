@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"Service/pkg/logger"
+	"context"
+)
+
+// GetServicePhotos returns every object key a service or a gallery points at.
+func (serviceRep *ServiceRepository) GetServicePhotos(ctx context.Context) ([]string, error) {
+	var photos []string
+
+	err := serviceRep.db.SelectContext(ctx, &photos, `
+		SELECT photo FROM "service" WHERE photo <> ''
+		UNION
+		SELECT object_key FROM "service_media"`)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetServicePhotos: %v", err)
+		return nil, err
+	}
+
+	return photos, nil
+}
@@ -25,11 +25,11 @@ func NewServiceRepository(db *sqlx.DB) *ServiceRepository {
 
 func (serviceRep *ServiceRepository) CreateService(ctx context.Context, service *models.Service) error {
 	_, err := serviceRep.db.NamedExecContext(ctx, `
-		INSERT INTO "service" (id, title, photo, created_time, updated_time)
-		VALUES (:id, :title, :photo, :created_time, :updated_time)`, *service)
+		INSERT INTO "service" (id, title, normalized_title, slug, photo, created_time, updated_time)
+		VALUES (:id, :title, :normalized_title, :slug, :photo, :created_time, :updated_time)`, *service)
 	if err != nil {
 		logger.ErrorLogger.Printf("Error CreateService: %v", err)
-		return err
+		return mapConstraintError(err, customErrors.ServiceAlreadyExists, nil)
 	}
 
 	return nil
@@ -37,7 +37,7 @@ func (serviceRep *ServiceRepository) CreateService(ctx context.Context, service
 
 func (serviceRep *ServiceRepository) GetServiceById(ctx context.Context, id uuid.UUID) (*models.Service, error) {
 	service := &models.Service{}
-	err := serviceRep.db.GetContext(ctx, service, `SELECT id, title, photo, created_time, updated_time FROM "service" WHERE id = $1`, id)
+	err := serviceRep.db.GetContext(ctx, service, `SELECT id, title, slug, photo, created_time, updated_time FROM "service" WHERE id = $1`, id)
 	if err != nil {
 		logger.ErrorLogger.Printf("Error GetServiceById: %v", err)
 
@@ -51,12 +51,18 @@ func (serviceRep *ServiceRepository) GetServiceById(ctx context.Context, id uuid
 	return service, nil
 }
 
-func (serviceRep *ServiceRepository) UpdateService(ctx context.Context, cmd *dtos.UpdateServiceCommand) error {
+// UpdateService returns the photo the service had before, so that the caller
+// can remove it once the new one is committed.
+func (serviceRep *ServiceRepository) UpdateService(ctx context.Context, cmd *dtos.UpdateServiceCommand) (string, error) {
 
 	setFields := map[string]interface{}{}
 
 	if cmd.Title != "" {
-		setFields["name"] = cmd.Title
+		setFields["title"] = cmd.Title
+		setFields["normalized_title"] = cmd.NormalizedTitle
+	}
+	if cmd.Slug != "" {
+		setFields["slug"] = cmd.Slug
 	}
 	if cmd.Photo != "" {
 		setFields["photo"] = cmd.Photo
@@ -65,7 +71,7 @@ func (serviceRep *ServiceRepository) UpdateService(ctx context.Context, cmd *dto
 
 	if len(setFields) == 0 {
 		logger.InfoLogger.Printf("No fields to update for service Id: %v", cmd.Id)
-		return nil
+		return "", nil
 	}
 
 	query := `UPDATE "service" SET `
@@ -84,30 +90,300 @@ func (serviceRep *ServiceRepository) UpdateService(ctx context.Context, cmd *dto
 	query += fmt.Sprintf(` WHERE id = $%d`, i)
 	params = append(params, cmd.Id)
 
-	_, err := serviceRep.db.ExecContext(ctx, query, params...)
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	var previousPhoto string
+	err = txx.GetContext(ctx, &previousPhoto, `SELECT photo FROM "service" WHERE id = $1 FOR UPDATE`, cmd.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", customErrors.ServiceNotFound
+		}
+		return "", fmt.Errorf("failed to lock service: %w", err)
+	}
+
+	_, err = txx.ExecContext(ctx, query, params...)
 	if err != nil {
 		logger.ErrorLogger.Printf("Error UpdateService: %v", err)
-		return err
+		return "", mapConstraintError(err, customErrors.ServiceAlreadyExists, nil)
 	}
 
-	return nil
+	if cmd.Photo != "" && !models.IsServiceMediaKey(cmd.Photo) {
+		err = resetCover(ctx, txx, cmd.Id)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if cmd.Slug != "" && cmd.PreviousSlug != "" && cmd.Slug != cmd.PreviousSlug {
+		_, err = txx.ExecContext(ctx, `DELETE FROM "service_slug_redirect" WHERE slug = $1 AND service_id = $2`, cmd.Slug, cmd.Id)
+		if err != nil {
+			return "", fmt.Errorf("failed to delete slug redirect: %w", err)
+		}
+
+		_, err = txx.ExecContext(ctx, `
+			INSERT INTO "service_slug_redirect" (slug, service_id, created_time)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (slug) DO UPDATE SET service_id = EXCLUDED.service_id, created_time = EXCLUDED.created_time`,
+			cmd.PreviousSlug, cmd.Id, cmd.UpdatedTime)
+		if err != nil {
+			return "", fmt.Errorf("failed to create slug redirect: %w", err)
+		}
+	}
+
+	if err = txx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return previousPhoto, nil
 }
 
-func (serviceRep *ServiceRepository) DeleteService(ctx context.Context, id uuid.UUID) error {
-	_, err := serviceRep.db.ExecContext(ctx, `DELETE FROM "service" WHERE id = $1`, id)
+// DeleteService removes the service according to the policy in one
+// transaction and returns the owners whose links were affected. The service
+// row is locked first, so no link can be added to it while the policy is
+// applied.
+func (serviceRep *ServiceRepository) DeleteService(ctx context.Context, cmd *dtos.DeleteServiceCommand) (*models.ServiceReferences, error) {
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	// Every policy may change bundle contents, the table lock comes before
+	// the row lock in the order abonement changes take them.
+	err = lockBundles(ctx, txx)
+	if err != nil {
+		return nil, err
+	}
+
+	var lockedId uuid.UUID
+	err = txx.GetContext(ctx, &lockedId, `SELECT id FROM "service" WHERE id = $1 FOR UPDATE`, cmd.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.ServiceNotFound
+		}
+		return nil, fmt.Errorf("failed to lock service: %w", err)
+	}
+
+	references := &models.ServiceReferences{}
+
+	err = txx.SelectContext(ctx, &references.CoachIds,
+		`SELECT DISTINCT coach_id FROM "coach_service" WHERE service_id = $1 ORDER BY coach_id`, cmd.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coach references: %w", err)
+	}
 
+	err = txx.SelectContext(ctx, &references.AbonementIds,
+		`SELECT DISTINCT abonement_id FROM "abonement_service" WHERE service_id = $1 ORDER BY abonement_id`, cmd.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get abonement references: %w", err)
+	}
+
+	err = txx.SelectContext(ctx, &references.BundleIds,
+		`SELECT bundle_id FROM "service_bundle_component" WHERE component_id = $1 ORDER BY bundle_id`, cmd.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bundle references: %w", err)
+	}
+
+	err = txx.GetContext(ctx, &references.Visits,
+		`SELECT count(*) FROM "service_visit" WHERE service_id = $1`, cmd.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count visits: %w", err)
+	}
+
+	// A booking takes a key share lock on the service row through the slot
+	// foreign key, so none can be added once the row is locked above.
+	err = txx.GetContext(ctx, &references.ActiveBookings,
+		`SELECT count(*) FROM "service_booking" WHERE service_id = $1 AND status = $2 AND slot_end > now()`,
+		cmd.Id, models.BookingStatusActive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count active bookings: %w", err)
+	}
+
+	// Bookings hold places in slots of this service's schedule: they cannot
+	// be moved to another one, and members are not told when they go with
+	// the service. Every policy refuses while upcoming ones are active.
+	if references.ActiveBookings > 0 && cmd.Policy != models.DeletePolicyRestrict {
+		err = customErrors.ServiceInUse
+		return &models.ServiceReferences{ActiveBookings: references.ActiveBookings}, err
+	}
+
+	switch cmd.Policy {
+	case models.DeletePolicyCascade:
+		err = deleteServiceLinks(ctx, txx, cmd.Id)
+	case models.DeletePolicyReassign:
+		var brokenRules []*models.ServiceRuleViolation
+		brokenRules, err = reassignServiceLinks(ctx, txx, cmd.Id, cmd.ReplacementServiceId)
+		if len(brokenRules) > 0 {
+			return &models.ServiceReferences{BrokenRules: brokenRules}, err
+		}
+	default:
+		if !references.Empty() {
+			err = customErrors.ServiceInUse
+			return references, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = txx.ExecContext(ctx, `DELETE FROM "service" WHERE id = $1`, cmd.Id)
 	if err != nil {
 		logger.ErrorLogger.Printf("Error DeleteService: %v", err)
-		return err
+		return nil, mapConstraintError(err, nil, customErrors.ServiceInUse)
+	}
+
+	if err = txx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return references, nil
+}
+
+func deleteServiceLinks(ctx context.Context, txx *sqlx.Tx, serviceId uuid.UUID) error {
+	_, err := txx.ExecContext(ctx, `DELETE FROM "coach_service" WHERE service_id = $1`, serviceId)
+	if err != nil {
+		return fmt.Errorf("failed to delete coach links: %w", err)
+	}
+
+	_, err = txx.ExecContext(ctx, `DELETE FROM "abonement_service" WHERE service_id = $1`, serviceId)
+	if err != nil {
+		return fmt.Errorf("failed to delete abonement links: %w", err)
+	}
+
+	_, err = txx.ExecContext(ctx, `DELETE FROM "service_bundle_component" WHERE component_id = $1`, serviceId)
+	if err != nil {
+		return fmt.Errorf("failed to delete bundle components: %w", err)
+	}
+
+	_, err = txx.ExecContext(ctx, `DELETE FROM "service_visit" WHERE service_id = $1`, serviceId)
+	if err != nil {
+		return fmt.Errorf("failed to delete visits: %w", err)
 	}
 
 	return nil
 }
 
+// reassignServiceLinks points the links of a service at its replacement,
+// skipping owners that already have the replacement. Bundles get the
+// replacement as component instead, unless that would make one contain
+// itself. Recorded visits move to the replacement, so they keep counting
+// against the quotas of the moved links.
+func reassignServiceLinks(
+	ctx context.Context,
+	txx *sqlx.Tx,
+	serviceId uuid.UUID,
+	replacementId uuid.UUID,
+) ([]*models.ServiceRuleViolation, error) {
+
+	affected, err := abonementsHolding(ctx, txx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := abonementRuleViolations(ctx, txx, affected)
+	if err != nil {
+		return nil, err
+	}
+
+	var replacementExists bool
+	err = txx.GetContext(ctx, &replacementExists, `SELECT EXISTS (SELECT 1 FROM "service" WHERE id = $1)`, replacementId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check replacement service: %w", err)
+	}
+	if !replacementExists {
+		return nil, customErrors.NewResourceError(customErrors.ServiceNotFound, replacementId.String())
+	}
+
+	_, err = txx.ExecContext(ctx, `
+		INSERT INTO "coach_service" (coach_id, service_id, qualification_level, certification_expiry, price_override,
+		                             certification_expired, updated_time)
+		SELECT DISTINCT ON (coach_id) coach_id, $2::uuid, qualification_level, certification_expiry, price_override,
+		       certification_expired, updated_time
+		FROM "coach_service" old
+		WHERE old.service_id = $1
+		  AND NOT EXISTS (SELECT 1 FROM "coach_service" WHERE coach_id = old.coach_id AND service_id = $2)`,
+		serviceId, replacementId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reassign coach links: %w", err)
+	}
+
+	_, err = txx.ExecContext(ctx, `
+		INSERT INTO "abonement_service" (abonement_id, service_id, visit_quota, quota_period, guest_passes,
+		                                 access_windows, updated_time)
+		SELECT DISTINCT ON (abonement_id) abonement_id, $2::uuid, visit_quota, quota_period, guest_passes,
+		       access_windows, updated_time
+		FROM "abonement_service" old
+		WHERE old.service_id = $1
+		  AND NOT EXISTS (SELECT 1 FROM "abonement_service" WHERE abonement_id = old.abonement_id AND service_id = $2)`,
+		serviceId, replacementId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reassign abonement links: %w", err)
+	}
+
+	var cycle bool
+	err = txx.GetContext(ctx, &cycle, bundleReachable+`
+		SELECT EXISTS (
+			SELECT 1 FROM reachable JOIN "service_bundle_component" c ON c.bundle_id = reachable.id
+			WHERE c.component_id = $2
+		)`, pq.Array([]uuid.UUID{replacementId}), serviceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bundle cycle: %w", err)
+	}
+	if cycle {
+		return nil, customErrors.NewResourceError(customErrors.BundleCycle, replacementId.String())
+	}
+
+	_, err = txx.ExecContext(ctx, `
+		INSERT INTO "service_bundle_component" (bundle_id, component_id)
+		SELECT old.bundle_id, $2
+		FROM "service_bundle_component" old
+		WHERE old.component_id = $1
+		  AND NOT EXISTS (SELECT 1 FROM "service_bundle_component" WHERE bundle_id = old.bundle_id AND component_id = $2)`,
+		serviceId, replacementId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reassign bundle components: %w", err)
+	}
+
+	_, err = txx.ExecContext(ctx, `UPDATE "service_visit" SET service_id = $2 WHERE service_id = $1`, serviceId, replacementId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reassign visits: %w", err)
+	}
+
+	err = deleteServiceLinks(ctx, txx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := abonementRuleViolations(ctx, txx, affected)
+	if err != nil {
+		return nil, err
+	}
+
+	if added := models.AddedViolations(before, after); len(added) > 0 {
+		return added, customErrors.ServiceRulesViolated
+	}
+
+	return nil, nil
+}
+
 func (serviceRep *ServiceRepository) GetServices(ctx context.Context) ([]*models.Service, error) {
 	var services []*models.Service
 
-	err := serviceRep.db.SelectContext(ctx, &services, `SELECT id, title, photo, created_time, updated_time FROM "service"`)
+	err := serviceRep.db.SelectContext(ctx, &services, `SELECT id, title, slug, photo, created_time, updated_time FROM "service"`)
 	if err != nil {
 		logger.ErrorLogger.Printf("Error GetServices: %v", err)
 
@@ -121,6 +397,42 @@ func (serviceRep *ServiceRepository) GetServices(ctx context.Context) ([]*models
 	return services, nil
 }
 
+// GetServicesWithPlaceholderSlug returns the services whose slug is still the
+// id placeholder left by the slug migration.
+func (serviceRep *ServiceRepository) GetServicesWithPlaceholderSlug(ctx context.Context) ([]*models.Service, error) {
+	var services []*models.Service
+
+	err := serviceRep.db.SelectContext(ctx, &services,
+		`SELECT id, title, slug, photo, created_time, updated_time FROM "service" WHERE slug = id::text`)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetServicesWithPlaceholderSlug: %v", err)
+		return nil, err
+	}
+
+	return services, nil
+}
+
+// SetServiceSlug replaces the slug and normalized title without touching
+// updated_time or leaving a redirect behind.
+func (serviceRep *ServiceRepository) SetServiceSlug(ctx context.Context, id uuid.UUID, slug string, normalizedTitle string) error {
+	result, err := serviceRep.db.ExecContext(ctx,
+		`UPDATE "service" SET slug = $1, normalized_title = $2 WHERE id = $3`, slug, normalizedTitle, id)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error SetServiceSlug: %v", err)
+		return mapConstraintError(err, customErrors.ServiceAlreadyExists, nil)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return customErrors.ServiceNotFound
+	}
+
+	return nil
+}
+
 func (serviceRep *ServiceRepository) CreateCoachServices(ctx context.Context, cmd *dtos.CreateCoachServicesCommand) error {
 
 	query := `
@@ -138,13 +450,35 @@ func (serviceRep *ServiceRepository) CreateCoachServices(ctx context.Context, cm
 	_, err := serviceRep.db.NamedExecContext(ctx, query, values)
 	if err != nil {
 		logger.ErrorLogger.Printf("Error CreateCoachServices: %v", err)
-		return err
+		return mapConstraintError(err, customErrors.ServiceLinkAlreadyExists, customErrors.ServiceNotFound)
 	}
 
 	return nil
 }
 
-func (serviceRep *ServiceRepository) CreateAbonementServices(ctx context.Context, cmd *dtos.CreateAbonementServicesCommand) error {
+// CreateAbonementServices adds the links and checks the resulting services of
+// the abonement against the rules before committing. The broken rules are
+// returned with ServiceRulesViolated.
+func (serviceRep *ServiceRepository) CreateAbonementServices(
+	ctx context.Context,
+	cmd *dtos.CreateAbonementServicesCommand,
+) ([]*models.ServiceRuleViolation, error) {
+
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	err = lockAbonementServices(ctx, txx, cmd.AbonementId)
+	if err != nil {
+		return nil, err
+	}
 
 	query := `
 	INSERT INTO "abonement_service" (abonement_id, service_id)
@@ -158,19 +492,41 @@ func (serviceRep *ServiceRepository) CreateAbonementServices(ctx context.Context
 		})
 	}
 
-	_, err := serviceRep.db.NamedExecContext(ctx, query, values)
+	_, err = txx.NamedExecContext(ctx, query, values)
 	if err != nil {
 		logger.ErrorLogger.Printf("Error CreateAbonementServices: %v", err)
-		return err
+		err = mapConstraintError(err, customErrors.ServiceLinkAlreadyExists, customErrors.ServiceNotFound)
+		return nil, err
 	}
 
-	return nil
+	violations, err := abonementRuleViolations(ctx, txx, []uuid.UUID{cmd.AbonementId})
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		err = customErrors.ServiceRulesViolated
+		return violations, err
+	}
+
+	if err = txx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil, nil
 }
 
-func (serviceRep *ServiceRepository) UpdateAbonementServices(ctx context.Context, abonementId uuid.UUID, servicesIds []uuid.UUID) error {
+// UpdateAbonementServices replaces the links and checks the resulting services
+// of the abonement against the rules before committing. The broken rules are
+// returned with ServiceRulesViolated.
+func (serviceRep *ServiceRepository) UpdateAbonementServices(
+	ctx context.Context,
+	abonementId uuid.UUID,
+	servicesIds []uuid.UUID,
+) ([]*models.ServiceRuleViolation, error) {
+
 	txx, err := serviceRep.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
 
 	defer func() {
@@ -179,33 +535,50 @@ func (serviceRep *ServiceRepository) UpdateAbonementServices(ctx context.Context
 		}
 	}()
 
+	err = lockAbonementServices(ctx, txx, abonementId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Links that stay keep their terms.
 	deleteQuery := `
 		DELETE FROM abonement_service
-		WHERE abonement_id = $1
+		WHERE abonement_id = $1 AND service_id <> ALL($2::uuid[])
 	`
 
-	_, err = txx.ExecContext(ctx, deleteQuery, abonementId)
+	_, err = txx.ExecContext(ctx, deleteQuery, abonementId, pq.Array(servicesIds))
 	if err != nil {
-		return fmt.Errorf("failed to delete abonement services: %w", err)
+		return nil, fmt.Errorf("failed to delete abonement services: %w", err)
 	}
 
 	insertQuery := `
 		INSERT INTO abonement_service (abonement_id, service_id)
-		VALUES ($1, $2)
+		SELECT $1::uuid, $2::uuid
+		WHERE NOT EXISTS (SELECT 1 FROM abonement_service WHERE abonement_id = $1 AND service_id = $2)
 	`
 
 	for _, serviceId := range servicesIds {
 		_, err = txx.ExecContext(ctx, insertQuery, abonementId, serviceId)
 		if err != nil {
-			return fmt.Errorf("failed to insert service_id %v: %w", serviceId, err)
+			return nil, fmt.Errorf("failed to insert service_id %v: %w", serviceId,
+				mapConstraintError(err, customErrors.ServiceLinkAlreadyExists, customErrors.ServiceNotFound))
 		}
 	}
 
-	if err := txx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	violations, err := abonementRuleViolations(ctx, txx, []uuid.UUID{abonementId})
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		err = customErrors.ServiceRulesViolated
+		return violations, err
 	}
 
-	return nil
+	if err = txx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil, nil
 }
 
 func (serviceRep *ServiceRepository) UpdateCoachServices(ctx context.Context, coachId uuid.UUID, servicesIds []uuid.UUID) error {
@@ -220,25 +593,28 @@ func (serviceRep *ServiceRepository) UpdateCoachServices(ctx context.Context, co
 		}
 	}()
 
+	// Links that stay keep their qualification and price attributes.
 	deleteQuery := `
 		DELETE FROM coach_service
-		WHERE coach_id = $1
+		WHERE coach_id = $1 AND service_id <> ALL($2::uuid[])
 	`
 
-	_, err = txx.ExecContext(ctx, deleteQuery, coachId)
+	_, err = txx.ExecContext(ctx, deleteQuery, coachId, pq.Array(servicesIds))
 	if err != nil {
 		return fmt.Errorf("failed to delete coach services: %w", err)
 	}
 
 	insertQuery := `
 		INSERT INTO coach_service (coach_id, service_id)
-		VALUES ($1, $2)
+		SELECT $1::uuid, $2::uuid
+		WHERE NOT EXISTS (SELECT 1 FROM coach_service WHERE coach_id = $1 AND service_id = $2)
 	`
 
 	for _, serviceId := range servicesIds {
 		_, err = txx.ExecContext(ctx, insertQuery, coachId, serviceId)
 		if err != nil {
-			return fmt.Errorf("failed to insert service_id %v: %w", serviceId, err)
+			return fmt.Errorf("failed to insert service_id %v: %w", serviceId,
+				mapConstraintError(err, customErrors.ServiceLinkAlreadyExists, customErrors.ServiceNotFound))
 		}
 	}
 
@@ -250,7 +626,7 @@ func (serviceRep *ServiceRepository) UpdateCoachServices(ctx context.Context, co
 }
 
 func (serviceRep *ServiceRepository) GetServicesByIds(ctx context.Context, ids []uuid.UUID) ([]*models.Service, error) {
-	query := `SELECT id, title, photo, created_time, updated_time 
+	query := `SELECT id, title, slug, photo, created_time, updated_time 
 			  FROM "service"
 			  WHERE id IN (?)`
 
@@ -265,7 +641,8 @@ func (serviceRep *ServiceRepository) GetServicesByIds(ctx context.Context, ids [
 
 	err = serviceRep.db.SelectContext(ctx, &services, query, args...)
 	if err != nil {
-		return nil, customErrors.ServiceNotFound
+		logger.ErrorLogger.Printf("Error GetServicesByIds: %v", err)
+		return nil, err
 	}
 
 	return services, nil
@@ -274,10 +651,10 @@ func (serviceRep *ServiceRepository) GetServicesByIds(ctx context.Context, ids [
 func (serviceRep *ServiceRepository) GetCoachServices(ctx context.Context, id uuid.UUID) ([]*models.Service, error) {
 	var services []*models.Service
 	err := serviceRep.db.SelectContext(ctx, &services,
-		`SELECT id, title, photo, created_time, updated_time
+		`SELECT id, title, slug, photo, created_time, updated_time
 		 FROM "service"
-	     JOIN "coach_service" on service.id = coach_service.service_id
-		 WHERE id = $1`, id)
+		 JOIN "coach_service" on service.id = coach_service.service_id
+		 WHERE coach_service.coach_id = $1`, id)
 	if err != nil {
 		return nil, err
 	}
@@ -288,7 +665,7 @@ func (serviceRep *ServiceRepository) GetCoachServices(ctx context.Context, id uu
 func (serviceRep *ServiceRepository) GetAbonementServices(ctx context.Context, id uuid.UUID) ([]*models.Service, error) {
 	var services []*models.Service
 	err := serviceRep.db.SelectContext(ctx, &services,
-		`SELECT id, title, photo, created_time, updated_time 
+		`SELECT id, title, slug, photo, created_time, updated_time 
 		 FROM "service"
 		 JOIN "abonement_service" on service.id = abonement_service.service_id
 		 WHERE abonement_service.abonement_id = $1`, id)
@@ -307,7 +684,7 @@ func (serviceRep *ServiceRepository) GetAbonementsServices(ctx context.Context,
 	}
 
 	query := `
-		SELECT abonement_service.abonement_id, service.id, service.title, service.photo, service.created_time, service.updated_time
+		SELECT abonement_service.abonement_id, service.id, service.title, service.slug, service.photo, service.created_time, service.updated_time
 		FROM "service"
 		JOIN "abonement_service" ON service.id = abonement_service.service_id
 		WHERE abonement_service.abonement_id = ANY($1)
@@ -317,6 +694,7 @@ func (serviceRep *ServiceRepository) GetAbonementsServices(ctx context.Context,
 		AbonementID uuid.UUID `db:"abonement_id"`
 		Id          uuid.UUID `db:"id"`
 		Title       string    `db:"title"`
+		Slug        string    `db:"slug"`
 		Photo       string    `db:"photo"`
 		CreatedTime time.Time `db:"created_time"`
 		UpdatedTime time.Time `db:"updated_time"`
@@ -334,6 +712,7 @@ func (serviceRep *ServiceRepository) GetAbonementsServices(ctx context.Context,
 		service := &models.Service{
 			Id:          row.Id,
 			Title:       row.Title,
+			Slug:        row.Slug,
 			Photo:       row.Photo,
 			CreatedTime: row.CreatedTime,
 			UpdatedTime: row.UpdatedTime,
@@ -353,7 +732,7 @@ func (serviceRep *ServiceRepository) GetCoachesServices(ctx context.Context, ids
 	}
 
 	query := `
-		SELECT coach_service.coach_id, service.id, service.title, service.photo, service.created_time, service.updated_time
+		SELECT coach_service.coach_id, service.id, service.title, service.slug, service.photo, service.created_time, service.updated_time
 		FROM "service"
 		JOIN "coach_service" ON service.id = coach_service.service_id
 		WHERE coach_service.coach_id = ANY($1)
@@ -363,6 +742,7 @@ func (serviceRep *ServiceRepository) GetCoachesServices(ctx context.Context, ids
 		CoachID     uuid.UUID `db:"coach_id"`
 		Id          uuid.UUID `db:"id"`
 		Title       string    `db:"title"`
+		Slug        string    `db:"slug"`
 		Photo       string    `db:"photo"`
 		CreatedTime time.Time `db:"created_time"`
 		UpdatedTime time.Time `db:"updated_time"`
@@ -380,6 +760,7 @@ func (serviceRep *ServiceRepository) GetCoachesServices(ctx context.Context, ids
 		service := &models.Service{
 			Id:          row.Id,
 			Title:       row.Title,
+			Slug:        row.Slug,
 			Photo:       row.Photo,
 			CreatedTime: row.CreatedTime,
 			UpdatedTime: row.UpdatedTime,
@@ -390,3 +771,51 @@ func (serviceRep *ServiceRepository) GetCoachesServices(ctx context.Context, ids
 
 	return coachServices, nil
 }
+
+func (serviceRep *ServiceRepository) GetServiceByNormalizedTitle(ctx context.Context, normalizedTitle string) (*models.Service, error) {
+	service := &models.Service{}
+	err := serviceRep.db.GetContext(ctx, service,
+		`SELECT id, title, slug, photo, created_time, updated_time FROM "service" WHERE normalized_title = $1`, normalizedTitle)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.ServiceNotFound
+		}
+
+		logger.ErrorLogger.Printf("Error GetServiceByNormalizedTitle: %v", err)
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// GetServiceBySlug resolves current slugs first and falls back to slugs the
+// service had before a rename. The returned flag tells whether the fallback
+// was used.
+func (serviceRep *ServiceRepository) GetServiceBySlug(ctx context.Context, slug string) (*models.Service, bool, error) {
+	service := &models.Service{}
+	err := serviceRep.db.GetContext(ctx, service,
+		`SELECT id, title, slug, photo, created_time, updated_time FROM "service" WHERE slug = $1`, slug)
+	if err == nil {
+		return service, false, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		logger.ErrorLogger.Printf("Error GetServiceBySlug: %v", err)
+		return nil, false, err
+	}
+
+	err = serviceRep.db.GetContext(ctx, service,
+		`SELECT service.id, service.title, service.slug, service.photo, service.created_time, service.updated_time
+		 FROM "service"
+		 JOIN "service_slug_redirect" ON service.id = service_slug_redirect.service_id
+		 WHERE service_slug_redirect.slug = $1`, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, customErrors.ServiceNotFound
+		}
+
+		logger.ErrorLogger.Printf("Error GetServiceBySlug: %v", err)
+		return nil, false, err
+	}
+
+	return service, true, nil
+}
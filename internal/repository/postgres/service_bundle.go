@@ -0,0 +1,198 @@
+package postgres
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// bundleReachable selects the services $1 and everything they contain,
+// directly or through other bundles.
+const bundleReachable = `
+	WITH RECURSIVE reachable AS (
+		SELECT unnest($1::uuid[]) AS id
+		UNION
+		SELECT c.component_id FROM "service_bundle_component" c JOIN reachable ON c.bundle_id = reachable.id
+	)`
+
+func (serviceRep *ServiceRepository) GetBundleComponents(ctx context.Context, bundleId uuid.UUID) ([]*models.Service, error) {
+	var services []*models.Service
+
+	err := serviceRep.db.SelectContext(ctx, &services, `
+		SELECT s.id, s.title, s.slug, s.photo, s.created_time, s.updated_time
+		FROM "service" s
+		JOIN "service_bundle_component" c ON c.component_id = s.id
+		WHERE c.bundle_id = $1
+		ORDER BY s.title, s.id`, bundleId)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetBundleComponents: %v", err)
+		return nil, err
+	}
+
+	return services, nil
+}
+
+// SetBundleComponents replaces the components of the bundle. Changes are
+// serialized by a table lock, otherwise two concurrent changes could both
+// pass the cycle check and close a loop together. The lock also keeps
+// abonement services from changing, so the abonements holding the bundle are
+// checked against the rules before committing: the rules the change breaks
+// there are returned with ServiceRulesViolated.
+func (serviceRep *ServiceRepository) SetBundleComponents(
+	ctx context.Context,
+	bundleId uuid.UUID,
+	componentIds []uuid.UUID,
+) ([]*models.ServiceRuleViolation, error) {
+
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	err = lockBundles(ctx, txx)
+	if err != nil {
+		return nil, err
+	}
+
+	var exists bool
+	err = txx.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM "service" WHERE id = $1)`, bundleId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bundle: %w", err)
+	}
+	if !exists {
+		err = customErrors.ServiceNotFound
+		return nil, err
+	}
+
+	var cycle bool
+	err = txx.GetContext(ctx, &cycle, bundleReachable+`
+		SELECT EXISTS (SELECT 1 FROM reachable WHERE id = $2)`, pq.Array(componentIds), bundleId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bundle cycle: %w", err)
+	}
+	if cycle {
+		err = customErrors.BundleCycle
+		return nil, err
+	}
+
+	affected, err := abonementsHolding(ctx, txx, bundleId)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := abonementRuleViolations(ctx, txx, affected)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = txx.ExecContext(ctx, `DELETE FROM "service_bundle_component" WHERE bundle_id = $1`, bundleId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete bundle components: %w", err)
+	}
+
+	_, err = txx.ExecContext(ctx, `
+		INSERT INTO "service_bundle_component" (bundle_id, component_id)
+		SELECT $1, unnest($2::uuid[])`, bundleId, pq.Array(componentIds))
+	if err != nil {
+		logger.ErrorLogger.Printf("Error SetBundleComponents: %v", err)
+		err = mapConstraintError(err, nil, customErrors.ServiceNotFound)
+		return nil, err
+	}
+
+	after, err := abonementRuleViolations(ctx, txx, affected)
+	if err != nil {
+		return nil, err
+	}
+
+	if added := models.AddedViolations(before, after); len(added) > 0 {
+		err = customErrors.ServiceRulesViolated
+		return added, err
+	}
+
+	if err = txx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil, nil
+}
+
+// GetBundlesComponentIds returns the direct components of those of the
+// services that are bundles.
+func (serviceRep *ServiceRepository) GetBundlesComponentIds(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	var rows []struct {
+		BundleId    uuid.UUID `db:"bundle_id"`
+		ComponentId uuid.UUID `db:"component_id"`
+	}
+
+	err := serviceRep.db.SelectContext(ctx, &rows, `
+		SELECT c.bundle_id, c.component_id
+		FROM "service_bundle_component" c
+		JOIN "service" s ON s.id = c.component_id
+		WHERE c.bundle_id = ANY($1)
+		ORDER BY c.bundle_id, s.title, s.id`, pq.Array(ids))
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetBundlesComponentIds: %v", err)
+		return nil, err
+	}
+
+	components := make(map[uuid.UUID][]uuid.UUID)
+	for _, row := range rows {
+		components[row.BundleId] = append(components[row.BundleId], row.ComponentId)
+	}
+
+	return components, nil
+}
+
+// GetBundlesLeafIds returns, for those of the services that are bundles, the
+// services they are ultimately made of: nested bundles are replaced by their
+// own components.
+func (serviceRep *ServiceRepository) GetBundlesLeafIds(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	var rows []struct {
+		BundleId    uuid.UUID `db:"bundle_id"`
+		ComponentId uuid.UUID `db:"component_id"`
+	}
+
+	err := serviceRep.db.SelectContext(ctx, &rows, `
+		WITH RECURSIVE tree AS (
+			SELECT bundle_id AS root_id, component_id FROM "service_bundle_component" WHERE bundle_id = ANY($1)
+			UNION
+			SELECT tree.root_id, c.component_id
+			FROM tree JOIN "service_bundle_component" c ON c.bundle_id = tree.component_id
+		)
+		SELECT tree.root_id AS bundle_id, tree.component_id
+		FROM tree
+		JOIN "service" s ON s.id = tree.component_id
+		WHERE NOT EXISTS (SELECT 1 FROM "service_bundle_component" c WHERE c.bundle_id = tree.component_id)
+		ORDER BY tree.root_id, s.title, s.id`, pq.Array(ids))
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetBundlesLeafIds: %v", err)
+		return nil, err
+	}
+
+	leaves := make(map[uuid.UUID][]uuid.UUID)
+	for _, row := range rows {
+		leaves[row.BundleId] = append(leaves[row.BundleId], row.ComponentId)
+	}
+
+	return leaves, nil
+}
+
+func lockBundles(ctx context.Context, txx *sqlx.Tx) error {
+	_, err := txx.ExecContext(ctx, `LOCK TABLE "service_bundle_component" IN SHARE ROW EXCLUSIVE MODE`)
+	if err != nil {
+		return fmt.Errorf("failed to lock bundles: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+func (serviceRep *ServiceRepository) GetServiceHours(ctx context.Context, serviceId uuid.UUID) (*models.ServiceHours, error) {
+	hours := &models.ServiceHours{}
+
+	err := serviceRep.db.GetContext(ctx, hours,
+		`SELECT service_id, time_zone, updated_time FROM "service_schedule" WHERE service_id = $1`, serviceId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.ServiceHoursNotFound
+		}
+		logger.ErrorLogger.Printf("Error GetServiceHours: %v", err)
+		return nil, err
+	}
+
+	err = serviceRep.db.SelectContext(ctx, &hours.Intervals, `
+		SELECT weekday, opens_minute, closes_minute
+		FROM "service_opening_interval"
+		WHERE service_id = $1
+		ORDER BY weekday, opens_minute`, serviceId)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetServiceHours: %v", err)
+		return nil, err
+	}
+
+	return hours, nil
+}
+
+// SetServiceHours replaces the time zone and every interval of the service.
+func (serviceRep *ServiceRepository) SetServiceHours(ctx context.Context, hours *models.ServiceHours) error {
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	_, err = txx.NamedExecContext(ctx, `
+		INSERT INTO "service_schedule" (service_id, time_zone, updated_time)
+		VALUES (:service_id, :time_zone, :updated_time)
+		ON CONFLICT (service_id) DO UPDATE SET time_zone = excluded.time_zone, updated_time = excluded.updated_time`, hours)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error SetServiceHours: %v", err)
+		err = mapConstraintError(err, nil, customErrors.ServiceNotFound)
+		return err
+	}
+
+	_, err = txx.ExecContext(ctx, `DELETE FROM "service_opening_interval" WHERE service_id = $1`, hours.ServiceId)
+	if err != nil {
+		return fmt.Errorf("failed to delete opening intervals: %w", err)
+	}
+
+	if len(hours.Intervals) > 0 {
+		weekdays := make([]int64, 0, len(hours.Intervals))
+		opens := make([]int64, 0, len(hours.Intervals))
+		closes := make([]int64, 0, len(hours.Intervals))
+		for _, interval := range hours.Intervals {
+			weekdays = append(weekdays, int64(interval.Weekday))
+			opens = append(opens, int64(interval.OpensMinute))
+			closes = append(closes, int64(interval.ClosesMinute))
+		}
+
+		_, err = txx.ExecContext(ctx, `
+			INSERT INTO "service_opening_interval" (service_id, weekday, opens_minute, closes_minute)
+			SELECT $1, weekday, opens_minute, closes_minute
+			FROM unnest($2::smallint[], $3::smallint[], $4::smallint[]) AS interval (weekday, opens_minute, closes_minute)`,
+			hours.ServiceId, pq.Array(weekdays), pq.Array(opens), pq.Array(closes))
+		if err != nil {
+			logger.ErrorLogger.Printf("Error SetServiceHours: %v", err)
+			return err
+		}
+	}
+
+	if err = txx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// AddServiceClosure sends dates as text, a time.Time would be converted to a
+// date in the time zone of the database session.
+func (serviceRep *ServiceRepository) AddServiceClosure(ctx context.Context, closure *models.ServiceClosure) error {
+	_, err := serviceRep.db.ExecContext(ctx, `
+		INSERT INTO "service_closure" (id, service_id, start_date, end_date, kind, reason, created_time)
+		VALUES ($1, $2, $3::date, $4::date, $5, $6, $7)`,
+		closure.Id, closure.ServiceId, closure.StartDate.Format(models.DateLayout), closure.EndDate.Format(models.DateLayout),
+		closure.Kind, closure.Reason, closure.CreatedTime)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error AddServiceClosure: %v", err)
+		return mapConstraintError(err, nil, customErrors.ServiceNotFound)
+	}
+
+	return nil
+}
+
+func (serviceRep *ServiceRepository) RemoveServiceClosure(ctx context.Context, serviceId uuid.UUID, closureId uuid.UUID) error {
+	result, err := serviceRep.db.ExecContext(ctx,
+		`DELETE FROM "service_closure" WHERE id = $1 AND service_id = $2`, closureId, serviceId)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error RemoveServiceClosure: %v", err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return customErrors.ServiceClosureNotFound
+	}
+
+	return nil
+}
+
+// GetServiceClosures returns the closures overlapping the dates from and to,
+// both included.
+func (serviceRep *ServiceRepository) GetServiceClosures(
+	ctx context.Context,
+	serviceId uuid.UUID,
+	from time.Time,
+	to time.Time,
+) ([]*models.ServiceClosure, error) {
+
+	var closures []*models.ServiceClosure
+
+	err := serviceRep.db.SelectContext(ctx, &closures, `
+		SELECT id, service_id, start_date, end_date, kind, reason, created_time
+		FROM "service_closure"
+		WHERE service_id = $1 AND end_date >= $2::date AND start_date <= $3::date
+		ORDER BY start_date, id`,
+		serviceId, from.Format(models.DateLayout), to.Format(models.DateLayout))
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetServiceClosures: %v", err)
+		return nil, err
+	}
+
+	return closures, nil
+}
@@ -0,0 +1,308 @@
+package postgres
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+func (serviceRep *ServiceRepository) GetServiceMedia(ctx context.Context, serviceId uuid.UUID) ([]*models.ServiceMedia, error) {
+	var media []*models.ServiceMedia
+
+	err := serviceRep.db.SelectContext(ctx, &media, `
+		SELECT id, service_id, object_key, media_type, content_type, alt_text, position, is_cover, created_time
+		FROM "service_media"
+		WHERE service_id = $1
+		ORDER BY position`, serviceId)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetServiceMedia: %v", err)
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// AddServiceMedia appends the media to the gallery. It becomes the cover when
+// asked to, or when it is the first media of a service without a photo.
+func (serviceRep *ServiceRepository) AddServiceMedia(ctx context.Context, media *models.ServiceMedia, maxMedia int) error {
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	photo, err := lockServicePhoto(ctx, txx, media.ServiceId)
+	if err != nil {
+		return err
+	}
+
+	var count int
+	err = txx.GetContext(ctx, &count, `SELECT count(*) FROM "service_media" WHERE service_id = $1`, media.ServiceId)
+	if err != nil {
+		return fmt.Errorf("failed to count service media: %w", err)
+	}
+	if count >= maxMedia {
+		err = customErrors.ServiceMediaLimitReached
+		return err
+	}
+
+	media.Position = count
+	media.Cover = media.Cover || (count == 0 && photo == "")
+
+	if media.Cover {
+		err = resetCover(ctx, txx, media.ServiceId)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = txx.NamedExecContext(ctx, `
+		INSERT INTO "service_media" (id, service_id, object_key, media_type, content_type, alt_text, position, is_cover, created_time)
+		VALUES (:id, :service_id, :object_key, :media_type, :content_type, :alt_text, :position, :is_cover, :created_time)`, media)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error AddServiceMedia: %v", err)
+		return err
+	}
+
+	if media.Cover {
+		err = setServicePhoto(ctx, txx, media.ServiceId, media.ObjectKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = txx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveServiceMedia closes the gap in the ordering and, when the cover is
+// removed, promotes the next media to cover.
+func (serviceRep *ServiceRepository) RemoveServiceMedia(ctx context.Context, serviceId uuid.UUID, mediaId uuid.UUID) (*models.ServiceMedia, error) {
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	photo, err := lockServicePhoto(ctx, txx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := &models.ServiceMedia{}
+	err = txx.GetContext(ctx, removed, `
+		DELETE FROM "service_media"
+		WHERE id = $1 AND service_id = $2
+		RETURNING id, service_id, object_key, media_type, content_type, alt_text, position, is_cover, created_time`,
+		mediaId, serviceId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = customErrors.ServiceMediaNotFound
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to remove service media: %w", err)
+	}
+
+	_, err = txx.ExecContext(ctx, `
+		UPDATE "service_media" SET position = position - 1
+		WHERE service_id = $1 AND position > $2`, serviceId, removed.Position)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reorder service media: %w", err)
+	}
+
+	if removed.Cover {
+		var nextCover string
+		err = txx.GetContext(ctx, &nextCover, `
+			UPDATE "service_media" SET is_cover = TRUE
+			WHERE id = (SELECT id FROM "service_media" WHERE service_id = $1 ORDER BY position LIMIT 1)
+			RETURNING object_key`, serviceId)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to promote cover: %w", err)
+		}
+
+		if nextCover != "" || photo == removed.ObjectKey {
+			err = setServicePhoto(ctx, txx, serviceId, nextCover)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = txx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return removed, nil
+}
+
+func (serviceRep *ServiceRepository) ReorderServiceMedia(ctx context.Context, serviceId uuid.UUID, mediaIds []uuid.UUID) error {
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	_, err = lockServicePhoto(ctx, txx, serviceId)
+	if err != nil {
+		return err
+	}
+
+	var currentIds []uuid.UUID
+	err = txx.SelectContext(ctx, &currentIds, `SELECT id FROM "service_media" WHERE service_id = $1`, serviceId)
+	if err != nil {
+		return fmt.Errorf("failed to get service media: %w", err)
+	}
+
+	if !sameIds(currentIds, mediaIds) {
+		err = customErrors.InvalidMediaOrder
+		return err
+	}
+
+	_, err = txx.ExecContext(ctx, `
+		UPDATE "service_media" m SET position = o.ord - 1
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, ord)
+		WHERE m.id = o.id AND m.service_id = $1`, serviceId, pq.Array(mediaIds))
+	if err != nil {
+		return fmt.Errorf("failed to reorder service media: %w", err)
+	}
+
+	if err = txx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (serviceRep *ServiceRepository) SetServiceMediaCover(ctx context.Context, serviceId uuid.UUID, mediaId uuid.UUID) error {
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	_, err = lockServicePhoto(ctx, txx, serviceId)
+	if err != nil {
+		return err
+	}
+
+	var objectKey string
+	err = txx.GetContext(ctx, &objectKey,
+		`SELECT object_key FROM "service_media" WHERE id = $1 AND service_id = $2`, mediaId, serviceId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = customErrors.ServiceMediaNotFound
+			return err
+		}
+		return fmt.Errorf("failed to get service media: %w", err)
+	}
+
+	err = resetCover(ctx, txx, serviceId)
+	if err != nil {
+		return err
+	}
+
+	_, err = txx.ExecContext(ctx, `UPDATE "service_media" SET is_cover = TRUE WHERE id = $1`, mediaId)
+	if err != nil {
+		return fmt.Errorf("failed to set cover: %w", err)
+	}
+
+	err = setServicePhoto(ctx, txx, serviceId, objectKey)
+	if err != nil {
+		return err
+	}
+
+	if err = txx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// lockServicePhoto locks the service row so that gallery changes of one
+// service are serialized, and returns its current photo.
+func lockServicePhoto(ctx context.Context, txx *sqlx.Tx, serviceId uuid.UUID) (string, error) {
+	var photo string
+
+	err := txx.GetContext(ctx, &photo, `SELECT photo FROM "service" WHERE id = $1 FOR UPDATE`, serviceId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", customErrors.ServiceNotFound
+		}
+		return "", fmt.Errorf("failed to lock service: %w", err)
+	}
+
+	return photo, nil
+}
+
+func setServicePhoto(ctx context.Context, txx *sqlx.Tx, serviceId uuid.UUID, photo string) error {
+	_, err := txx.ExecContext(ctx, `UPDATE "service" SET photo = $1, updated_time = now() WHERE id = $2`, photo, serviceId)
+	if err != nil {
+		return fmt.Errorf("failed to update service photo: %w", err)
+	}
+
+	return nil
+}
+
+// resetCover leaves the gallery of the service without a cover, as when the
+// photo is replaced by one from outside the gallery.
+func resetCover(ctx context.Context, txx *sqlx.Tx, serviceId uuid.UUID) error {
+	_, err := txx.ExecContext(ctx, `UPDATE "service_media" SET is_cover = FALSE WHERE service_id = $1 AND is_cover`, serviceId)
+	if err != nil {
+		return fmt.Errorf("failed to reset cover: %w", err)
+	}
+
+	return nil
+}
+
+func sameIds(current []uuid.UUID, requested []uuid.UUID) bool {
+	if len(current) != len(requested) {
+		return false
+	}
+
+	seen := make(map[uuid.UUID]bool, len(current))
+	for _, id := range current {
+		seen[id] = true
+	}
+
+	for _, id := range requested {
+		if !seen[id] {
+			return false
+		}
+		delete(seen, id)
+	}
+
+	return true
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+// serviceUsageQuery sums up every service, or only those without coaches
+// when $3 is set. Links are counted from the link tables, visits within
+// [$1, $2).
+const serviceUsageQuery = `
+	SELECT s.id AS service_id, s.title,
+	       (SELECT count(DISTINCT cs.coach_id) FROM "coach_service" cs WHERE cs.service_id = s.id) AS coaches,
+	       (SELECT count(DISTINCT a.abonement_id) FROM "abonement_service" a WHERE a.service_id = s.id) AS abonements,
+	       coalesce(v.visits, 0) AS visits,
+	       coalesce(v.guest_visits, 0) AS guest_visits,
+	       coalesce(v.members, 0) AS members
+	FROM "service" s
+	LEFT JOIN (
+		SELECT service_id,
+		       count(*) FILTER (WHERE NOT guest) AS visits,
+		       count(*) FILTER (WHERE guest) AS guest_visits,
+		       count(DISTINCT member_id) AS members
+		FROM "service_visit"
+		WHERE visited_time >= $1 AND visited_time < $2
+		GROUP BY service_id
+	) v ON v.service_id = s.id
+	WHERE NOT $3 OR NOT EXISTS (SELECT 1 FROM "coach_service" cs WHERE cs.service_id = s.id)
+	ORDER BY visits DESC, abonements DESC, coaches DESC, s.title, s.id`
+
+// GetServiceUsage returns every service, the most visited first.
+func (serviceRep *ServiceRepository) GetServiceUsage(ctx context.Context, from time.Time, to time.Time) ([]*models.ServiceUsage, error) {
+	var usage []*models.ServiceUsage
+
+	err := serviceRep.db.SelectContext(ctx, &usage, serviceUsageQuery, from, to, false)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetServiceUsage: %v", err)
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// GetUncoachedServices returns the services no coach offers, the most
+// visited first.
+func (serviceRep *ServiceRepository) GetUncoachedServices(ctx context.Context, from time.Time, to time.Time) ([]*models.ServiceUsage, error) {
+	var usage []*models.ServiceUsage
+
+	err := serviceRep.db.SelectContext(ctx, &usage, serviceUsageQuery, from, to, true)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetUncoachedServices: %v", err)
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// GetVisitTrend counts visits per period of the given granularity, periods
+// starting at local midnight in timeZone. Periods without visits are left
+// out.
+func (serviceRep *ServiceRepository) GetVisitTrend(
+	ctx context.Context,
+	serviceId *uuid.UUID,
+	granularity string,
+	timeZone string,
+	from time.Time,
+	to time.Time,
+) ([]*models.TrendPoint, error) {
+
+	var points []*models.TrendPoint
+
+	err := serviceRep.db.SelectContext(ctx, &points, `
+		SELECT date_trunc($1, visited_time AT TIME ZONE $2) AT TIME ZONE $2 AS period_start,
+		       count(*) FILTER (WHERE NOT guest) AS visits,
+		       count(*) FILTER (WHERE guest) AS guest_visits,
+		       count(DISTINCT member_id) AS members
+		FROM "service_visit"
+		WHERE ($3::uuid IS NULL OR service_id = $3)
+		  AND visited_time >= $4 AND visited_time < $5
+		GROUP BY 1
+		ORDER BY 1`, granularity, timeZone, serviceId, from, to)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetVisitTrend: %v", err)
+		return nil, err
+	}
+
+	return points, nil
+}
@@ -0,0 +1,261 @@
+package postgres
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const serviceRuleColumns = `id, kind, service_id, other_service_id, description, created_time`
+
+// CreateServiceRule stores the rule unless a rule of the other kind binds the
+// same two services. Rules are serialized by a table lock, otherwise two
+// contradicting rules could pass the check together.
+func (serviceRep *ServiceRepository) CreateServiceRule(ctx context.Context, rule *models.ServiceRule) error {
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	_, err = txx.ExecContext(ctx, `LOCK TABLE "service_rule" IN SHARE ROW EXCLUSIVE MODE`)
+	if err != nil {
+		return fmt.Errorf("failed to lock service rules: %w", err)
+	}
+
+	var conflict bool
+	err = txx.GetContext(ctx, &conflict, `
+		SELECT EXISTS (
+			SELECT 1 FROM "service_rule"
+			WHERE kind <> $1
+			  AND ((service_id = $2 AND other_service_id = $3) OR (service_id = $3 AND other_service_id = $2))
+		)`, rule.Kind, rule.ServiceId, rule.OtherServiceId)
+	if err != nil {
+		return fmt.Errorf("failed to check service rule conflict: %w", err)
+	}
+	if conflict {
+		err = customErrors.ServiceRuleConflict
+		return err
+	}
+
+	_, err = txx.NamedExecContext(ctx, `
+		INSERT INTO "service_rule" (`+serviceRuleColumns+`)
+		VALUES (:id, :kind, :service_id, :other_service_id, :description, :created_time)`, rule)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error CreateServiceRule: %v", err)
+		err = mapConstraintError(err, customErrors.ServiceRuleAlreadyExists, customErrors.ServiceNotFound)
+		return err
+	}
+
+	if err = txx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (serviceRep *ServiceRepository) DeleteServiceRule(ctx context.Context, id uuid.UUID) error {
+	result, err := serviceRep.db.ExecContext(ctx, `DELETE FROM "service_rule" WHERE id = $1`, id)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error DeleteServiceRule: %v", err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return customErrors.ServiceRuleNotFound
+	}
+
+	return nil
+}
+
+// GetServiceRules returns every rule, or those naming the service on either
+// side when serviceId is set.
+func (serviceRep *ServiceRepository) GetServiceRules(ctx context.Context, serviceId *uuid.UUID) ([]*models.ServiceRule, error) {
+	var rules []*models.ServiceRule
+
+	err := serviceRep.db.SelectContext(ctx, &rules, `
+		SELECT `+serviceRuleColumns+` FROM "service_rule"
+		WHERE $1::uuid IS NULL OR service_id = $1 OR other_service_id = $1
+		ORDER BY created_time, id`, serviceId)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetServiceRules: %v", err)
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// CheckServiceSet evaluates the rules for a proposed set of services.
+func (serviceRep *ServiceRepository) CheckServiceSet(ctx context.Context, ids []uuid.UUID) (*models.ServiceSetCheck, error) {
+	broken, err := brokenServiceRules(ctx, serviceRep.db, map[uuid.UUID][]uuid.UUID{uuid.Nil: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ServiceSetCheck{Violations: broken[uuid.Nil]}, nil
+}
+
+// lockAbonementServices serializes the changes of the services of one
+// abonement. Bundle contents are share locked first so they cannot change
+// under the rule check. SetBundleComponents and DeleteService take the same
+// table lock exclusively before anything else, which keeps them away from
+// every abonement without per-abonement locks and in the same lock order.
+func lockAbonementServices(ctx context.Context, txx *sqlx.Tx, abonementId uuid.UUID) error {
+	_, err := txx.ExecContext(ctx, `LOCK TABLE "service_bundle_component" IN SHARE MODE`)
+	if err != nil {
+		return fmt.Errorf("failed to lock bundles: %w", err)
+	}
+
+	_, err = txx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtextextended('abonement_service:' || $1::text, 0))`, abonementId)
+	if err != nil {
+		return fmt.Errorf("failed to lock abonement services: %w", err)
+	}
+
+	return nil
+}
+
+// abonementsHolding returns the abonements that include the service, directly
+// or through bundles.
+func abonementsHolding(ctx context.Context, q sqlx.QueryerContext, serviceId uuid.UUID) ([]uuid.UUID, error) {
+	var abonementIds []uuid.UUID
+
+	err := sqlx.SelectContext(ctx, q, &abonementIds, `
+		WITH RECURSIVE holders AS (
+			SELECT $1::uuid AS id
+			UNION
+			SELECT c.bundle_id FROM "service_bundle_component" c JOIN holders ON c.component_id = holders.id
+		)
+		SELECT DISTINCT abonement_id FROM "abonement_service"
+		WHERE service_id IN (SELECT id FROM holders)
+		ORDER BY abonement_id`, serviceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get abonements holding service: %w", err)
+	}
+
+	return abonementIds, nil
+}
+
+// abonementRuleViolations checks the current services of the abonements.
+func abonementRuleViolations(ctx context.Context, q sqlx.QueryerContext, abonementIds []uuid.UUID) ([]*models.ServiceRuleViolation, error) {
+	if len(abonementIds) == 0 {
+		return nil, nil
+	}
+
+	var links []struct {
+		AbonementId uuid.UUID `db:"abonement_id"`
+		ServiceId   uuid.UUID `db:"service_id"`
+	}
+
+	err := sqlx.SelectContext(ctx, q, &links, `
+		SELECT abonement_id, service_id FROM "abonement_service" WHERE abonement_id = ANY($1)`, pq.Array(abonementIds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get abonement services: %w", err)
+	}
+
+	sets := make(map[uuid.UUID][]uuid.UUID, len(abonementIds))
+	for _, link := range links {
+		sets[link.AbonementId] = append(sets[link.AbonementId], link.ServiceId)
+	}
+
+	broken, err := brokenServiceRules(ctx, q, sets)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []*models.ServiceRuleViolation
+	for _, abonementId := range abonementIds {
+		for _, rule := range broken[abonementId] {
+			violations = append(violations, &models.ServiceRuleViolation{AbonementId: abonementId, Rule: rule})
+		}
+	}
+
+	return violations, nil
+}
+
+// brokenServiceRules checks the service sets of several owners at once.
+// Everything the bundles of a set contain, however deeply nested, counts as
+// part of it.
+func brokenServiceRules(
+	ctx context.Context,
+	q sqlx.QueryerContext,
+	sets map[uuid.UUID][]uuid.UUID,
+) (map[uuid.UUID][]*models.ServiceRule, error) {
+
+	var ownerIds, servicesIds []uuid.UUID
+	for ownerId, ids := range sets {
+		for _, id := range ids {
+			ownerIds = append(ownerIds, ownerId)
+			servicesIds = append(servicesIds, id)
+		}
+	}
+
+	broken := make(map[uuid.UUID][]*models.ServiceRule)
+	if len(servicesIds) == 0 {
+		return broken, nil
+	}
+
+	var rows []struct {
+		OwnerId   uuid.UUID `db:"owner_id"`
+		ServiceId uuid.UUID `db:"service_id"`
+	}
+
+	err := sqlx.SelectContext(ctx, q, &rows, `
+		WITH RECURSIVE included AS (
+			SELECT owner_id, service_id FROM unnest($1::uuid[], $2::uuid[]) AS t(owner_id, service_id)
+			UNION
+			SELECT included.owner_id, c.component_id
+			FROM included JOIN "service_bundle_component" c ON c.bundle_id = included.service_id
+		)
+		SELECT owner_id, service_id FROM included`, pq.Array(ownerIds), pq.Array(servicesIds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand service sets: %w", err)
+	}
+
+	included := make(map[uuid.UUID]map[uuid.UUID]bool, len(sets))
+	seen := make(map[uuid.UUID]bool, len(rows))
+	var allIds []uuid.UUID
+	for _, row := range rows {
+		if included[row.OwnerId] == nil {
+			included[row.OwnerId] = make(map[uuid.UUID]bool)
+		}
+		included[row.OwnerId][row.ServiceId] = true
+
+		if !seen[row.ServiceId] {
+			seen[row.ServiceId] = true
+			allIds = append(allIds, row.ServiceId)
+		}
+	}
+
+	// A rule only applies when its first service is in the set.
+	var rules []*models.ServiceRule
+	err = sqlx.SelectContext(ctx, q, &rules, `
+		SELECT `+serviceRuleColumns+` FROM "service_rule"
+		WHERE service_id = ANY($1)
+		ORDER BY created_time, id`, pq.Array(allIds))
+	if err != nil {
+		logger.ErrorLogger.Printf("Error brokenServiceRules: %v", err)
+		return nil, err
+	}
+
+	for ownerId, services := range included {
+		if ownerBroken := models.BrokenRules(services, rules); len(ownerBroken) > 0 {
+			broken[ownerId] = ownerBroken
+		}
+	}
+
+	return broken, nil
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+func (serviceRep *ServiceRepository) UpsertServiceTranslation(ctx context.Context, cmd *dtos.UpsertServiceTranslationCommand) error {
+	now := time.Now()
+
+	_, err := serviceRep.db.ExecContext(ctx, `
+		INSERT INTO "service_translation" (service_id, locale, title, description, created_time, updated_time)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		ON CONFLICT (service_id, locale) DO UPDATE
+		SET title = EXCLUDED.title, description = EXCLUDED.description, updated_time = EXCLUDED.updated_time`,
+		cmd.ServiceId, cmd.Locale, cmd.Title, cmd.Description, now)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error UpsertServiceTranslation: %v", err)
+		return mapConstraintError(err, nil, customErrors.ServiceNotFound)
+	}
+
+	return nil
+}
+
+func (serviceRep *ServiceRepository) DeleteServiceTranslation(ctx context.Context, serviceId uuid.UUID, locale string) error {
+	result, err := serviceRep.db.ExecContext(ctx,
+		`DELETE FROM "service_translation" WHERE service_id = $1 AND locale = $2`, serviceId, locale)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error DeleteServiceTranslation: %v", err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return customErrors.ServiceTranslationNotFound
+	}
+
+	return nil
+}
+
+func (serviceRep *ServiceRepository) GetServiceTranslations(ctx context.Context, serviceId uuid.UUID) ([]*models.ServiceTranslation, error) {
+	var translations []*models.ServiceTranslation
+
+	err := serviceRep.db.SelectContext(ctx, &translations, `
+		SELECT service_id, locale, title, description, created_time, updated_time
+		FROM "service_translation"
+		WHERE service_id = $1
+		ORDER BY locale`, serviceId)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetServiceTranslations: %v", err)
+		return nil, err
+	}
+
+	return translations, nil
+}
+
+// GetServicesTranslations returns translations of the given services limited
+// to the given locales, keyed by service id and then by locale.
+func (serviceRep *ServiceRepository) GetServicesTranslations(
+	ctx context.Context,
+	servicesIds []uuid.UUID,
+	locales []string,
+) (map[uuid.UUID]map[string]*models.ServiceTranslation, error) {
+
+	servicesTranslations := make(map[uuid.UUID]map[string]*models.ServiceTranslation)
+
+	if len(servicesIds) == 0 || len(locales) == 0 {
+		return servicesTranslations, nil
+	}
+
+	var translations []*models.ServiceTranslation
+
+	err := serviceRep.db.SelectContext(ctx, &translations, `
+		SELECT service_id, locale, title, description, created_time, updated_time
+		FROM "service_translation"
+		WHERE service_id = ANY($1) AND locale = ANY($2)`, pq.Array(servicesIds), pq.Array(locales))
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetServicesTranslations: %v", err)
+		return nil, err
+	}
+
+	for _, translation := range translations {
+		if servicesTranslations[translation.ServiceId] == nil {
+			servicesTranslations[translation.ServiceId] = make(map[string]*models.ServiceTranslation)
+		}
+
+		servicesTranslations[translation.ServiceId][translation.Locale] = translation
+	}
+
+	return servicesTranslations, nil
+}
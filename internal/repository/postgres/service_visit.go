@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+const visitColumns = `id, event_id, abonement_id, service_id, member_id, guest, visited_time, recorded_time`
+
+// RecordVisit checks the access windows and the quota of the visit and stores
+// it in one transaction. The terms are those of the link granting the
+// service, its own or that of a bundle containing it. The abonement services
+// are locked first and the granting link row after, so visits counted against
+// the same link are recorded one after the other and the quota cannot be
+// overdrawn. A visit whose event was already recorded is returned as a
+// duplicate before any check, so a retried event keeps its original receipt
+// even if the terms changed in between. location is the time zone the windows
+// and quota periods are read in.
+func (serviceRep *ServiceRepository) RecordVisit(
+	ctx context.Context,
+	visit *models.Visit,
+	location *time.Location,
+) (*models.VisitReceipt, error) {
+
+	txx, err := serviceRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = txx.Rollback()
+		}
+	}()
+
+	err = lockAbonementServices(ctx, txx, visit.AbonementId)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := abonementServiceGrant(ctx, txx, visit.AbonementId, visit.ServiceId, "FOR UPDATE")
+	if err != nil {
+		return nil, err
+	}
+	terms := grant.Terms
+
+	receipt := &models.VisitReceipt{Visit: visit}
+
+	recorded := &models.Visit{}
+	err = txx.GetContext(ctx, recorded, `SELECT `+visitColumns+` FROM "service_visit" WHERE event_id = $1`, visit.EventId)
+	switch {
+	case err == nil:
+		if recorded.AbonementId != visit.AbonementId || recorded.ServiceId != visit.ServiceId {
+			err = customErrors.VisitEventConflict
+			return nil, err
+		}
+		receipt.Visit = recorded
+		receipt.Duplicate = true
+	case errors.Is(err, sql.ErrNoRows):
+		err = nil
+	default:
+		return nil, fmt.Errorf("failed to look up visit event: %w", err)
+	}
+
+	local := receipt.Visit.VisitedTime.In(location)
+
+	usage, err := countVisits(ctx, txx, visit.AbonementId, grant.ServiceIds, models.PeriodBounds(terms.QuotaPeriod, local))
+	if err != nil {
+		return nil, err
+	}
+
+	if !receipt.Duplicate {
+		switch {
+		case !terms.AllowsAt(local):
+			err = customErrors.AccessOutsideHours
+			return nil, err
+		case visit.Guest && usage.GuestVisits >= terms.GuestPasses:
+			err = customErrors.GuestPassesUsedUp
+			return nil, err
+		case !visit.Guest && terms.VisitQuota != nil && usage.Visits >= *terms.VisitQuota:
+			err = customErrors.VisitQuotaUsedUp
+			return nil, err
+		}
+
+		_, err = txx.NamedExecContext(ctx, `
+			INSERT INTO "service_visit" (`+visitColumns+`)
+			VALUES (:id, :event_id, :abonement_id, :service_id, :member_id, :guest, :visited_time, :recorded_time)`, visit)
+		if err != nil {
+			logger.ErrorLogger.Printf("Error RecordVisit: %v", err)
+			err = mapConstraintError(err, customErrors.VisitEventConflict, customErrors.ServiceNotFound)
+			return nil, err
+		}
+
+		if visit.Guest {
+			usage.GuestVisits++
+		} else {
+			usage.Visits++
+		}
+	}
+
+	if err = txx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	receipt.RemainingVisits, receipt.RemainingGuestPasses = usage.Remaining(terms)
+
+	return receipt, nil
+}
+
+// CountVisits counts the visits of the abonement to any of the services, the
+// services a link grants share its quota.
+func (serviceRep *ServiceRepository) CountVisits(
+	ctx context.Context,
+	abonementId uuid.UUID,
+	servicesIds []uuid.UUID,
+	bounds *models.QuotaPeriodBounds,
+) (*models.QuotaUsage, error) {
+
+	return countVisits(ctx, serviceRep.db, abonementId, servicesIds, bounds)
+}
+
+func countVisits(
+	ctx context.Context,
+	q sqlx.QueryerContext,
+	abonementId uuid.UUID,
+	servicesIds []uuid.UUID,
+	bounds *models.QuotaPeriodBounds,
+) (*models.QuotaUsage, error) {
+
+	usage := &models.QuotaUsage{}
+
+	err := sqlx.GetContext(ctx, q, usage, `
+		SELECT count(*) FILTER (WHERE NOT guest) AS visits, count(*) FILTER (WHERE guest) AS guest_visits
+		FROM "service_visit"
+		WHERE abonement_id = $1 AND service_id = ANY($2)
+		  AND ($3::timestamptz IS NULL OR visited_time >= $3)
+		  AND ($4::timestamptz IS NULL OR visited_time < $4)`,
+		abonementId, pq.Array(servicesIds), bounds.Start, bounds.End)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error CountVisits: %v", err)
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// GetVisits returns the visits of the abonement within [from, to), latest
+// first, of a single service when serviceId is set.
+func (serviceRep *ServiceRepository) GetVisits(
+	ctx context.Context,
+	abonementId uuid.UUID,
+	serviceId *uuid.UUID,
+	from time.Time,
+	to time.Time,
+) ([]*models.Visit, error) {
+
+	var visits []*models.Visit
+
+	err := serviceRep.db.SelectContext(ctx, &visits, `
+		SELECT `+visitColumns+` FROM "service_visit"
+		WHERE abonement_id = $1 AND ($2::uuid IS NULL OR service_id = $2)
+		  AND visited_time >= $3 AND visited_time < $4
+		ORDER BY visited_time DESC, id`, abonementId, serviceId, from, to)
+	if err != nil {
+		logger.ErrorLogger.Printf("Error GetVisits: %v", err)
+		return nil, err
+	}
+
+	return visits, nil
+}
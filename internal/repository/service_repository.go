@@ -5,22 +5,134 @@ import (
 	"Service/internal/models"
 	"context"
 	"github.com/google/uuid"
+	"time"
 )
 
 type ServiceRepository interface {
 	CreateService(ctx context.Context, service *models.Service) error
 	GetServiceById(ctx context.Context, id uuid.UUID) (*models.Service, error)
-	UpdateService(ctx context.Context, cmd *dtos.UpdateServiceCommand) error
-	DeleteService(ctx context.Context, id uuid.UUID) error
+	UpdateService(ctx context.Context, cmd *dtos.UpdateServiceCommand) (string, error)
+	DeleteService(ctx context.Context, cmd *dtos.DeleteServiceCommand) (*models.ServiceReferences, error)
 
 	GetServices(ctx context.Context) ([]*models.Service, error)
 	CreateCoachServices(ctx context.Context, cmd *dtos.CreateCoachServicesCommand) error
-	CreateAbonementServices(ctx context.Context, cmd *dtos.CreateAbonementServicesCommand) error
+	CreateAbonementServices(ctx context.Context, cmd *dtos.CreateAbonementServicesCommand) ([]*models.ServiceRuleViolation, error)
 	GetServicesByIds(ctx context.Context, ids []uuid.UUID) ([]*models.Service, error)
 	GetCoachServices(ctx context.Context, id uuid.UUID) ([]*models.Service, error)
 	GetAbonementServices(ctx context.Context, id uuid.UUID) ([]*models.Service, error)
 	GetAbonementsServices(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]*models.Service, error)
 	GetCoachesServices(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]*models.Service, error)
-	UpdateAbonementServices(ctx context.Context, abonementId uuid.UUID, servicesIds []uuid.UUID) error
+	UpdateAbonementServices(ctx context.Context, abonementId uuid.UUID, servicesIds []uuid.UUID) ([]*models.ServiceRuleViolation, error)
 	UpdateCoachServices(ctx context.Context, coachId uuid.UUID, servicesIds []uuid.UUID) error
+
+	CreateCoachServiceLink(ctx context.Context, link *models.CoachServiceLink) error
+	UpdateCoachServiceLink(ctx context.Context, link *models.CoachServiceLink) error
+	GetCoachServiceLink(ctx context.Context, coachId uuid.UUID, serviceId uuid.UUID) (*models.CoachServiceLink, error)
+	GetCoachServiceLinks(ctx context.Context, coachId uuid.UUID) ([]*models.CoachServiceLink, error)
+
+	GetBundleComponents(ctx context.Context, bundleId uuid.UUID) ([]*models.Service, error)
+	SetBundleComponents(ctx context.Context, bundleId uuid.UUID, componentIds []uuid.UUID) ([]*models.ServiceRuleViolation, error)
+	GetBundlesComponentIds(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+	GetBundlesLeafIds(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+
+	CreateServiceRule(ctx context.Context, rule *models.ServiceRule) error
+	DeleteServiceRule(ctx context.Context, id uuid.UUID) error
+	GetServiceRules(ctx context.Context, serviceId *uuid.UUID) ([]*models.ServiceRule, error)
+	CheckServiceSet(ctx context.Context, ids []uuid.UUID) (*models.ServiceSetCheck, error)
+
+	GetServiceByNormalizedTitle(ctx context.Context, normalizedTitle string) (*models.Service, error)
+	GetServiceBySlug(ctx context.Context, slug string) (*models.Service, bool, error)
+	GetServicesWithPlaceholderSlug(ctx context.Context) ([]*models.Service, error)
+	SetServiceSlug(ctx context.Context, id uuid.UUID, slug string, normalizedTitle string) error
+
+	UpsertServiceTranslation(ctx context.Context, cmd *dtos.UpsertServiceTranslationCommand) error
+	DeleteServiceTranslation(ctx context.Context, serviceId uuid.UUID, locale string) error
+	GetServiceTranslations(ctx context.Context, serviceId uuid.UUID) ([]*models.ServiceTranslation, error)
+	GetServicesTranslations(ctx context.Context, servicesIds []uuid.UUID, locales []string) (map[uuid.UUID]map[string]*models.ServiceTranslation, error)
+
+	GetServicesClassifications(ctx context.Context, servicesIds []uuid.UUID) (map[uuid.UUID]*models.ServiceClassification, error)
+	FilterServicesIds(ctx context.Context, servicesIds []uuid.UUID, filter *models.ServiceFilter) (map[uuid.UUID]bool, error)
+}
+
+// LinkRepository pages through the owners of coach_service and
+// abonement_service rows and removes links of owners that no longer exist.
+type LinkRepository interface {
+	GetLinkedCoachIds(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error)
+	GetLinkedAbonementIds(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error)
+	DeleteCoachesLinks(ctx context.Context, coachIds []uuid.UUID) (int64, error)
+	DeleteAbonementsLinks(ctx context.Context, abonementIds []uuid.UUID) (int64, error)
+}
+
+type CertificationRepository interface {
+	FlagExpiredCertifications(ctx context.Context, today time.Time, updatedTime time.Time) ([]*models.CoachServiceLink, error)
+}
+
+type PhotoRepository interface {
+	GetServicePhotos(ctx context.Context) ([]string, error)
+}
+
+type PhotoUploadRepository interface {
+	CreatePhotoUpload(ctx context.Context, upload *models.PhotoUpload) error
+	GetPhotoUpload(ctx context.Context, token uuid.UUID) (*models.PhotoUpload, error)
+	DeletePhotoUpload(ctx context.Context, token uuid.UUID) error
+	AttachPhotoUpload(ctx context.Context, upload *models.PhotoUpload, updatedTime time.Time) (string, error)
+	DeleteExpiredPhotoUploads(ctx context.Context, now time.Time) ([]string, error)
+}
+
+type CategoryRepository interface {
+	CreateCategory(ctx context.Context, category *models.ServiceCategory) error
+	GetCategoryById(ctx context.Context, id uuid.UUID) (*models.ServiceCategory, error)
+	GetCategories(ctx context.Context) ([]*models.ServiceCategory, error)
+	UpdateCategory(ctx context.Context, cmd *dtos.UpdateCategoryCommand, updatedTime time.Time) error
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
+
+	SetServiceCategory(ctx context.Context, serviceId uuid.UUID, categoryId *uuid.UUID, updatedTime time.Time) error
+	SetServiceTags(ctx context.Context, serviceId uuid.UUID, tags []string, updatedTime time.Time) error
+	GetServicesClassifications(ctx context.Context, servicesIds []uuid.UUID) (map[uuid.UUID]*models.ServiceClassification, error)
+}
+
+type ServiceHoursRepository interface {
+	GetServiceHours(ctx context.Context, serviceId uuid.UUID) (*models.ServiceHours, error)
+	SetServiceHours(ctx context.Context, hours *models.ServiceHours) error
+
+	AddServiceClosure(ctx context.Context, closure *models.ServiceClosure) error
+	RemoveServiceClosure(ctx context.Context, serviceId uuid.UUID, closureId uuid.UUID) error
+	GetServiceClosures(ctx context.Context, serviceId uuid.UUID, from time.Time, to time.Time) ([]*models.ServiceClosure, error)
+}
+
+type AbonementTermsRepository interface {
+	GetAbonementServiceTerms(ctx context.Context, abonementId uuid.UUID, serviceId uuid.UUID) (*models.AbonementServiceTerms, error)
+	GetAbonementServiceGrant(ctx context.Context, abonementId uuid.UUID, serviceId uuid.UUID) (*models.AbonementServiceGrant, error)
+	SetAbonementServiceTerms(ctx context.Context, terms *models.AbonementServiceTerms) error
+	GetAbonementsServicesTerms(ctx context.Context, abonementIds []uuid.UUID) (map[uuid.UUID]map[uuid.UUID]*models.AbonementServiceTerms, error)
+}
+
+type VisitRepository interface {
+	RecordVisit(ctx context.Context, visit *models.Visit, location *time.Location) (*models.VisitReceipt, error)
+	CountVisits(ctx context.Context, abonementId uuid.UUID, servicesIds []uuid.UUID, bounds *models.QuotaPeriodBounds) (*models.QuotaUsage, error)
+	GetVisits(ctx context.Context, abonementId uuid.UUID, serviceId *uuid.UUID, from time.Time, to time.Time) ([]*models.Visit, error)
+}
+
+type ReportRepository interface {
+	GetServiceUsage(ctx context.Context, from time.Time, to time.Time) ([]*models.ServiceUsage, error)
+	GetUncoachedServices(ctx context.Context, from time.Time, to time.Time) ([]*models.ServiceUsage, error)
+	GetVisitTrend(ctx context.Context, serviceId *uuid.UUID, granularity string, timeZone string, from time.Time, to time.Time) ([]*models.TrendPoint, error)
+}
+
+type BookingRepository interface {
+	GetBookingSettings(ctx context.Context, serviceId uuid.UUID) (*models.BookingSettings, error)
+	SetBookingSettings(ctx context.Context, settings *models.BookingSettings) error
+
+	GetBookedSlots(ctx context.Context, serviceId uuid.UUID, from time.Time, to time.Time) ([]*models.Slot, error)
+	CreateBooking(ctx context.Context, booking *models.Booking, capacity int) error
+	CancelBooking(ctx context.Context, bookingId uuid.UUID, cancelledTime time.Time) (*models.Booking, error)
+	GetBooking(ctx context.Context, bookingId uuid.UUID) (*models.Booking, error)
+}
+
+type ServiceMediaRepository interface {
+	GetServiceMedia(ctx context.Context, serviceId uuid.UUID) ([]*models.ServiceMedia, error)
+	AddServiceMedia(ctx context.Context, media *models.ServiceMedia, maxMedia int) error
+	RemoveServiceMedia(ctx context.Context, serviceId uuid.UUID, mediaId uuid.UUID) (*models.ServiceMedia, error)
+	ReorderServiceMedia(ctx context.Context, serviceId uuid.UUID, mediaIds []uuid.UUID) error
+	SetServiceMediaCover(ctx context.Context, serviceId uuid.UUID, mediaId uuid.UUID) error
 }
@@ -2,14 +2,30 @@ package server
 
 import (
 	serviceGRPC "Service/internal/delivery/grpc"
+	serviceHTTP "Service/internal/delivery/http"
 	"Service/internal/dtos"
+	"Service/internal/events"
 	"Service/internal/models"
 	"Service/internal/repository/postgres"
 	"Service/internal/usecase"
+	"Service/internal/usecase/abonement_access_usecase"
+	"Service/internal/usecase/booking_usecase"
+	"Service/internal/usecase/category_usecase"
+	"Service/internal/usecase/certification_usecase"
 	"Service/internal/usecase/localstack_usecase"
+	"Service/internal/usecase/photo_gc_usecase"
+	"Service/internal/usecase/photo_upload_usecase"
+	"Service/internal/usecase/reconcile_usecase"
+	"Service/internal/usecase/report_usecase"
+	"Service/internal/usecase/service_hours_usecase"
+	"Service/internal/usecase/service_media_usecase"
 	"Service/internal/usecase/service_usecase"
+	"Service/pkg/certs"
 	"Service/pkg/logger"
+	"Service/pkg/resilience"
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	abonementGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.abonement"
 	coachGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
@@ -21,78 +37,353 @@ import (
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
+	grpcCredentials "google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
 	"io/ioutil"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
+)
+
+const (
+	defaultCertReloadInterval = time.Minute
+	fallbackLocale            = "en"
+
+	coachPeer     = "coach"
+	abonementPeer = "abonement"
 )
 
 type AppGRPC struct {
 	gRPCServer     *grpc.Server
+	httpServer     *http.Server
+	debugServer    *http.Server
 	serviceUseCase usecase.ServiceUseCase
 	cloudUseCase   usecase.CloudUseCase
 	coachClient    *coachGRPC.CoachClient
+	stopBackground context.CancelFunc
 }
 
-func NewAppGRPC(cloudConfig *models.CloudConfig) (*AppGRPC, error) {
+func NewAppGRPC(appConfig *models.AppConfig) (*AppGRPC, error) {
 
-	db := initDB()
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
 
-	connCoach, err := grpc.NewClient(os.Getenv("COACH_SERVICE_PORT"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	serverTLS, err := loadTLSConfig(backgroundCtx, appConfig.ServerTLS, true, certs.ServerConfig)
 	if err != nil {
-		logger.ErrorLogger.Printf("failed to connect to coach server: %v", err)
+		stopBackground()
+		logger.ErrorLogger.Printf("failed to load server certificates: %v", err)
 		return nil, err
 	}
 
-	connAbonement, err := grpc.NewClient(os.Getenv("ABONEMENT_SERVICE_PORT"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	err = checkGateway(appConfig.Gateway, appConfig.ServerTLS, serverTLS)
 	if err != nil {
-		logger.ErrorLogger.Printf("failed to connect to abonement server: %v", err)
+		stopBackground()
+		logger.ErrorLogger.Printf("refusing to start HTTP gateway: %v", err)
 		return nil, err
 	}
 
-	coachClient := coachGRPC.NewCoachClient(connCoach)
-	abonementClient := abonementGRPC.NewAbonementClient(connAbonement)
+	db := initDB()
+
+	healthServer := health.NewServer()
+
+	peers, err := dialPeers(backgroundCtx, appConfig, healthServer)
+	if err != nil {
+		stopBackground()
+		return nil, err
+	}
 
 	repository := postgres.NewServiceRepository(db)
 
-	serviceUseCase := service_usecase.NewServiceUseCase(repository, &coachClient, &abonementClient)
+	defaultLocale := os.Getenv("DEFAULT_LOCALE")
+	if defaultLocale == "" {
+		defaultLocale = fallbackLocale
+	}
 
-	awsCfg, err := config.LoadDefaultConfig(context.TODO(),
-		config.WithRegion(cloudConfig.Region),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(cloudConfig.Key, cloudConfig.Secret, "")),
-	)
+	var eventPublisher events.Publisher = events.LogPublisher{}
+	if len(appConfig.Events.WebhookURLs) > 0 {
+		eventPublisher = events.NewWebhookPublisher(appConfig.Events.WebhookURLs, appConfig.Events.Timeout)
+	}
+
+	localStackUseCase, err := newCloudUseCase(appConfig.Cloud)
 	if err != nil {
 		logger.FatalLogger.Fatalf("failed loading config, %v", err)
 		return nil, err
 	}
 
-	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
-		o.UsePathStyle = true
-		o.BaseEndpoint = aws.String(cloudConfig.EndPoint)
-	})
+	serviceUseCase := service_usecase.NewServiceUseCase(
+		repository,
+		localStackUseCase,
+		&peers.coachClient,
+		&peers.abonementClient,
+		defaultLocale,
+		appConfig.PeerCache,
+		eventPublisher,
+	)
+
+	reconcileUseCase := reconcile_usecase.NewReconcileUseCase(
+		repository,
+		&peers.coachClient,
+		&peers.abonementClient,
+		func(coachIds []uuid.UUID, abonementIds []uuid.UUID) {
+			serviceUseCase.InvalidatePeerCache(context.TODO(), coachIds, abonementIds, false)
+		},
+	)
 
-	localStackUseCase := localstack_usecase.NewLocalstackUseCase(client, cloudConfig)
+	if appConfig.Reconcile.Interval > 0 {
+		go reconcileUseCase.Schedule(backgroundCtx, appConfig.Reconcile.Interval, &appConfig.Reconcile.Options)
+	}
 
-	gRPCServer := grpc.NewServer()
+	photoUploadUseCase := photo_upload_usecase.NewPhotoUploadUseCase(repository, serviceUseCase, localStackUseCase, appConfig.PhotoUpload.TTL)
+	if appConfig.PhotoUpload.CleanupInterval > 0 {
+		go photoUploadUseCase.Schedule(backgroundCtx, appConfig.PhotoUpload.CleanupInterval)
+	}
+
+	serviceMediaUseCase := service_media_usecase.NewServiceMediaUseCase(repository, localStackUseCase)
+	categoryUseCase := category_usecase.NewCategoryUseCase(repository)
+	serviceHoursUseCase := service_hours_usecase.NewServiceHoursUseCase(repository)
+	bookingUseCase := booking_usecase.NewBookingUseCase(repository, serviceHoursUseCase)
+	abonementAccessUseCase := abonement_access_usecase.NewAbonementAccessUseCase(repository, repository, serviceHoursUseCase)
+	reportUseCase := report_usecase.NewReportUseCase(repository)
+
+	certificationUseCase := certification_usecase.NewCertificationUseCase(repository)
+	if appConfig.CertificationCheck.Interval > 0 {
+		go certificationUseCase.Schedule(backgroundCtx, appConfig.CertificationCheck.Interval)
+	}
+
+	photoGCUseCase := photo_gc_usecase.NewPhotoGCUseCase(repository, localStackUseCase)
+	if appConfig.PhotoGC.Interval > 0 {
+		go photoGCUseCase.Schedule(backgroundCtx, appConfig.PhotoGC.Interval, &appConfig.PhotoGC.Options)
+	}
+
+	gRPCServer := grpc.NewServer(
+		grpc.Creds(transportCredentials(serverTLS)),
+		grpc.ChainUnaryInterceptor(serviceGRPC.LocaleUnaryInterceptor),
+	)
 
 	serviceGRPC.Register(gRPCServer, serviceUseCase, localStackUseCase)
+	serviceGRPC.RegisterReconciler(gRPCServer, reconcileUseCase)
+	serviceGRPC.RegisterPhotoUpload(gRPCServer, photoUploadUseCase, localStackUseCase)
+	serviceGRPC.RegisterServiceMedia(gRPCServer, serviceMediaUseCase, localStackUseCase)
+	serviceGRPC.RegisterServiceCatalog(gRPCServer, categoryUseCase, serviceUseCase, localStackUseCase)
+	serviceGRPC.RegisterServiceHours(gRPCServer, serviceHoursUseCase)
+	serviceGRPC.RegisterBooking(gRPCServer, bookingUseCase)
+	serviceGRPC.RegisterCoachServiceLinks(gRPCServer, serviceUseCase)
+	serviceGRPC.RegisterAbonementAccess(gRPCServer, abonementAccessUseCase, serviceUseCase, localStackUseCase)
+	serviceGRPC.RegisterServiceReports(gRPCServer, reportUseCase)
+	serviceGRPC.RegisterServiceBundles(gRPCServer, serviceUseCase, localStackUseCase)
+	serviceGRPC.RegisterServiceRules(gRPCServer, serviceUseCase)
+	healthgrpc.RegisterHealthServer(gRPCServer, healthServer)
+
+	mux := http.NewServeMux()
+	serviceHTTP.Register(mux, serviceUseCase, abonementAccessUseCase, localStackUseCase)
+	serviceHTTP.RegisterPhotoUpload(mux, photoUploadUseCase, localStackUseCase)
+	serviceHTTP.RegisterServiceMedia(mux, serviceMediaUseCase, localStackUseCase)
+	serviceHTTP.RegisterCategories(mux, categoryUseCase)
+	serviceHTTP.RegisterServiceHours(mux, serviceHoursUseCase)
+	serviceHTTP.RegisterBooking(mux, bookingUseCase)
+	serviceHTTP.RegisterCoachServiceLinks(mux, serviceUseCase)
+	serviceHTTP.RegisterAbonementAccess(mux, abonementAccessUseCase)
+	serviceHTTP.RegisterReports(mux, reportUseCase)
+	serviceHTTP.RegisterServiceBundles(mux, serviceUseCase, localStackUseCase)
+	serviceHTTP.RegisterServiceRules(mux, serviceUseCase)
+	serviceHTTP.RegisterHealth(mux, peers.coachBreaker, peers.abonementBreaker)
+
+	var handler http.Handler = serviceHTTP.WithLocale(mux)
+	if appConfig.Gateway.AuthToken != "" {
+		handler = serviceHTTP.WithBearerToken(appConfig.Gateway.AuthToken, handler)
+	}
+
+	httpServer := &http.Server{
+		Addr:              appConfig.Gateway.Addr,
+		Handler:           handler,
+		TLSConfig:         serverTLS,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	debugMux := http.NewServeMux()
+	serviceHTTP.RegisterDebug(debugMux)
+	serviceHTTP.RegisterHealth(debugMux, peers.coachBreaker, peers.abonementBreaker)
+
+	debugServer := &http.Server{
+		Addr:              appConfig.Gateway.DebugAddr,
+		Handler:           debugMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	err = serviceUseCase.BackfillSlugs(context.TODO())
+	if err != nil {
+		stopBackground()
+		logger.ErrorLogger.Printf("failed to backfill service slugs: %v", err)
+		return nil, err
+	}
 
 	//to do initial insert if no data
 	err = insertInitServices(serviceUseCase, localStackUseCase)
 	if err != nil {
+		stopBackground()
 		return nil, err
 	}
 
 	return &AppGRPC{
 		gRPCServer:     gRPCServer,
+		httpServer:     httpServer,
+		debugServer:    debugServer,
 		serviceUseCase: serviceUseCase,
 		cloudUseCase:   localStackUseCase,
-		coachClient:    &coachClient,
+		coachClient:    &peers.coachClient,
+		stopBackground: stopBackground,
+	}, nil
+}
+
+type peerClients struct {
+	coachClient      coachGRPC.CoachClient
+	abonementClient  abonementGRPC.AbonementClient
+	coachBreaker     *resilience.Breaker
+	abonementBreaker *resilience.Breaker
+	conns            []*grpc.ClientConn
+}
+
+func (p *peerClients) close() {
+	for _, conn := range p.conns {
+		_ = conn.Close()
+	}
+}
+
+func dialPeers(ctx context.Context, appConfig *models.AppConfig, healthServer *health.Server) (*peerClients, error) {
+	clientTLS, err := loadTLSConfig(ctx, appConfig.ClientTLS, false, certs.ClientConfig)
+	if err != nil {
+		logger.ErrorLogger.Printf("failed to load client certificates: %v", err)
+		return nil, err
+	}
+	clientCreds := transportCredentials(clientTLS)
+
+	coachBreaker := newPeerBreaker(coachPeer, appConfig.Resilience, healthServer)
+	abonementBreaker := newPeerBreaker(abonementPeer, appConfig.Resilience, healthServer)
+
+	connCoach, err := grpc.NewClient(
+		os.Getenv("COACH_SERVICE_PORT"),
+		grpc.WithTransportCredentials(clientCreds),
+		grpc.WithChainUnaryInterceptor(resilience.UnaryClientInterceptor(peerPolicy(appConfig.Resilience), coachBreaker)),
+	)
+	if err != nil {
+		logger.ErrorLogger.Printf("failed to connect to coach server: %v", err)
+		return nil, err
+	}
+
+	connAbonement, err := grpc.NewClient(
+		os.Getenv("ABONEMENT_SERVICE_PORT"),
+		grpc.WithTransportCredentials(clientCreds),
+		grpc.WithChainUnaryInterceptor(resilience.UnaryClientInterceptor(peerPolicy(appConfig.Resilience), abonementBreaker)),
+	)
+	if err != nil {
+		_ = connCoach.Close()
+		logger.ErrorLogger.Printf("failed to connect to abonement server: %v", err)
+		return nil, err
+	}
+
+	return &peerClients{
+		coachClient:      coachGRPC.NewCoachClient(connCoach),
+		abonementClient:  abonementGRPC.NewAbonementClient(connAbonement),
+		coachBreaker:     coachBreaker,
+		abonementBreaker: abonementBreaker,
+		conns:            []*grpc.ClientConn{connCoach, connAbonement},
 	}, nil
 }
 
+func peerPolicy(config *models.ResilienceConfig) resilience.Policy {
+	return resilience.Policy{
+		Timeout:     config.CallTimeout,
+		MaxAttempts: config.MaxAttempts,
+		BaseBackoff: config.BaseBackoff,
+		MaxBackoff:  config.MaxBackoff,
+	}
+}
+
+// newPeerBreaker mirrors the breaker state into metrics and into the health
+// service under the peer name, so a peer outage is visible in health checks
+// without marking this service itself as not serving.
+func newPeerBreaker(peer string, config *models.ResilienceConfig, healthServer *health.Server) *resilience.Breaker {
+	onStateChange := func(name string, state resilience.State) {
+		logger.InfoLogger.Printf("Circuit breaker for %s is %s", name, state)
+
+		resilience.PublishState(name, state)
+
+		servingStatus := healthgrpc.HealthCheckResponse_SERVING
+		if state == resilience.StateOpen {
+			servingStatus = healthgrpc.HealthCheckResponse_NOT_SERVING
+		}
+		healthServer.SetServingStatus(name, servingStatus)
+	}
+
+	resilience.PublishState(peer, resilience.StateClosed)
+	healthServer.SetServingStatus(peer, healthgrpc.HealthCheckResponse_SERVING)
+
+	return resilience.NewBreaker(peer, config.FailureLimit, config.BreakerCooldown, onStateChange)
+}
+
+// loadTLSConfig builds a TLS config that follows certificate rotation on disk,
+// or nil when no TLS setting is configured at all. An incomplete set of
+// settings fails instead of silently going plaintext.
+func loadTLSConfig(
+	ctx context.Context,
+	tlsConfig *models.TLSConfig,
+	requireKeyPair bool,
+	buildConfig func(*certs.Reloader) *tls.Config,
+) (*tls.Config, error) {
+
+	if !tlsConfig.Enabled() {
+		return nil, nil
+	}
+
+	if err := tlsConfig.Validate(requireKeyPair); err != nil {
+		return nil, err
+	}
+
+	reloader, err := certs.NewReloader(tlsConfig.CertFile, tlsConfig.KeyFile, tlsConfig.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := tlsConfig.ReloadInterval
+	if interval <= 0 {
+		interval = defaultCertReloadInterval
+	}
+
+	go reloader.Watch(ctx, interval)
+
+	return buildConfig(reloader), nil
+}
+
+func transportCredentials(tlsConfig *tls.Config) grpcCredentials.TransportCredentials {
+	if tlsConfig == nil {
+		return insecure.NewCredentials()
+	}
+
+	return grpcCredentials.NewTLS(tlsConfig)
+}
+
+// checkGateway refuses an HTTP gateway that would be reachable without TLS or
+// without authenticating its clients, either by certificate or bearer token.
+func checkGateway(gateway *models.GatewayConfig, serverTLS *models.TLSConfig, tlsConfig *tls.Config) error {
+	if gateway.Addr == "" {
+		return nil
+	}
+
+	if tlsConfig == nil {
+		return errors.New("the HTTP gateway requires APP_TLS_CERT_FILE and APP_TLS_KEY_FILE")
+	}
+
+	if serverTLS.CAFile == "" && gateway.AuthToken == "" {
+		return errors.New("the HTTP gateway requires APP_TLS_CLIENT_CA_FILE or APP_HTTP_AUTH_TOKEN")
+	}
+
+	return nil
+}
+
 func (app *AppGRPC) Run(port string) error {
 
 	listen, err := net.Listen(os.Getenv("APP_GRPC_PROTOCOL"), port)
@@ -109,13 +400,51 @@ func (app *AppGRPC) Run(port string) error {
 		}
 	}()
 
+	if app.httpServer.Addr != "" {
+		logger.InfoLogger.Printf("Starting HTTP gateway on port %s", app.httpServer.Addr)
+
+		go func() {
+			if err := app.httpServer.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.FatalLogger.Fatalf("Failed to serve HTTP gateway: %v", err)
+			}
+		}()
+	}
+
+	if app.debugServer.Addr != "" {
+		logger.InfoLogger.Printf("Starting debug listener on %s", app.debugServer.Addr)
+
+		go func() {
+			if err := app.debugServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.FatalLogger.Fatalf("Failed to serve debug listener: %v", err)
+			}
+		}()
+	}
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
 
 	<-quit
 
+	if app.httpServer.Addr != "" {
+		logger.InfoLogger.Printf("stopping HTTP gateway %s", app.httpServer.Addr)
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if err := app.httpServer.Shutdown(shutdownCtx); err != nil {
+			logger.ErrorLogger.Printf("Failed to stop HTTP gateway: %v", err)
+		}
+	}
+
+	if app.debugServer.Addr != "" {
+		if err := app.debugServer.Close(); err != nil {
+			logger.ErrorLogger.Printf("Failed to stop debug listener: %v", err)
+		}
+	}
+
 	logger.InfoLogger.Printf("stopping gRPC server %s", port)
 	app.gRPCServer.GracefulStop()
+	app.stopBackground()
 
 	return nil
 }
@@ -142,9 +471,26 @@ func initDB() *sqlx.DB {
 	return db
 }
 
+func newCloudUseCase(cloudConfig *models.CloudConfig) (*localstack_usecase.LocalstackUseCase, error) {
+	awsCfg, err := config.LoadDefaultConfig(context.TODO(),
+		config.WithRegion(cloudConfig.Region),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(cloudConfig.Key, cloudConfig.Secret, "")),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		o.UsePathStyle = true
+		o.BaseEndpoint = aws.String(cloudConfig.EndPoint)
+	})
+
+	return localstack_usecase.NewLocalstackUseCase(client, cloudConfig), nil
+}
+
 func insertInitServices(serviceUseCase usecase.ServiceUseCase, cloudUseCase usecase.CloudUseCase) error {
 
-	services, err := serviceUseCase.GetServices(context.TODO())
+	services, err := serviceUseCase.GetServices(context.TODO(), nil)
 	if err != nil {
 		return err
 	}
@@ -158,15 +504,16 @@ func insertInitServices(serviceUseCase usecase.ServiceUseCase, cloudUseCase usec
 	if err != nil {
 		return err
 	}
-	randomGymID := uuid.New().String()
-	gymUrl, err := cloudUseCase.PutObject(context.TODO(), gymPhotoBytes, "service/"+randomGymID)
+	gymServiceId := uuid.New()
+	gymPhotoKey := models.ServicePhotoKey(gymServiceId)
+	err = cloudUseCase.PutObject(context.TODO(), gymPhotoBytes, gymPhotoKey)
 	if err != nil {
 		return err
 	}
 	gymServiceReq := &dtos.CreateServiceCommand{
-		Id:    uuid.New(),
+		Id:    gymServiceId,
 		Title: "gym",
-		Photo: gymUrl,
+		Photo: gymPhotoKey,
 	}
 	_, err = serviceUseCase.CreateService(context.TODO(), gymServiceReq)
 	if err != nil {
@@ -178,15 +525,16 @@ func insertInitServices(serviceUseCase usecase.ServiceUseCase, cloudUseCase usec
 	if err != nil {
 		return err
 	}
-	randomSaunaID := uuid.New().String()
-	saunaUrl, err := cloudUseCase.PutObject(context.TODO(), saunaPhotoBytes, "service/"+randomSaunaID)
+	saunaServiceId := uuid.New()
+	saunaPhotoKey := models.ServicePhotoKey(saunaServiceId)
+	err = cloudUseCase.PutObject(context.TODO(), saunaPhotoBytes, saunaPhotoKey)
 	if err != nil {
 		return err
 	}
 	saunaServiceReq := &dtos.CreateServiceCommand{
-		Id:    uuid.New(),
+		Id:    saunaServiceId,
 		Title: "sauna",
-		Photo: saunaUrl,
+		Photo: saunaPhotoKey,
 	}
 	_, err = serviceUseCase.CreateService(context.TODO(), saunaServiceReq)
 	if err != nil {
@@ -198,15 +546,16 @@ func insertInitServices(serviceUseCase usecase.ServiceUseCase, cloudUseCase usec
 	if err != nil {
 		return err
 	}
-	randomSwimmingPoolID := uuid.New().String()
-	swimmingPoolUrl, err := cloudUseCase.PutObject(context.TODO(), swimmingPoolPhotoBytes, "service/"+randomSwimmingPoolID)
+	swimmingPoolServiceId := uuid.New()
+	swimmingPoolPhotoKey := models.ServicePhotoKey(swimmingPoolServiceId)
+	err = cloudUseCase.PutObject(context.TODO(), swimmingPoolPhotoBytes, swimmingPoolPhotoKey)
 	if err != nil {
 		return err
 	}
 	swimmingPoolServiceReq := &dtos.CreateServiceCommand{
-		Id:    uuid.New(),
+		Id:    swimmingPoolServiceId,
 		Title: "swimming-pool",
-		Photo: swimmingPoolUrl,
+		Photo: swimmingPoolPhotoKey,
 	}
 	_, err = serviceUseCase.CreateService(context.TODO(), swimmingPoolServiceReq)
 	if err != nil {
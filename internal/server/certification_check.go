@@ -0,0 +1,19 @@
+package server
+
+import (
+	"Service/internal/models"
+	"Service/internal/repository/postgres"
+	"Service/internal/usecase/certification_usecase"
+	"context"
+)
+
+// RunCertificationCheck flags expired coach certifications once outside of
+// the server, for use from the command line.
+func RunCertificationCheck() (*models.CertificationCheckReport, error) {
+	db := initDB()
+	defer db.Close()
+
+	certificationUseCase := certification_usecase.NewCertificationUseCase(postgres.NewServiceRepository(db))
+
+	return certificationUseCase.FlagExpiredCertifications(context.Background())
+}
@@ -0,0 +1,24 @@
+package server
+
+import (
+	"Service/internal/models"
+	"Service/internal/repository/postgres"
+	"Service/internal/usecase/photo_gc_usecase"
+	"context"
+)
+
+// RunPhotoGC performs a single collection of orphaned service photos outside
+// of the server, for use from the command line.
+func RunPhotoGC(appConfig *models.AppConfig, opts *models.PhotoGCOptions) (*models.PhotoGCReport, error) {
+	db := initDB()
+	defer db.Close()
+
+	cloudUseCase, err := newCloudUseCase(appConfig.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	photoGCUseCase := photo_gc_usecase.NewPhotoGCUseCase(postgres.NewServiceRepository(db), cloudUseCase)
+
+	return photoGCUseCase.CollectOrphanedPhotos(context.Background(), opts)
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"Service/internal/models"
+	"Service/internal/repository/postgres"
+	"Service/internal/usecase/reconcile_usecase"
+	"context"
+	"google.golang.org/grpc/health"
+)
+
+// RunReconciliation performs a single reconciliation of coach and abonement
+// links outside of the server, for use from the command line.
+func RunReconciliation(appConfig *models.AppConfig, opts *models.ReconcileOptions) (*models.ReconcileReport, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := initDB()
+	defer db.Close()
+
+	peers, err := dialPeers(ctx, appConfig, health.NewServer())
+	if err != nil {
+		return nil, err
+	}
+	defer peers.close()
+
+	reconcileUseCase := reconcile_usecase.NewReconcileUseCase(
+		postgres.NewServiceRepository(db),
+		&peers.coachClient,
+		&peers.abonementClient,
+		nil,
+	)
+
+	return reconcileUseCase.ReconcileLinks(ctx, opts)
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type AbonementAccessUseCase interface {
+	GetAbonementServiceTerms(ctx context.Context, abonementId uuid.UUID, serviceId uuid.UUID) (*models.AbonementServiceTerms, error)
+	SetAbonementServiceTerms(ctx context.Context, cmd *dtos.SetAbonementServiceTermsCommand) (*models.AbonementServiceTerms, error)
+	GetAbonementsServicesTerms(ctx context.Context, abonementIds []uuid.UUID) (map[uuid.UUID]map[uuid.UUID]*models.AbonementServiceTerms, error)
+
+	CheckAccess(ctx context.Context, abonementId uuid.UUID, serviceId uuid.UUID, at time.Time) (*models.AccessDecision, error)
+
+	RecordVisit(ctx context.Context, cmd *dtos.RecordVisitCommand) (*models.VisitReceipt, error)
+	GetVisits(ctx context.Context, abonementId uuid.UUID, serviceId *uuid.UUID, from time.Time, to time.Time) ([]*models.Visit, error)
+}
@@ -0,0 +1,198 @@
+package abonement_access_usecase
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/internal/repository"
+	"Service/internal/usecase"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+type AbonementAccessUseCase struct {
+	termsRepo           repository.AbonementTermsRepository
+	visitRepo           repository.VisitRepository
+	serviceHoursUseCase usecase.ServiceHoursUseCase
+}
+
+func NewAbonementAccessUseCase(
+	termsRepo repository.AbonementTermsRepository,
+	visitRepo repository.VisitRepository,
+	serviceHoursUseCase usecase.ServiceHoursUseCase,
+) *AbonementAccessUseCase {
+	return &AbonementAccessUseCase{
+		termsRepo:           termsRepo,
+		visitRepo:           visitRepo,
+		serviceHoursUseCase: serviceHoursUseCase,
+	}
+}
+
+func (u *AbonementAccessUseCase) GetAbonementServiceTerms(
+	ctx context.Context,
+	abonementId uuid.UUID,
+	serviceId uuid.UUID,
+) (*models.AbonementServiceTerms, error) {
+
+	terms, err := u.termsRepo.GetAbonementServiceTerms(ctx, abonementId, serviceId)
+	if err != nil {
+		return nil, withIds(err, abonementId, serviceId)
+	}
+
+	return terms, nil
+}
+
+func (u *AbonementAccessUseCase) SetAbonementServiceTerms(
+	ctx context.Context,
+	cmd *dtos.SetAbonementServiceTermsCommand,
+) (*models.AbonementServiceTerms, error) {
+
+	terms := &models.AbonementServiceTerms{
+		AbonementId: cmd.AbonementId,
+		ServiceId:   cmd.ServiceId,
+		VisitQuota:  cmd.VisitQuota,
+		QuotaPeriod: cmd.QuotaPeriod,
+		GuestPasses: cmd.GuestPasses,
+		Windows:     cmd.Windows,
+		UpdatedTime: time.Now(),
+	}
+	if terms.Windows == nil {
+		terms.Windows = []models.OpeningInterval{}
+	}
+
+	err := u.termsRepo.SetAbonementServiceTerms(ctx, terms)
+	if err != nil {
+		return nil, withIds(err, cmd.AbonementId, cmd.ServiceId)
+	}
+
+	return terms, nil
+}
+
+func (u *AbonementAccessUseCase) GetAbonementsServicesTerms(
+	ctx context.Context,
+	abonementIds []uuid.UUID,
+) (map[uuid.UUID]map[uuid.UUID]*models.AbonementServiceTerms, error) {
+
+	return u.termsRepo.GetAbonementsServicesTerms(ctx, abonementIds)
+}
+
+// CheckAccess tells whether the abonement includes the service, directly or
+// through a bundle, whether at falls into the allowed windows of the granting
+// link, read in the time zone of the service, and whether visits are left in
+// the quota period of at.
+func (u *AbonementAccessUseCase) CheckAccess(
+	ctx context.Context,
+	abonementId uuid.UUID,
+	serviceId uuid.UUID,
+	at time.Time,
+) (*models.AccessDecision, error) {
+
+	decision := &models.AccessDecision{AbonementId: abonementId, ServiceId: serviceId, At: at}
+
+	grant, err := u.termsRepo.GetAbonementServiceGrant(ctx, abonementId, serviceId)
+	if err != nil {
+		if errors.Is(err, customErrors.AbonementServiceLinkNotFound) {
+			decision.Reason = models.AccessReasonNotIncluded
+			return decision, nil
+		}
+		return nil, err
+	}
+	terms := grant.Terms
+	decision.Terms = terms
+
+	location, err := u.serviceLocation(ctx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := u.visitRepo.CountVisits(ctx, abonementId, grant.ServiceIds, models.PeriodBounds(terms.QuotaPeriod, at.In(location)))
+	if err != nil {
+		return nil, err
+	}
+	decision.RemainingVisits, decision.RemainingGuestPasses = usage.Remaining(terms)
+
+	switch {
+	case !terms.AllowsAt(at.In(location)):
+		decision.Reason = models.AccessReasonOutsideHours
+	case decision.RemainingVisits != nil && *decision.RemainingVisits == 0:
+		decision.Reason = models.AccessReasonQuotaUsedUp
+	default:
+		decision.Granted = true
+	}
+
+	return decision, nil
+}
+
+// RecordVisit counts a turnstile pass against the quota of its period. Guest
+// visits use up guest passes instead of the visit quota. The windows and the
+// quota are checked by the repository against the terms it locks, after a
+// retried event has been answered with its original receipt.
+func (u *AbonementAccessUseCase) RecordVisit(ctx context.Context, cmd *dtos.RecordVisitCommand) (*models.VisitReceipt, error) {
+	location, err := u.serviceLocation(ctx, cmd.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	visit := &models.Visit{
+		Id:           uuid.New(),
+		EventId:      cmd.EventId,
+		AbonementId:  cmd.AbonementId,
+		ServiceId:    cmd.ServiceId,
+		MemberId:     cmd.MemberId,
+		Guest:        cmd.Guest,
+		VisitedTime:  cmd.At,
+		RecordedTime: time.Now(),
+	}
+
+	receipt, err := u.visitRepo.RecordVisit(ctx, visit, location)
+	if err != nil {
+		return nil, withIds(err, cmd.AbonementId, cmd.ServiceId)
+	}
+
+	return receipt, nil
+}
+
+func (u *AbonementAccessUseCase) GetVisits(
+	ctx context.Context,
+	abonementId uuid.UUID,
+	serviceId *uuid.UUID,
+	from time.Time,
+	to time.Time,
+) ([]*models.Visit, error) {
+
+	return u.visitRepo.GetVisits(ctx, abonementId, serviceId, from, to)
+}
+
+// serviceLocation is the time zone of the opening hours of the service, UTC
+// for services without opening hours.
+func (u *AbonementAccessUseCase) serviceLocation(ctx context.Context, serviceId uuid.UUID) (*time.Location, error) {
+	hours, err := u.serviceHoursUseCase.GetServiceHours(ctx, serviceId)
+	if err != nil {
+		if errors.Is(err, customErrors.ServiceHoursNotFound) {
+			return time.UTC, nil
+		}
+		return nil, err
+	}
+
+	location, err := time.LoadLocation(hours.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load time zone %s: %w", hours.TimeZone, err)
+	}
+
+	return location, nil
+}
+
+func withIds(err error, abonementId uuid.UUID, serviceId uuid.UUID) error {
+	switch {
+	case errors.Is(err, customErrors.AbonementServiceLinkNotFound),
+		errors.Is(err, customErrors.AccessOutsideHours),
+		errors.Is(err, customErrors.VisitQuotaUsedUp),
+		errors.Is(err, customErrors.GuestPassesUsedUp):
+		return customErrors.NewResourceError(err, abonementId.String()+"/"+serviceId.String())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type BookingUseCase interface {
+	GetBookingSettings(ctx context.Context, serviceId uuid.UUID) (*models.BookingSettings, error)
+	SetBookingSettings(ctx context.Context, cmd *dtos.SetBookingSettingsCommand) (*models.BookingSettings, error)
+
+	GetAvailability(ctx context.Context, serviceId uuid.UUID, from time.Time, to time.Time) ([]*models.Slot, error)
+	BookSlot(ctx context.Context, cmd *dtos.BookSlotCommand) (*models.Booking, error)
+	CancelBooking(ctx context.Context, bookingId uuid.UUID) (*models.Booking, error)
+	GetBooking(ctx context.Context, bookingId uuid.UUID) (*models.Booking, error)
+}
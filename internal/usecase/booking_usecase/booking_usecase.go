@@ -0,0 +1,186 @@
+package booking_usecase
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/internal/repository"
+	"Service/internal/usecase"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+type BookingUseCase struct {
+	bookingRepo         repository.BookingRepository
+	serviceHoursUseCase usecase.ServiceHoursUseCase
+}
+
+func NewBookingUseCase(bookingRepo repository.BookingRepository, serviceHoursUseCase usecase.ServiceHoursUseCase) *BookingUseCase {
+	return &BookingUseCase{
+		bookingRepo:         bookingRepo,
+		serviceHoursUseCase: serviceHoursUseCase,
+	}
+}
+
+func (u *BookingUseCase) GetBookingSettings(ctx context.Context, serviceId uuid.UUID) (*models.BookingSettings, error) {
+	settings, err := u.bookingRepo.GetBookingSettings(ctx, serviceId)
+	if err != nil {
+		return nil, withIds(err, serviceId, uuid.Nil)
+	}
+
+	return settings, nil
+}
+
+func (u *BookingUseCase) SetBookingSettings(ctx context.Context, cmd *dtos.SetBookingSettingsCommand) (*models.BookingSettings, error) {
+	settings := &models.BookingSettings{
+		ServiceId:   cmd.ServiceId,
+		SlotMinutes: cmd.SlotMinutes,
+		Capacity:    cmd.Capacity,
+		UpdatedTime: time.Now(),
+	}
+
+	err := u.bookingRepo.SetBookingSettings(ctx, settings)
+	if err != nil {
+		return nil, withIds(err, cmd.ServiceId, uuid.Nil)
+	}
+
+	return settings, nil
+}
+
+// GetAvailability returns every slot starting within [from, to) with the
+// places already taken.
+func (u *BookingUseCase) GetAvailability(ctx context.Context, serviceId uuid.UUID, from time.Time, to time.Time) ([]*models.Slot, error) {
+	settings, err := u.GetBookingSettings(ctx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	slots, err := u.generateSlots(ctx, settings, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	booked, err := u.bookingRepo.GetBookedSlots(ctx, serviceId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	bookedByStart := make(map[int64]*models.Slot, len(booked))
+	for _, slot := range booked {
+		bookedByStart[slot.Start.Unix()] = slot
+	}
+
+	for _, slot := range slots {
+		if bookedSlot, ok := bookedByStart[slot.Start.Unix()]; ok {
+			slot.Booked = bookedSlot.Booked
+		}
+	}
+
+	return slots, nil
+}
+
+// BookSlot checks that the slot is one the schedule generates before taking a
+// place in it; capacity itself is enforced by the repository.
+func (u *BookingUseCase) BookSlot(ctx context.Context, cmd *dtos.BookSlotCommand) (*models.Booking, error) {
+	if !cmd.SlotStart.After(time.Now()) {
+		return nil, customErrors.NewResourceError(customErrors.SlotInPast, cmd.SlotStart.UTC().Format(time.RFC3339))
+	}
+
+	settings, err := u.GetBookingSettings(ctx, cmd.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := u.findSlot(ctx, settings, cmd.SlotStart)
+	if err != nil {
+		return nil, err
+	}
+
+	booking := &models.Booking{
+		Id:          uuid.New(),
+		ServiceId:   cmd.ServiceId,
+		MemberId:    cmd.MemberId,
+		SlotStart:   slot.Start,
+		SlotEnd:     slot.End,
+		Status:      models.BookingStatusActive,
+		CreatedTime: time.Now(),
+	}
+
+	err = u.bookingRepo.CreateBooking(ctx, booking, settings.Capacity)
+	if err != nil {
+		if errors.Is(err, customErrors.SlotFull) || errors.Is(err, customErrors.BookingAlreadyExists) {
+			return nil, customErrors.NewResourceError(err, slot.Start.Format(time.RFC3339))
+		}
+		return nil, withIds(err, cmd.ServiceId, booking.Id)
+	}
+
+	return booking, nil
+}
+
+func (u *BookingUseCase) CancelBooking(ctx context.Context, bookingId uuid.UUID) (*models.Booking, error) {
+	booking, err := u.bookingRepo.CancelBooking(ctx, bookingId, time.Now())
+	if err != nil {
+		return nil, withIds(err, uuid.Nil, bookingId)
+	}
+
+	return booking, nil
+}
+
+func (u *BookingUseCase) GetBooking(ctx context.Context, bookingId uuid.UUID) (*models.Booking, error) {
+	booking, err := u.bookingRepo.GetBooking(ctx, bookingId)
+	if err != nil {
+		return nil, withIds(err, uuid.Nil, bookingId)
+	}
+
+	return booking, nil
+}
+
+func (u *BookingUseCase) findSlot(ctx context.Context, settings *models.BookingSettings, start time.Time) (*models.Slot, error) {
+	slots, err := u.generateSlots(ctx, settings, start, start.Add(time.Second))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, slot := range slots {
+		if slot.Start.Equal(start) {
+			return slot, nil
+		}
+	}
+
+	return nil, customErrors.NewResourceError(customErrors.SlotNotFound, start.UTC().Format(time.RFC3339))
+}
+
+// generateSlots returns the slots starting within [from, to). Windows are
+// clipped to the range they are fetched for, so they are always fetched from
+// the UTC midnight before the day of from: the grid of a window open around
+// the clock is then anchored at a midnight, which gives the same grid for any
+// range because slot lengths divide a day.
+func (u *BookingUseCase) generateSlots(ctx context.Context, settings *models.BookingSettings, from time.Time, to time.Time) ([]*models.Slot, error) {
+	anchor := from.Truncate(24 * time.Hour).Add(-24 * time.Hour)
+
+	windows, err := u.serviceHoursUseCase.GetOpeningWindows(ctx, settings.ServiceId, anchor, to.Add(settings.SlotDuration()))
+	if err != nil {
+		return nil, err
+	}
+
+	return cutSlots(windows, settings, from, to), nil
+}
+
+func withIds(err error, serviceId uuid.UUID, bookingId uuid.UUID) error {
+	var resourceErr *customErrors.ResourceError
+	if errors.As(err, &resourceErr) {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, customErrors.ServiceNotFound),
+		errors.Is(err, customErrors.ServiceNotBookable):
+		return customErrors.NewResourceError(err, serviceId.String())
+	case errors.Is(err, customErrors.BookingNotFound):
+		return customErrors.NewResourceError(err, bookingId.String())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,31 @@
+package booking_usecase
+
+import (
+	"Service/internal/models"
+	"time"
+)
+
+// cutSlots cuts every window into consecutive slots from its start and
+// keeps the slots starting within [from, to). The tail of a window shorter
+// than a slot is not bookable.
+func cutSlots(windows []*models.OpeningWindow, settings *models.BookingSettings, from time.Time, to time.Time) []*models.Slot {
+	var slots []*models.Slot
+
+	duration := settings.SlotDuration()
+
+	for _, window := range windows {
+		for start := window.Start; !start.Add(duration).After(window.End); start = start.Add(duration) {
+			if start.Before(from) || !start.Before(to) {
+				continue
+			}
+
+			slots = append(slots, &models.Slot{
+				Start:    start,
+				End:      start.Add(duration),
+				Capacity: settings.Capacity,
+			})
+		}
+	}
+
+	return slots
+}
@@ -0,0 +1,131 @@
+package booking_usecase
+
+import (
+	"Service/internal/models"
+	"testing"
+	"time"
+)
+
+func TestCutSlots(t *testing.T) {
+	utc := func(value string) time.Time {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+	window := func(start, end string) *models.OpeningWindow {
+		return &models.OpeningWindow{Start: utc(start), End: utc(end)}
+	}
+
+	tests := []struct {
+		name        string
+		windows     []*models.OpeningWindow
+		slotMinutes int
+		from        time.Time
+		to          time.Time
+		want        []string
+	}{
+		{
+			name:        "window divided evenly",
+			windows:     []*models.OpeningWindow{window("2026-01-05T08:00:00Z", "2026-01-05T10:00:00Z")},
+			slotMinutes: 60,
+			from:        utc("2026-01-05T00:00:00Z"),
+			to:          utc("2026-01-06T00:00:00Z"),
+			want:        []string{"2026-01-05T08:00:00Z", "2026-01-05T09:00:00Z"},
+		},
+		{
+			name:        "short tail is not bookable",
+			windows:     []*models.OpeningWindow{window("2026-01-05T08:00:00Z", "2026-01-05T09:30:00Z")},
+			slotMinutes: 45,
+			from:        utc("2026-01-05T00:00:00Z"),
+			to:          utc("2026-01-06T00:00:00Z"),
+			want:        []string{"2026-01-05T08:00:00Z", "2026-01-05T08:45:00Z"},
+		},
+		{
+			name:        "window shorter than a slot",
+			windows:     []*models.OpeningWindow{window("2026-01-05T08:00:00Z", "2026-01-05T08:30:00Z")},
+			slotMinutes: 60,
+			from:        utc("2026-01-05T00:00:00Z"),
+			to:          utc("2026-01-06T00:00:00Z"),
+		},
+		{
+			name: "touching windows are cut separately",
+			windows: []*models.OpeningWindow{
+				window("2026-01-05T08:00:00Z", "2026-01-05T09:00:00Z"),
+				window("2026-01-05T09:00:00Z", "2026-01-05T10:00:00Z"),
+			},
+			slotMinutes: 45,
+			from:        utc("2026-01-05T00:00:00Z"),
+			to:          utc("2026-01-06T00:00:00Z"),
+			want:        []string{"2026-01-05T08:00:00Z", "2026-01-05T09:00:00Z"},
+		},
+		{
+			name:        "slots keep the grid of the window start",
+			windows:     []*models.OpeningWindow{window("2026-01-05T08:00:00Z", "2026-01-05T11:00:00Z")},
+			slotMinutes: 60,
+			from:        utc("2026-01-05T08:30:00Z"),
+			to:          utc("2026-01-05T10:00:00Z"),
+			want:        []string{"2026-01-05T09:00:00Z"},
+		},
+		{
+			name:        "slot starting at the end of the range is left out",
+			windows:     []*models.OpeningWindow{window("2026-01-05T08:00:00Z", "2026-01-05T11:00:00Z")},
+			slotMinutes: 60,
+			from:        utc("2026-01-05T08:00:00Z"),
+			to:          utc("2026-01-05T09:00:00Z"),
+			want:        []string{"2026-01-05T08:00:00Z"},
+		},
+		{
+			name:        "window spanning midnight",
+			windows:     []*models.OpeningWindow{window("2026-01-05T22:00:00Z", "2026-01-06T01:00:00Z")},
+			slotMinutes: 90,
+			from:        utc("2026-01-05T00:00:00Z"),
+			to:          utc("2026-01-07T00:00:00Z"),
+			want:        []string{"2026-01-05T22:00:00Z", "2026-01-05T23:30:00Z"},
+		},
+		{
+			// 01:00 to 04:00 in Europe/Berlin on the night clocks go forward.
+			name:        "spring forward night",
+			windows:     []*models.OpeningWindow{window("2026-03-29T00:00:00Z", "2026-03-29T02:00:00Z")},
+			slotMinutes: 60,
+			from:        utc("2026-03-28T23:00:00Z"),
+			to:          utc("2026-03-29T22:00:00Z"),
+			want:        []string{"2026-03-29T00:00:00Z", "2026-03-29T01:00:00Z"},
+		},
+		{
+			// 01:00 to 04:00 in Europe/Berlin on the night clocks go back.
+			name:        "fall back night",
+			windows:     []*models.OpeningWindow{window("2026-10-24T23:00:00Z", "2026-10-25T03:00:00Z")},
+			slotMinutes: 60,
+			from:        utc("2026-10-24T22:00:00Z"),
+			to:          utc("2026-10-25T23:00:00Z"),
+			want: []string{
+				"2026-10-24T23:00:00Z",
+				"2026-10-25T00:00:00Z",
+				"2026-10-25T01:00:00Z",
+				"2026-10-25T02:00:00Z",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &models.BookingSettings{SlotMinutes: tt.slotMinutes, Capacity: 3}
+			got := cutSlots(tt.windows, settings, tt.from, tt.to)
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d slots, want %d", len(got), len(tt.want))
+			}
+			for i, slot := range got {
+				start := utc(tt.want[i])
+				if !slot.Start.Equal(start) || !slot.End.Equal(start.Add(settings.SlotDuration())) {
+					t.Errorf("slot %d = [%s, %s), want to start at %s", i, slot.Start, slot.End, start)
+				}
+				if slot.Capacity != settings.Capacity {
+					t.Errorf("slot %d capacity = %d, want %d", i, slot.Capacity, settings.Capacity)
+				}
+			}
+		})
+	}
+}
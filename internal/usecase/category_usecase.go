@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"context"
+	"github.com/google/uuid"
+)
+
+type CategoryUseCase interface {
+	CreateCategory(ctx context.Context, cmd *dtos.CreateCategoryCommand) (*models.ServiceCategory, error)
+	GetCategoryById(ctx context.Context, id uuid.UUID) (*models.ServiceCategory, error)
+	GetCategories(ctx context.Context) ([]*models.ServiceCategory, error)
+	UpdateCategory(ctx context.Context, cmd *dtos.UpdateCategoryCommand) (*models.ServiceCategory, error)
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
+
+	SetServiceCategory(ctx context.Context, serviceId uuid.UUID, categoryId *uuid.UUID) (*models.ServiceClassification, error)
+	SetServiceTags(ctx context.Context, serviceId uuid.UUID, tags []string) (*models.ServiceClassification, error)
+}
@@ -0,0 +1,137 @@
+package category_usecase
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/internal/repository"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+type CategoryUseCase struct {
+	categoryRepo repository.CategoryRepository
+}
+
+func NewCategoryUseCase(categoryRepo repository.CategoryRepository) *CategoryUseCase {
+	return &CategoryUseCase{categoryRepo: categoryRepo}
+}
+
+func (u *CategoryUseCase) CreateCategory(ctx context.Context, cmd *dtos.CreateCategoryCommand) (*models.ServiceCategory, error) {
+	category := &models.ServiceCategory{
+		Id:          uuid.New(),
+		ParentId:    cmd.ParentId,
+		Name:        cmd.Name,
+		CreatedTime: time.Now(),
+		UpdatedTime: time.Now(),
+	}
+
+	err := u.categoryRepo.CreateCategory(ctx, category)
+	if err != nil {
+		return nil, withCategoryId(err, cmd.ParentId, category.Id, cmd.Name)
+	}
+
+	return category, nil
+}
+
+func (u *CategoryUseCase) GetCategoryById(ctx context.Context, id uuid.UUID) (*models.ServiceCategory, error) {
+	category, err := u.categoryRepo.GetCategoryById(ctx, id)
+	if err != nil {
+		return nil, withCategoryId(err, nil, id, "")
+	}
+
+	return category, nil
+}
+
+func (u *CategoryUseCase) GetCategories(ctx context.Context) ([]*models.ServiceCategory, error) {
+	return u.categoryRepo.GetCategories(ctx)
+}
+
+func (u *CategoryUseCase) UpdateCategory(ctx context.Context, cmd *dtos.UpdateCategoryCommand) (*models.ServiceCategory, error) {
+	if cmd.ParentId != nil && *cmd.ParentId == cmd.Id {
+		return nil, customErrors.NewResourceError(customErrors.InvalidCategoryParent, cmd.Id.String())
+	}
+
+	// Checked first so that a missing category is not reported as a missing
+	// parent.
+	_, err := u.GetCategoryById(ctx, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.categoryRepo.UpdateCategory(ctx, cmd, time.Now())
+	if err != nil {
+		return nil, withCategoryId(err, cmd.ParentId, cmd.Id, cmd.Name)
+	}
+
+	return u.GetCategoryById(ctx, cmd.Id)
+}
+
+func (u *CategoryUseCase) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	err := u.categoryRepo.DeleteCategory(ctx, id)
+	if err != nil {
+		return withCategoryId(err, nil, id, "")
+	}
+
+	return nil
+}
+
+// SetServiceCategory assigns the service to a category, a nil category
+// removes the assignment.
+func (u *CategoryUseCase) SetServiceCategory(ctx context.Context, serviceId uuid.UUID, categoryId *uuid.UUID) (*models.ServiceClassification, error) {
+	err := u.categoryRepo.SetServiceCategory(ctx, serviceId, categoryId, time.Now())
+	if err != nil {
+		if errors.Is(err, customErrors.ServiceNotFound) {
+			return nil, customErrors.NewResourceError(err, serviceId.String())
+		}
+		return nil, withCategoryId(err, categoryId, uuid.Nil, "")
+	}
+
+	return u.getClassification(ctx, serviceId)
+}
+
+func (u *CategoryUseCase) SetServiceTags(ctx context.Context, serviceId uuid.UUID, tags []string) (*models.ServiceClassification, error) {
+	err := u.categoryRepo.SetServiceTags(ctx, serviceId, tags, time.Now())
+	if err != nil {
+		if errors.Is(err, customErrors.ServiceNotFound) {
+			return nil, customErrors.NewResourceError(err, serviceId.String())
+		}
+		return nil, err
+	}
+
+	return u.getClassification(ctx, serviceId)
+}
+
+func (u *CategoryUseCase) getClassification(ctx context.Context, serviceId uuid.UUID) (*models.ServiceClassification, error) {
+	classifications, err := u.categoryRepo.GetServicesClassifications(ctx, []uuid.UUID{serviceId})
+	if err != nil {
+		return nil, err
+	}
+
+	classification, ok := classifications[serviceId]
+	if !ok {
+		return nil, customErrors.NewResourceError(customErrors.ServiceNotFound, serviceId.String())
+	}
+
+	return classification, nil
+}
+
+// withCategoryId names the category an error is about. A missing category is
+// the parent (or the assigned category) when one was given, the category
+// itself otherwise.
+func withCategoryId(err error, referencedId *uuid.UUID, id uuid.UUID, name string) error {
+	switch {
+	case errors.Is(err, customErrors.CategoryNotFound) && referencedId != nil:
+		return customErrors.NewResourceError(err, referencedId.String())
+	case errors.Is(err, customErrors.CategoryAlreadyExists):
+		return customErrors.NewResourceError(err, name)
+	case errors.Is(err, customErrors.CategoryNotFound),
+		errors.Is(err, customErrors.CategoryInUse),
+		errors.Is(err, customErrors.InvalidCategoryParent):
+		return customErrors.NewResourceError(err, id.String())
+	default:
+		return err
+	}
+}
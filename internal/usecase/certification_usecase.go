@@ -0,0 +1,10 @@
+package usecase
+
+import (
+	"Service/internal/models"
+	"context"
+)
+
+type CertificationUseCase interface {
+	FlagExpiredCertifications(ctx context.Context) (*models.CertificationCheckReport, error)
+}
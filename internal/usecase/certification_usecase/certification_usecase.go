@@ -0,0 +1,64 @@
+package certification_usecase
+
+import (
+	"Service/internal/models"
+	"Service/internal/repository"
+	"Service/pkg/logger"
+	"context"
+	"expvar"
+	"time"
+)
+
+var stats = expvar.NewMap("certification_check")
+
+type CertificationUseCase struct {
+	certificationRepo repository.CertificationRepository
+}
+
+func NewCertificationUseCase(certificationRepo repository.CertificationRepository) *CertificationUseCase {
+	return &CertificationUseCase{certificationRepo: certificationRepo}
+}
+
+// FlagExpiredCertifications flags the coach-service links whose
+// certification expired before today (UTC). Each link is reported by the run
+// that flags it only.
+func (u *CertificationUseCase) FlagExpiredCertifications(ctx context.Context) (*models.CertificationCheckReport, error) {
+	report := &models.CertificationCheckReport{StartedTime: time.Now()}
+
+	links, err := u.certificationRepo.FlagExpiredCertifications(ctx, report.StartedTime.UTC(), report.StartedTime)
+	if err != nil {
+		return nil, err
+	}
+
+	report.FlaggedLinks = links
+	report.Duration = time.Since(report.StartedTime)
+
+	stats.Add("runs", 1)
+	stats.Add("links_flagged", int64(len(links)))
+
+	for _, link := range links {
+		logger.InfoLogger.Printf("Certification of coach %s for service %s expired on %s",
+			link.CoachId, link.ServiceId, link.CertificationExpiry.Format(models.DateLayout))
+	}
+
+	logger.InfoLogger.Printf("Certification check finished in %s: flagged=%d", report.Duration, len(links))
+
+	return report, nil
+}
+
+// Schedule runs FlagExpiredCertifications every interval until ctx is done.
+func (u *CertificationUseCase) Schedule(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := u.FlagExpiredCertifications(ctx); err != nil {
+				logger.ErrorLogger.Printf("Scheduled certification check failed: %v", err)
+			}
+		}
+	}
+}
@@ -1,9 +1,17 @@
 package usecase
 
-import "context"
+import (
+	"Service/internal/models"
+	"context"
+	"time"
+)
 
 type CloudUseCase interface {
-	PutObject(ctx context.Context, object []byte, name string) (string, error)
+	PutObject(ctx context.Context, object []byte, name string) error
 	DeleteObject(ctx context.Context, name string) error
-	GetObjectByName(ctx context.Context, name string) ([]byte, error)
+	OpenObject(ctx context.Context, name string, opts models.ObjectReadOptions) (*models.ObjectStream, error)
+	PhotoURL(ctx context.Context, key string) (string, error)
+	ListObjects(ctx context.Context, prefix string) ([]models.StoredObject, error)
+	PresignPutObject(ctx context.Context, name, contentType string, size int64, ttl time.Duration) (string, error)
+	StatObject(ctx context.Context, name string) (*models.StoredObject, bool, error)
 }
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"context"
+	"github.com/google/uuid"
+)
+
+type CoachServiceLinkUseCase interface {
+	CreateCoachServiceLink(ctx context.Context, cmd *dtos.CreateCoachServiceLinkCommand) (*models.CoachServiceLink, error)
+	UpdateCoachServiceLink(ctx context.Context, cmd *dtos.UpdateCoachServiceLinkCommand) (*models.CoachServiceLink, error)
+	GetCoachServiceLink(ctx context.Context, coachId uuid.UUID, serviceId uuid.UUID) (*models.CoachServiceLink, error)
+	GetCoachServiceLinks(ctx context.Context, coachId uuid.UUID) ([]*models.CoachServiceLink, error)
+}
@@ -1,41 +1,52 @@
 package localstack_usecase
 
 import (
+	customErrors "Service/internal/errors"
 	"Service/internal/models"
 	"Service/pkg/logger"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type LocalstackUseCase struct {
-	client *s3.Client
-	config *models.CloudConfig
+	client    *s3.Client
+	config    *models.CloudConfig
+	photoURLs photoURLRenderer
 }
 
 func NewLocalstackUseCase(client *s3.Client, config *models.CloudConfig) *LocalstackUseCase {
 	return &LocalstackUseCase{
-		client: client,
-		config: config,
+		client:    client,
+		config:    config,
+		photoURLs: newPhotoURLRenderer(client, config),
 	}
 }
 
-func (luc *LocalstackUseCase) PutObject(ctx context.Context, object []byte, name string) (string, error) {
+// PutObject stores the object with the content type sniffed from its bytes,
+// so downloads report the real type instead of the S3 default.
+func (luc *LocalstackUseCase) PutObject(ctx context.Context, object []byte, name string) error {
 	_, err := luc.client.PutObject(ctx, &s3.PutObjectInput{
-		Bucket: aws.String(luc.config.Bucket),
-		Key:    aws.String(name),
-		Body:   bytes.NewReader(object),
+		Bucket:      aws.String(luc.config.Bucket),
+		Key:         aws.String(name),
+		Body:        bytes.NewReader(object),
+		ContentType: aws.String(http.DetectContentType(object)),
 	})
 	if err != nil {
 		logger.ErrorLogger.Printf("Failed to put object: %v", err)
-		return "", err
+		return err
 	}
 
-	fileURL := fmt.Sprintf("%s/%s/%s", luc.config.EndPoint, luc.config.Bucket, name)
-
-	return fileURL, nil
+	return nil
 }
 
 func (luc *LocalstackUseCase) DeleteObject(ctx context.Context, name string) error {
@@ -51,22 +62,133 @@ func (luc *LocalstackUseCase) DeleteObject(ctx context.Context, name string) err
 	return nil
 }
 
-func (luc *LocalstackUseCase) GetObjectByName(ctx context.Context, name string) ([]byte, error) {
-	object, err := luc.client.GetObject(ctx, &s3.GetObjectInput{
+// OpenObject starts reading the object from opts.Offset. The caller closes
+// the body of the returned stream.
+func (luc *LocalstackUseCase) OpenObject(ctx context.Context, name string, opts models.ObjectReadOptions) (*models.ObjectStream, error) {
+	input := &s3.GetObjectInput{
 		Bucket: aws.String(luc.config.Bucket),
 		Key:    aws.String(name),
-	})
+	}
+	if opts.Offset > 0 {
+		input.Range = aws.String(fmt.Sprintf("bytes=%d-", opts.Offset))
+	}
+	if opts.IfMatch != "" {
+		input.IfMatch = aws.String(opts.IfMatch)
+	}
+	if opts.IfNoneMatch != "" {
+		input.IfNoneMatch = aws.String(opts.IfNoneMatch)
+	}
+
+	object, err := luc.client.GetObject(ctx, input)
 	if err != nil {
+		var responseErr *awshttp.ResponseError
+		if errors.As(err, &responseErr) {
+			switch responseErr.HTTPStatusCode() {
+			case http.StatusNotModified:
+				return &models.ObjectStream{ETag: opts.IfNoneMatch, NotModified: true}, nil
+			case http.StatusNotFound:
+				return nil, customErrors.ServicePhotoNotFound
+			case http.StatusPreconditionFailed:
+				return nil, customErrors.PhotoChanged
+			case http.StatusRequestedRangeNotSatisfiable:
+				return nil, customErrors.InvalidPhotoRange
+			}
+		}
+
 		logger.ErrorLogger.Printf("Failed to get object: %v", err)
 		return nil, err
 	}
 
-	var photo []byte
-	_, err = object.Body.Read(photo)
+	size := aws.ToInt64(object.ContentLength)
+	if contentRange := aws.ToString(object.ContentRange); contentRange != "" {
+		if total, err := strconv.ParseInt(contentRange[strings.LastIndex(contentRange, "/")+1:], 10, 64); err == nil {
+			size = total
+		}
+	}
+
+	return &models.ObjectStream{
+		Body:        object.Body,
+		Size:        size,
+		Offset:      opts.Offset,
+		ContentType: aws.ToString(object.ContentType),
+		ETag:        aws.ToString(object.ETag),
+	}, nil
+}
+
+// PhotoURL renders the url clients fetch the object with. An empty key
+// renders as an empty url.
+func (luc *LocalstackUseCase) PhotoURL(ctx context.Context, key string) (string, error) {
+	if key == "" {
+		return "", nil
+	}
+
+	return luc.photoURLs.render(ctx, key)
+}
+
+func (luc *LocalstackUseCase) ListObjects(ctx context.Context, prefix string) ([]models.StoredObject, error) {
+	var objects []models.StoredObject
+
+	paginator := s3.NewListObjectsV2Paginator(luc.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(luc.config.Bucket),
+		Prefix: aws.String(prefix),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			logger.ErrorLogger.Printf("Failed to list objects: %v", err)
+			return nil, err
+		}
+
+		for _, object := range page.Contents {
+			objects = append(objects, models.StoredObject{
+				Key:          aws.ToString(object.Key),
+				LastModified: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+// PresignPutObject returns a url the object can be put with directly. The
+// signature covers the content type and length, so the client cannot upload
+// anything else with it.
+func (luc *LocalstackUseCase) PresignPutObject(ctx context.Context, name, contentType string, size int64, ttl time.Duration) (string, error) {
+	request, err := s3.NewPresignClient(luc.client).PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(luc.config.Bucket),
+		Key:           aws.String(name),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(size),
+	}, s3.WithPresignExpires(ttl))
 	if err != nil {
-		logger.ErrorLogger.Printf("Failed to read object: %v", err)
-		return nil, err
+		logger.ErrorLogger.Printf("Failed to presign put object: %v", err)
+		return "", err
+	}
+
+	return request.URL, nil
+}
+
+// StatObject reports whether the object exists and, if so, its metadata.
+func (luc *LocalstackUseCase) StatObject(ctx context.Context, name string) (*models.StoredObject, bool, error) {
+	head, err := luc.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(luc.config.Bucket),
+		Key:    aws.String(name),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return nil, false, nil
+		}
+
+		logger.ErrorLogger.Printf("Failed to stat object: %v", err)
+		return nil, false, err
 	}
 
-	return photo, nil
+	return &models.StoredObject{
+		Key:          name,
+		LastModified: aws.ToTime(head.LastModified),
+		Size:         aws.ToInt64(head.ContentLength),
+		ContentType:  aws.ToString(head.ContentType),
+	}, true, nil
 }
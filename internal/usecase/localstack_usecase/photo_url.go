@@ -0,0 +1,63 @@
+package localstack_usecase
+
+import (
+	"Service/internal/models"
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultPresignTTL = 15 * time.Minute
+
+// photoURLRenderer turns an object key into a url clients can fetch.
+type photoURLRenderer interface {
+	render(ctx context.Context, key string) (string, error)
+}
+
+func newPhotoURLRenderer(client *s3.Client, config *models.CloudConfig) photoURLRenderer {
+	if config.PhotoURLMode == models.PhotoURLPresigned {
+		ttl := config.PresignTTL
+		if ttl <= 0 {
+			ttl = defaultPresignTTL
+		}
+
+		return &presignedURLRenderer{client: s3.NewPresignClient(client), bucket: config.Bucket, ttl: ttl}
+	}
+
+	baseURL := config.PublicBaseURL
+	if baseURL == "" {
+		baseURL = fmt.Sprintf("%s/%s", config.EndPoint, config.Bucket)
+	}
+
+	return &publicURLRenderer{baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+type publicURLRenderer struct {
+	baseURL string
+}
+
+func (r *publicURLRenderer) render(_ context.Context, key string) (string, error) {
+	return r.baseURL + "/" + (&url.URL{Path: key}).EscapedPath(), nil
+}
+
+type presignedURLRenderer struct {
+	client *s3.PresignClient
+	bucket string
+	ttl    time.Duration
+}
+
+func (r *presignedURLRenderer) render(ctx context.Context, key string) (string, error) {
+	request, err := r.client.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(r.ttl))
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL, nil
+}
@@ -0,0 +1,10 @@
+package usecase
+
+import (
+	"Service/internal/models"
+	"context"
+)
+
+type PhotoGCUseCase interface {
+	CollectOrphanedPhotos(ctx context.Context, opts *models.PhotoGCOptions) (*models.PhotoGCReport, error)
+}
@@ -0,0 +1,103 @@
+package photo_gc_usecase
+
+import (
+	"Service/internal/models"
+	"Service/internal/repository"
+	"Service/internal/usecase"
+	"Service/pkg/logger"
+	"context"
+	"expvar"
+	"time"
+)
+
+var stats = expvar.NewMap("photo_gc")
+
+type PhotoGCUseCase struct {
+	photoRepo    repository.PhotoRepository
+	cloudUseCase usecase.CloudUseCase
+}
+
+func NewPhotoGCUseCase(photoRepo repository.PhotoRepository, cloudUseCase usecase.CloudUseCase) *PhotoGCUseCase {
+	return &PhotoGCUseCase{
+		photoRepo:    photoRepo,
+		cloudUseCase: cloudUseCase,
+	}
+}
+
+// CollectOrphanedPhotos deletes service photos and gallery objects nothing
+// points at, unless it is a dry run. Objects younger than the grace period
+// are kept.
+func (u *PhotoGCUseCase) CollectOrphanedPhotos(ctx context.Context, opts *models.PhotoGCOptions) (*models.PhotoGCReport, error) {
+	report := &models.PhotoGCReport{DryRun: opts.DryRun, StartedTime: time.Now()}
+
+	// The bucket is listed before the photos are read, so a photo saved in
+	// between is seen as referenced rather than as an orphan.
+	var objects []models.StoredObject
+	for _, prefix := range []string{models.ServicePhotoPrefix, models.ServiceMediaPrefix} {
+		prefixObjects, err := u.cloudUseCase.ListObjects(ctx, prefix)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, prefixObjects...)
+	}
+
+	photos, err := u.photoRepo.GetServicePhotos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	referenced := make(map[string]struct{}, len(photos))
+	for _, photo := range photos {
+		referenced[photo] = struct{}{}
+	}
+
+	cutoff := report.StartedTime.Add(-opts.GracePeriod)
+
+	for _, object := range objects {
+		report.ObjectsScanned++
+
+		if _, ok := referenced[object.Key]; ok || object.LastModified.After(cutoff) {
+			continue
+		}
+
+		report.OrphanKeys = append(report.OrphanKeys, object.Key)
+
+		if opts.DryRun {
+			continue
+		}
+
+		if err := u.cloudUseCase.DeleteObject(ctx, object.Key); err != nil {
+			logger.ErrorLogger.Printf("Failed to delete orphaned photo %s: %v", object.Key, err)
+			continue
+		}
+		report.DeletedObjects++
+	}
+
+	report.Duration = time.Since(report.StartedTime)
+
+	stats.Add("runs", 1)
+	stats.Add("orphans_found", int64(len(report.OrphanKeys)))
+	stats.Add("objects_deleted", int64(report.DeletedObjects))
+
+	logger.InfoLogger.Printf("Photo GC finished in %s: scanned=%d orphans=%d deleted=%d dry_run=%t",
+		report.Duration, report.ObjectsScanned, len(report.OrphanKeys), report.DeletedObjects, report.DryRun)
+
+	return report, nil
+}
+
+// Schedule runs CollectOrphanedPhotos every interval until ctx is done.
+func (u *PhotoGCUseCase) Schedule(ctx context.Context, interval time.Duration, opts *models.PhotoGCOptions) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := u.CollectOrphanedPhotos(ctx, opts); err != nil {
+				logger.ErrorLogger.Printf("Scheduled photo GC failed: %v", err)
+			}
+		}
+	}
+}
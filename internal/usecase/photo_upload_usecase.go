@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"context"
+)
+
+type PhotoUploadUseCase interface {
+	CreatePhotoUpload(ctx context.Context, cmd *dtos.CreatePhotoUploadCommand) (*models.PhotoUpload, error)
+	ConfirmPhotoUpload(ctx context.Context, cmd *dtos.ConfirmPhotoUploadCommand) (*models.Service, error)
+}
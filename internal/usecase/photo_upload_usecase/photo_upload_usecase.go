@@ -0,0 +1,219 @@
+package photo_upload_usecase
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/internal/repository"
+	"Service/internal/usecase"
+	"Service/pkg/logger"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultUploadTTL = 15 * time.Minute
+
+// sniffLen is how much http.DetectContentType looks at.
+const sniffLen = 512
+
+type PhotoUploadUseCase struct {
+	uploadRepo     repository.PhotoUploadRepository
+	serviceUseCase usecase.ServiceUseCase
+	cloudUseCase   usecase.CloudUseCase
+	ttl            time.Duration
+}
+
+func NewPhotoUploadUseCase(
+	uploadRepo repository.PhotoUploadRepository,
+	serviceUseCase usecase.ServiceUseCase,
+	cloudUseCase usecase.CloudUseCase,
+	ttl time.Duration,
+) *PhotoUploadUseCase {
+	if ttl <= 0 {
+		ttl = defaultUploadTTL
+	}
+
+	return &PhotoUploadUseCase{
+		uploadRepo:     uploadRepo,
+		serviceUseCase: serviceUseCase,
+		cloudUseCase:   cloudUseCase,
+		ttl:            ttl,
+	}
+}
+
+// CreatePhotoUpload reserves a new photo version of the service and returns
+// a presigned url the client puts the photo to.
+func (u *PhotoUploadUseCase) CreatePhotoUpload(ctx context.Context, cmd *dtos.CreatePhotoUploadCommand) (*models.PhotoUpload, error) {
+	_, err := u.serviceUseCase.GetServiceById(ctx, cmd.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	upload := &models.PhotoUpload{
+		Token:       uuid.New(),
+		ServiceId:   cmd.ServiceId,
+		ObjectKey:   models.ServicePhotoKey(cmd.ServiceId),
+		ContentType: cmd.ContentType,
+		MaxSize:     cmd.Size,
+		ExpiresTime: now.Add(u.ttl),
+		CreatedTime: now,
+	}
+
+	upload.UploadURL, err = u.cloudUseCase.PresignPutObject(ctx, upload.ObjectKey, upload.ContentType, upload.MaxSize, u.ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.uploadRepo.CreatePhotoUpload(ctx, upload)
+	if err != nil {
+		if errors.Is(err, customErrors.ServiceNotFound) {
+			return nil, customErrors.NewResourceError(err, cmd.ServiceId.String())
+		}
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+// ConfirmPhotoUpload checks the uploaded object against what was declared,
+// its size, stored type and the type its first bytes show, and makes it the
+// photo of the service. The replaced photo is removed after the switch is
+// committed.
+func (u *PhotoUploadUseCase) ConfirmPhotoUpload(ctx context.Context, cmd *dtos.ConfirmPhotoUploadCommand) (*models.Service, error) {
+	upload, err := u.uploadRepo.GetPhotoUpload(ctx, cmd.Token)
+	if err != nil {
+		return nil, withToken(err, cmd.Token)
+	}
+
+	if upload.ServiceId != cmd.ServiceId {
+		return nil, customErrors.NewResourceError(customErrors.PhotoUploadNotFound, cmd.Token.String())
+	}
+
+	if time.Now().After(upload.ExpiresTime) {
+		return nil, customErrors.NewResourceError(customErrors.PhotoUploadExpired, cmd.Token.String())
+	}
+
+	object, found, err := u.cloudUseCase.StatObject(ctx, upload.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, customErrors.NewResourceError(customErrors.PhotoUploadIncomplete, cmd.Token.String())
+	}
+
+	if object.Size > upload.MaxSize || object.ContentType != upload.ContentType {
+		u.reject(ctx, upload)
+		return nil, customErrors.NewResourceError(customErrors.PhotoUploadMismatch, cmd.Token.String())
+	}
+
+	// The stored content type is only what the client declared, the bytes
+	// must say the same.
+	detected, err := u.detectContentType(ctx, upload.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	if detected != upload.ContentType {
+		u.reject(ctx, upload)
+		return nil, customErrors.NewResourceError(customErrors.PhotoUploadMismatch, cmd.Token.String())
+	}
+
+	previousPhoto, err := u.uploadRepo.AttachPhotoUpload(ctx, upload, time.Now())
+	if err != nil {
+		if errors.Is(err, customErrors.ServiceNotFound) {
+			return nil, customErrors.NewResourceError(err, cmd.ServiceId.String())
+		}
+		return nil, withToken(err, cmd.Token)
+	}
+
+	if previousPhoto != "" && previousPhoto != upload.ObjectKey && !models.IsServiceMediaKey(previousPhoto) {
+		if err := u.cloudUseCase.DeleteObject(ctx, previousPhoto); err != nil {
+			logger.ErrorLogger.Printf("Failed to delete replaced photo %s: %v", previousPhoto, err)
+		}
+	}
+
+	return u.serviceUseCase.GetServiceById(ctx, cmd.ServiceId)
+}
+
+// CleanupExpiredUploads removes uploads that were never confirmed together
+// with whatever the clients managed to put into the bucket.
+func (u *PhotoUploadUseCase) CleanupExpiredUploads(ctx context.Context) (int, error) {
+	keys, err := u.uploadRepo.DeleteExpiredPhotoUploads(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, key := range keys {
+		if err := u.cloudUseCase.DeleteObject(ctx, key); err != nil {
+			logger.ErrorLogger.Printf("Failed to delete expired upload %s: %v", key, err)
+			continue
+		}
+		removed++
+	}
+
+	if len(keys) > 0 {
+		logger.InfoLogger.Printf("Removed %d of %d expired photo uploads", removed, len(keys))
+	}
+
+	return removed, nil
+}
+
+// Schedule runs CleanupExpiredUploads every interval until ctx is done.
+func (u *PhotoUploadUseCase) Schedule(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := u.CleanupExpiredUploads(ctx); err != nil {
+				logger.ErrorLogger.Printf("Scheduled photo upload cleanup failed: %v", err)
+			}
+		}
+	}
+}
+
+// detectContentType sniffs the type of the object from its first bytes.
+func (u *PhotoUploadUseCase) detectContentType(ctx context.Context, key string) (string, error) {
+	object, err := u.cloudUseCase.OpenObject(ctx, key, models.ObjectReadOptions{})
+	if err != nil {
+		return "", err
+	}
+	defer object.Body.Close()
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(object.Body, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read uploaded photo: %w", err)
+	}
+
+	return http.DetectContentType(head[:n]), nil
+}
+
+// reject drops an upload whose object does not match what was declared.
+func (u *PhotoUploadUseCase) reject(ctx context.Context, upload *models.PhotoUpload) {
+	if err := u.cloudUseCase.DeleteObject(ctx, upload.ObjectKey); err != nil {
+		logger.ErrorLogger.Printf("Failed to delete rejected upload %s: %v", upload.ObjectKey, err)
+	}
+
+	if err := u.uploadRepo.DeletePhotoUpload(ctx, upload.Token); err != nil {
+		logger.ErrorLogger.Printf("Failed to forget rejected upload %s: %v", upload.Token, err)
+	}
+}
+
+func withToken(err error, token uuid.UUID) error {
+	if errors.Is(err, customErrors.PhotoUploadNotFound) {
+		return customErrors.NewResourceError(err, token.String())
+	}
+
+	return err
+}
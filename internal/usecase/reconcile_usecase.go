@@ -0,0 +1,10 @@
+package usecase
+
+import (
+	"Service/internal/models"
+	"context"
+)
+
+type ReconcileUseCase interface {
+	ReconcileLinks(ctx context.Context, opts *models.ReconcileOptions) (*models.ReconcileReport, error)
+}
@@ -0,0 +1,281 @@
+package reconcile_usecase
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/internal/repository"
+	"Service/pkg/logger"
+	"context"
+	"expvar"
+	abonementGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.abonement"
+	coachGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBatchSize      = 100
+	defaultCallsPerSecond = 20
+)
+
+var stats = expvar.NewMap("reconciler")
+
+// peerChecker sorts a batch of ids into the ones the peer definitely does not
+// know and the ones it could not verify.
+type peerChecker func(ctx context.Context, ids []uuid.UUID, limiter <-chan time.Time) (orphans []uuid.UUID, unverified int)
+
+type ReconcileUseCase struct {
+	linkRepo        repository.LinkRepository
+	coachClient     *coachGRPC.CoachClient
+	abonementClient *abonementGRPC.AbonementClient
+	onOrphans       func(coachIds []uuid.UUID, abonementIds []uuid.UUID)
+
+	running sync.Mutex
+}
+
+// NewReconcileUseCase takes onOrphans to let callers react to removed owners,
+// e.g. by dropping them from caches. It may be nil.
+func NewReconcileUseCase(
+	linkRepo repository.LinkRepository,
+	coachClient *coachGRPC.CoachClient,
+	abonementClient *abonementGRPC.AbonementClient,
+	onOrphans func(coachIds []uuid.UUID, abonementIds []uuid.UUID),
+) *ReconcileUseCase {
+	return &ReconcileUseCase{
+		linkRepo:        linkRepo,
+		coachClient:     coachClient,
+		abonementClient: abonementClient,
+		onOrphans:       onOrphans,
+	}
+}
+
+// ReconcileLinks finds coach_service and abonement_service rows whose owner
+// was deleted in its own service and, unless it is a dry run, removes them.
+// Ids the peers fail to answer for are never treated as orphans.
+func (u *ReconcileUseCase) ReconcileLinks(ctx context.Context, opts *models.ReconcileOptions) (*models.ReconcileReport, error) {
+	if !u.running.TryLock() {
+		return nil, customErrors.ReconciliationInProgress
+	}
+	defer u.running.Unlock()
+
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	callsPerSecond := opts.CallsPerSecond
+	if callsPerSecond <= 0 {
+		callsPerSecond = defaultCallsPerSecond
+	}
+
+	limiter := time.NewTicker(time.Second / time.Duration(callsPerSecond))
+	defer limiter.Stop()
+
+	report := &models.ReconcileReport{DryRun: opts.DryRun, StartedTime: time.Now()}
+
+	coachOrphans, coachChecked, coachUnverified, err := u.scan(ctx, batchSize, limiter.C, u.linkRepo.GetLinkedCoachIds, u.checkCoaches)
+	if err != nil {
+		return nil, err
+	}
+
+	abonementOrphans, abonementChecked, abonementUnverified, err := u.scan(ctx, batchSize, limiter.C, u.linkRepo.GetLinkedAbonementIds, u.checkAbonements)
+	if err != nil {
+		return nil, err
+	}
+
+	report.CoachIdsChecked = coachChecked
+	report.AbonementIdsChecked = abonementChecked
+	report.OrphanCoachIds = coachOrphans
+	report.OrphanAbonementIds = abonementOrphans
+	report.Unverified = coachUnverified + abonementUnverified
+
+	if !opts.DryRun {
+		report.RemovedLinks, err = u.removeOrphans(ctx, coachOrphans, abonementOrphans)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	report.Duration = time.Since(report.StartedTime)
+
+	stats.Add("runs", 1)
+	stats.Add("orphan_coaches", int64(len(coachOrphans)))
+	stats.Add("orphan_abonements", int64(len(abonementOrphans)))
+	stats.Add("unverified", int64(report.Unverified))
+	stats.Add("removed_links", report.RemovedLinks)
+
+	logger.InfoLogger.Printf(
+		"Reconciliation finished (dry run: %t) in %s: checked %d coaches and %d abonements, "+
+			"found %d orphan coaches and %d orphan abonements, %d unverified, removed %d links",
+		report.DryRun, report.Duration, report.CoachIdsChecked, report.AbonementIdsChecked,
+		len(coachOrphans), len(abonementOrphans), report.Unverified, report.RemovedLinks,
+	)
+
+	return report, nil
+}
+
+// Schedule runs ReconcileLinks every interval until ctx is done.
+func (u *ReconcileUseCase) Schedule(ctx context.Context, interval time.Duration, opts *models.ReconcileOptions) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := u.ReconcileLinks(ctx, opts); err != nil {
+				logger.ErrorLogger.Printf("Scheduled reconciliation failed: %v", err)
+			}
+		}
+	}
+}
+
+func (u *ReconcileUseCase) scan(
+	ctx context.Context,
+	batchSize int,
+	limiter <-chan time.Time,
+	page func(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error),
+	check peerChecker,
+) ([]uuid.UUID, int, int, error) {
+
+	var orphans []uuid.UUID
+	checked, unverified := 0, 0
+	after := uuid.Nil
+
+	for {
+		ids, err := page(ctx, after, batchSize)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		if len(ids) == 0 {
+			return orphans, checked, unverified, nil
+		}
+
+		batchOrphans, batchUnverified := check(ctx, ids, limiter)
+		orphans = append(orphans, batchOrphans...)
+		unverified += batchUnverified
+		checked += len(ids)
+		after = ids[len(ids)-1]
+
+		if ctx.Err() != nil {
+			return nil, 0, 0, ctx.Err()
+		}
+	}
+}
+
+func (u *ReconcileUseCase) checkCoaches(ctx context.Context, ids []uuid.UUID, limiter <-chan time.Time) ([]uuid.UUID, int) {
+	var orphans []uuid.UUID
+	unverified := 0
+
+	for _, id := range ids {
+		if !wait(ctx, limiter) {
+			return orphans, unverified
+		}
+
+		_, err := (*u.coachClient).GetCoachById(ctx, &coachGRPC.GetCoachByIdRequest{Id: id.String()})
+		switch {
+		case err == nil:
+		case status.Code(err) == codes.NotFound:
+			orphans = append(orphans, id)
+		default:
+			logger.ErrorLogger.Printf("Failed to verify coach %s: %v", id, err)
+			unverified++
+		}
+	}
+
+	return orphans, unverified
+}
+
+// checkAbonements asks for the whole batch at once and treats every id missing
+// from the answer as an orphan. If the batch call fails the ids are checked one
+// by one.
+func (u *ReconcileUseCase) checkAbonements(ctx context.Context, ids []uuid.UUID, limiter <-chan time.Time) ([]uuid.UUID, int) {
+	if !wait(ctx, limiter) {
+		return nil, len(ids)
+	}
+
+	request := &abonementGRPC.GetAbonementsByIdsRequest{}
+	for _, id := range ids {
+		request.Ids = append(request.Ids, id.String())
+	}
+
+	response, err := (*u.abonementClient).GetAbonementsByIds(ctx, request)
+	if err == nil {
+		found := make(map[string]struct{}, len(response.AbonementObjects))
+		for _, abonement := range response.AbonementObjects {
+			found[abonement.Id] = struct{}{}
+		}
+
+		var orphans []uuid.UUID
+		for _, id := range ids {
+			if _, ok := found[id.String()]; !ok {
+				orphans = append(orphans, id)
+			}
+		}
+
+		return orphans, 0
+	}
+
+	logger.ErrorLogger.Printf("Batch abonement check failed, falling back to single checks: %v", err)
+
+	var orphans []uuid.UUID
+	unverified := 0
+
+	for _, id := range ids {
+		if !wait(ctx, limiter) {
+			return orphans, unverified
+		}
+
+		_, err := (*u.abonementClient).GetAbonementById(ctx, &abonementGRPC.GetAbonementByIdRequest{Id: id.String()})
+		switch {
+		case err == nil:
+		case status.Code(err) == codes.NotFound:
+			orphans = append(orphans, id)
+		default:
+			logger.ErrorLogger.Printf("Failed to verify abonement %s: %v", id, err)
+			unverified++
+		}
+	}
+
+	return orphans, unverified
+}
+
+func (u *ReconcileUseCase) removeOrphans(ctx context.Context, coachIds []uuid.UUID, abonementIds []uuid.UUID) (int64, error) {
+	var removed int64
+
+	if len(coachIds) > 0 {
+		count, err := u.linkRepo.DeleteCoachesLinks(ctx, coachIds)
+		if err != nil {
+			return 0, err
+		}
+		removed += count
+	}
+
+	if len(abonementIds) > 0 {
+		count, err := u.linkRepo.DeleteAbonementsLinks(ctx, abonementIds)
+		if err != nil {
+			return removed, err
+		}
+		removed += count
+	}
+
+	if u.onOrphans != nil && (len(coachIds) > 0 || len(abonementIds) > 0) {
+		u.onOrphans(coachIds, abonementIds)
+	}
+
+	return removed, nil
+}
+
+func wait(ctx context.Context, limiter <-chan time.Time) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-limiter:
+		return true
+	}
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"Service/internal/models"
+	"context"
+	"io"
+)
+
+type ReportUseCase interface {
+	GetServiceUsage(ctx context.Context, query *models.ReportQuery) ([]*models.ServiceUsage, error)
+	GetCoverageGaps(ctx context.Context, query *models.ReportQuery) ([]*models.ServiceUsage, error)
+	GetVisitTrend(ctx context.Context, query *models.ReportQuery) ([]*models.TrendPoint, error)
+
+	// ExportReport writes the named report to w as CSV with a header row.
+	ExportReport(ctx context.Context, report string, query *models.ReportQuery, w io.Writer) error
+}
@@ -0,0 +1,61 @@
+package report_usecase
+
+import (
+	"Service/internal/models"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	serviceUsageHeader = []string{"service_id", "title", "coaches", "abonements", "visits", "guest_visits", "members"}
+	trendHeader        = []string{"period_start", "visits", "guest_visits", "members"}
+)
+
+func writeServiceUsageCSV(w io.Writer, usage []*models.ServiceUsage) error {
+	writer := csv.NewWriter(w)
+	_ = writer.Write(serviceUsageHeader)
+
+	for _, service := range usage {
+		_ = writer.Write([]string{
+			service.ServiceId.String(),
+			csvText(service.Title),
+			strconv.Itoa(service.Coaches),
+			strconv.Itoa(service.Abonements),
+			strconv.Itoa(service.Visits),
+			strconv.Itoa(service.GuestVisits),
+			strconv.Itoa(service.Members),
+		})
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeTrendCSV(w io.Writer, trend []*models.TrendPoint) error {
+	writer := csv.NewWriter(w)
+	_ = writer.Write(trendHeader)
+
+	for _, point := range trend {
+		_ = writer.Write([]string{
+			point.PeriodStart.Format(time.RFC3339),
+			strconv.Itoa(point.Visits),
+			strconv.Itoa(point.GuestVisits),
+			strconv.Itoa(point.Members),
+		})
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvText keeps spreadsheets from evaluating free text as a formula.
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
@@ -0,0 +1,112 @@
+package report_usecase
+
+import (
+	"Service/internal/models"
+	"Service/internal/repository"
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+type ReportUseCase struct {
+	reportRepo repository.ReportRepository
+}
+
+func NewReportUseCase(reportRepo repository.ReportRepository) *ReportUseCase {
+	return &ReportUseCase{reportRepo: reportRepo}
+}
+
+func (u *ReportUseCase) GetServiceUsage(ctx context.Context, query *models.ReportQuery) ([]*models.ServiceUsage, error) {
+	return u.reportRepo.GetServiceUsage(ctx, query.From, query.To)
+}
+
+// GetCoverageGaps lists the services no coach offers. Those included in
+// abonements or visited in the range come first.
+func (u *ReportUseCase) GetCoverageGaps(ctx context.Context, query *models.ReportQuery) ([]*models.ServiceUsage, error) {
+	return u.reportRepo.GetUncoachedServices(ctx, query.From, query.To)
+}
+
+// GetVisitTrend returns a point for every period overlapping the range, the
+// first one starting before From when From is not a period start. Periods
+// without visits are included with zero counts.
+func (u *ReportUseCase) GetVisitTrend(ctx context.Context, query *models.ReportQuery) ([]*models.TrendPoint, error) {
+	location, err := time.LoadLocation(query.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load time zone %s: %w", query.TimeZone, err)
+	}
+
+	points, err := u.reportRepo.GetVisitTrend(ctx, query.ServiceId, query.Granularity, query.TimeZone, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	counted := make(map[int64]*models.TrendPoint, len(points))
+	for _, point := range points {
+		counted[point.PeriodStart.Unix()] = point
+	}
+
+	var trend []*models.TrendPoint
+	for start := periodStart(query.Granularity, query.From.In(location)); start.Before(query.To); start = nextPeriod(query.Granularity, start) {
+		point, ok := counted[start.Unix()]
+		if !ok {
+			point = &models.TrendPoint{}
+		}
+		point.PeriodStart = start
+
+		trend = append(trend, point)
+	}
+
+	return trend, nil
+}
+
+func (u *ReportUseCase) ExportReport(ctx context.Context, report string, query *models.ReportQuery, w io.Writer) error {
+	switch report {
+	case models.ReportServiceUsage:
+		usage, err := u.GetServiceUsage(ctx, query)
+		if err != nil {
+			return err
+		}
+		return writeServiceUsageCSV(w, usage)
+	case models.ReportCoverageGaps:
+		usage, err := u.GetCoverageGaps(ctx, query)
+		if err != nil {
+			return err
+		}
+		return writeServiceUsageCSV(w, usage)
+	case models.ReportVisitTrend:
+		trend, err := u.GetVisitTrend(ctx, query)
+		if err != nil {
+			return err
+		}
+		return writeTrendCSV(w, trend)
+	default:
+		return fmt.Errorf("unknown report %q", report)
+	}
+}
+
+// periodStart truncates local to the start of its period, weeks starting on
+// Monday as in date_trunc.
+func periodStart(granularity string, local time.Time) time.Time {
+	year, month, day := local.Date()
+
+	switch granularity {
+	case models.TrendGranularityWeek:
+		return time.Date(year, month, day-(int(local.Weekday())+6)%7, 0, 0, 0, 0, local.Location())
+	case models.TrendGranularityMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, local.Location())
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, local.Location())
+	}
+}
+
+func nextPeriod(granularity string, start time.Time) time.Time {
+	switch granularity {
+	case models.TrendGranularityWeek:
+		return start.AddDate(0, 0, 7)
+	case models.TrendGranularityMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
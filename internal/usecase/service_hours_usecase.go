@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type ServiceHoursUseCase interface {
+	GetServiceHours(ctx context.Context, serviceId uuid.UUID) (*models.ServiceHours, error)
+	SetServiceHours(ctx context.Context, cmd *dtos.SetServiceHoursCommand) (*models.ServiceHours, error)
+
+	AddServiceClosure(ctx context.Context, cmd *dtos.AddServiceClosureCommand) (*models.ServiceClosure, error)
+	RemoveServiceClosure(ctx context.Context, serviceId uuid.UUID, closureId uuid.UUID) error
+	GetServiceClosures(ctx context.Context, serviceId uuid.UUID, from time.Time, to time.Time) ([]*models.ServiceClosure, error)
+
+	GetOpenStatus(ctx context.Context, serviceId uuid.UUID, at time.Time) (*models.OpenStatus, error)
+	GetOpeningWindows(ctx context.Context, serviceId uuid.UUID, from time.Time, to time.Time) ([]*models.OpeningWindow, error)
+}
@@ -0,0 +1,136 @@
+package service_hours_usecase
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/internal/repository"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+// nextWindowLookahead bounds the search for the next opening of a closed
+// service.
+const nextWindowLookahead = 31 * 24 * time.Hour
+
+type ServiceHoursUseCase struct {
+	hoursRepo repository.ServiceHoursRepository
+}
+
+func NewServiceHoursUseCase(hoursRepo repository.ServiceHoursRepository) *ServiceHoursUseCase {
+	return &ServiceHoursUseCase{hoursRepo: hoursRepo}
+}
+
+func (u *ServiceHoursUseCase) GetServiceHours(ctx context.Context, serviceId uuid.UUID) (*models.ServiceHours, error) {
+	hours, err := u.hoursRepo.GetServiceHours(ctx, serviceId)
+	if err != nil {
+		return nil, withIds(err, serviceId, uuid.Nil)
+	}
+
+	return hours, nil
+}
+
+func (u *ServiceHoursUseCase) SetServiceHours(ctx context.Context, cmd *dtos.SetServiceHoursCommand) (*models.ServiceHours, error) {
+	hours := &models.ServiceHours{
+		ServiceId:   cmd.ServiceId,
+		TimeZone:    cmd.TimeZone,
+		Intervals:   cmd.Intervals,
+		UpdatedTime: time.Now(),
+	}
+
+	err := u.hoursRepo.SetServiceHours(ctx, hours)
+	if err != nil {
+		return nil, withIds(err, cmd.ServiceId, uuid.Nil)
+	}
+
+	return u.GetServiceHours(ctx, cmd.ServiceId)
+}
+
+func (u *ServiceHoursUseCase) AddServiceClosure(ctx context.Context, cmd *dtos.AddServiceClosureCommand) (*models.ServiceClosure, error) {
+	closure := &models.ServiceClosure{
+		Id:          uuid.New(),
+		ServiceId:   cmd.ServiceId,
+		StartDate:   cmd.StartDate,
+		EndDate:     cmd.EndDate,
+		Kind:        cmd.Kind,
+		Reason:      cmd.Reason,
+		CreatedTime: time.Now(),
+	}
+
+	err := u.hoursRepo.AddServiceClosure(ctx, closure)
+	if err != nil {
+		return nil, withIds(err, cmd.ServiceId, closure.Id)
+	}
+
+	return closure, nil
+}
+
+func (u *ServiceHoursUseCase) RemoveServiceClosure(ctx context.Context, serviceId uuid.UUID, closureId uuid.UUID) error {
+	err := u.hoursRepo.RemoveServiceClosure(ctx, serviceId, closureId)
+	if err != nil {
+		return withIds(err, serviceId, closureId)
+	}
+
+	return nil
+}
+
+func (u *ServiceHoursUseCase) GetServiceClosures(ctx context.Context, serviceId uuid.UUID, from time.Time, to time.Time) ([]*models.ServiceClosure, error) {
+	return u.hoursRepo.GetServiceClosures(ctx, serviceId, from, to)
+}
+
+func (u *ServiceHoursUseCase) GetOpenStatus(ctx context.Context, serviceId uuid.UUID, at time.Time) (*models.OpenStatus, error) {
+	// Starting a day earlier finds the real start of a window that is open at
+	// the moment.
+	windows, err := u.GetOpeningWindows(ctx, serviceId, at.Add(-24*time.Hour), at.Add(nextWindowLookahead))
+	if err != nil {
+		return nil, err
+	}
+
+	openStatus := &models.OpenStatus{At: at}
+
+	for _, window := range windows {
+		if !window.End.After(at) {
+			continue
+		}
+
+		openStatus.Open = !window.Start.After(at)
+		openStatus.Window = window
+		break
+	}
+
+	return openStatus, nil
+}
+
+func (u *ServiceHoursUseCase) GetOpeningWindows(ctx context.Context, serviceId uuid.UUID, from time.Time, to time.Time) ([]*models.OpeningWindow, error) {
+	hours, err := u.GetServiceHours(ctx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := time.LoadLocation(hours.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load time zone %s: %w", hours.TimeZone, err)
+	}
+
+	closures, err := u.hoursRepo.GetServiceClosures(ctx, serviceId, from.In(location), to.In(location))
+	if err != nil {
+		return nil, err
+	}
+
+	return openingWindows(hours.Intervals, location, closures, from, to), nil
+}
+
+func withIds(err error, serviceId uuid.UUID, closureId uuid.UUID) error {
+	switch {
+	case errors.Is(err, customErrors.ServiceNotFound),
+		errors.Is(err, customErrors.ServiceHoursNotFound):
+		return customErrors.NewResourceError(err, serviceId.String())
+	case errors.Is(err, customErrors.ServiceClosureNotFound):
+		return customErrors.NewResourceError(err, closureId.String())
+	default:
+		return err
+	}
+}
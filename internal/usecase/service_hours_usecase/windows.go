@@ -0,0 +1,79 @@
+package service_hours_usecase
+
+import (
+	"Service/internal/models"
+	"time"
+)
+
+// openingWindows lays the weekly intervals over the local days between from
+// and to, skipping closed days, and clips the result to [from, to).
+// time.Date resolves local times skipped or repeated by daylight saving
+// changes, so intervals keep their wall clock times.
+func openingWindows(
+	intervals []models.OpeningInterval,
+	location *time.Location,
+	closures []*models.ServiceClosure,
+	from time.Time,
+	to time.Time,
+) []*models.OpeningWindow {
+
+	var windows []*models.OpeningWindow
+
+	localFrom := from.In(location)
+	day := time.Date(localFrom.Year(), localFrom.Month(), localFrom.Day(), 0, 0, 0, 0, location)
+
+	for day.Before(to) {
+		if !isClosed(closures, day.Format(models.DateLayout)) {
+			for _, interval := range intervals {
+				if interval.Weekday != day.Weekday() {
+					continue
+				}
+
+				start := time.Date(day.Year(), day.Month(), day.Day(), 0, interval.OpensMinute, 0, 0, location)
+				end := time.Date(day.Year(), day.Month(), day.Day(), 0, interval.ClosesMinute, 0, 0, location)
+
+				windows = appendWindow(windows, start, end, from, to)
+			}
+		}
+
+		day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, location)
+	}
+
+	return windows
+}
+
+// appendWindow clips the window and merges it into the last one when they
+// touch. Windows are appended in chronological order.
+func appendWindow(windows []*models.OpeningWindow, start, end, from, to time.Time) []*models.OpeningWindow {
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	if !end.After(start) {
+		return windows
+	}
+
+	if len(windows) > 0 {
+		last := windows[len(windows)-1]
+		if !start.After(last.End) {
+			if end.After(last.End) {
+				last.End = end.In(time.UTC)
+			}
+			return windows
+		}
+	}
+
+	return append(windows, &models.OpeningWindow{Start: start.In(time.UTC), End: end.In(time.UTC)})
+}
+
+func isClosed(closures []*models.ServiceClosure, date string) bool {
+	for _, closure := range closures {
+		if closure.Covers(date) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,160 @@
+package service_hours_usecase
+
+import (
+	"Service/internal/models"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestOpeningWindows(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	local := func(value string) time.Time {
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", value, berlin)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+	utc := func(value string) time.Time {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+	date := func(value string) time.Time {
+		parsed, err := time.Parse(models.DateLayout, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+
+	tests := []struct {
+		name      string
+		intervals []models.OpeningInterval
+		closures  []*models.ServiceClosure
+		from      time.Time
+		to        time.Time
+		want      []models.OpeningWindow
+	}{
+		{
+			name:      "regular day",
+			intervals: []models.OpeningInterval{{Weekday: time.Monday, OpensMinute: 9 * 60, ClosesMinute: 18 * 60}},
+			from:      local("2026-01-05 00:00"),
+			to:        local("2026-01-12 00:00"),
+			want:      []models.OpeningWindow{{Start: utc("2026-01-05T08:00:00Z"), End: utc("2026-01-05T17:00:00Z")}},
+		},
+		{
+			name:      "spring forward keeps wall clock times",
+			intervals: []models.OpeningInterval{{Weekday: time.Sunday, OpensMinute: 1 * 60, ClosesMinute: 4 * 60}},
+			from:      local("2026-03-29 00:00"),
+			to:        local("2026-03-30 00:00"),
+			want:      []models.OpeningWindow{{Start: utc("2026-03-29T00:00:00Z"), End: utc("2026-03-29T02:00:00Z")}},
+		},
+		{
+			name:      "fall back keeps wall clock times",
+			intervals: []models.OpeningInterval{{Weekday: time.Sunday, OpensMinute: 1 * 60, ClosesMinute: 4 * 60}},
+			from:      local("2026-10-25 00:00"),
+			to:        local("2026-10-26 00:00"),
+			want:      []models.OpeningWindow{{Start: utc("2026-10-24T23:00:00Z"), End: utc("2026-10-25T03:00:00Z")}},
+		},
+		{
+			name: "day after the change uses the new offset",
+			intervals: []models.OpeningInterval{
+				{Weekday: time.Sunday, OpensMinute: 9 * 60, ClosesMinute: 18 * 60},
+				{Weekday: time.Monday, OpensMinute: 9 * 60, ClosesMinute: 18 * 60},
+			},
+			from: local("2026-03-28 00:00"),
+			to:   local("2026-03-31 00:00"),
+			want: []models.OpeningWindow{
+				{Start: utc("2026-03-29T07:00:00Z"), End: utc("2026-03-29T16:00:00Z")},
+				{Start: utc("2026-03-30T07:00:00Z"), End: utc("2026-03-30T16:00:00Z")},
+			},
+		},
+		{
+			name: "touching intervals merge",
+			intervals: []models.OpeningInterval{
+				{Weekday: time.Monday, OpensMinute: 9 * 60, ClosesMinute: 12 * 60},
+				{Weekday: time.Monday, OpensMinute: 12 * 60, ClosesMinute: 15 * 60},
+			},
+			from: local("2026-01-05 00:00"),
+			to:   local("2026-01-06 00:00"),
+			want: []models.OpeningWindow{{Start: utc("2026-01-05T08:00:00Z"), End: utc("2026-01-05T14:00:00Z")}},
+		},
+		{
+			name: "intervals with a gap stay apart",
+			intervals: []models.OpeningInterval{
+				{Weekday: time.Monday, OpensMinute: 9 * 60, ClosesMinute: 12 * 60},
+				{Weekday: time.Monday, OpensMinute: 13 * 60, ClosesMinute: 15 * 60},
+			},
+			from: local("2026-01-05 00:00"),
+			to:   local("2026-01-06 00:00"),
+			want: []models.OpeningWindow{
+				{Start: utc("2026-01-05T08:00:00Z"), End: utc("2026-01-05T11:00:00Z")},
+				{Start: utc("2026-01-05T12:00:00Z"), End: utc("2026-01-05T14:00:00Z")},
+			},
+		},
+		{
+			name: "window spanning midnight",
+			intervals: []models.OpeningInterval{
+				{Weekday: time.Monday, OpensMinute: 20 * 60, ClosesMinute: 24 * 60},
+				{Weekday: time.Tuesday, OpensMinute: 0, ClosesMinute: 2 * 60},
+			},
+			from: local("2026-01-05 00:00"),
+			to:   local("2026-01-07 00:00"),
+			want: []models.OpeningWindow{{Start: utc("2026-01-05T19:00:00Z"), End: utc("2026-01-06T01:00:00Z")}},
+		},
+		{
+			name:      "range starting late on the previous UTC day",
+			intervals: []models.OpeningInterval{{Weekday: time.Monday, OpensMinute: 0, ClosesMinute: 2 * 60}},
+			from:      utc("2026-01-04T23:30:00Z"),
+			to:        utc("2026-01-05T12:00:00Z"),
+			want:      []models.OpeningWindow{{Start: utc("2026-01-04T23:30:00Z"), End: utc("2026-01-05T01:00:00Z")}},
+		},
+		{
+			name:      "clipped to the range",
+			intervals: []models.OpeningInterval{{Weekday: time.Monday, OpensMinute: 9 * 60, ClosesMinute: 18 * 60}},
+			from:      local("2026-01-05 10:00"),
+			to:        local("2026-01-05 11:00"),
+			want:      []models.OpeningWindow{{Start: utc("2026-01-05T09:00:00Z"), End: utc("2026-01-05T10:00:00Z")}},
+		},
+		{
+			name:      "range ending at the opening",
+			intervals: []models.OpeningInterval{{Weekday: time.Monday, OpensMinute: 9 * 60, ClosesMinute: 18 * 60}},
+			from:      local("2026-01-05 00:00"),
+			to:        local("2026-01-05 09:00"),
+		},
+		{
+			name: "closed days are skipped",
+			intervals: []models.OpeningInterval{
+				{Weekday: time.Monday, OpensMinute: 9 * 60, ClosesMinute: 18 * 60},
+				{Weekday: time.Tuesday, OpensMinute: 9 * 60, ClosesMinute: 18 * 60},
+			},
+			closures: []*models.ServiceClosure{{StartDate: date("2026-01-05"), EndDate: date("2026-01-05")}},
+			from:     local("2026-01-05 00:00"),
+			to:       local("2026-01-07 00:00"),
+			want:     []models.OpeningWindow{{Start: utc("2026-01-06T08:00:00Z"), End: utc("2026-01-06T17:00:00Z")}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := openingWindows(tt.intervals, berlin, tt.closures, tt.from, tt.to)
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d windows %v, want %d", len(got), got, len(tt.want))
+			}
+			for i, window := range got {
+				if !window.Start.Equal(tt.want[i].Start) || !window.End.Equal(tt.want[i].End) {
+					t.Errorf("window %d = [%s, %s), want [%s, %s)", i, window.Start, window.End, tt.want[i].Start, tt.want[i].End)
+				}
+			}
+		})
+	}
+}
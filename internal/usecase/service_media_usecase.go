@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"context"
+	"github.com/google/uuid"
+)
+
+type ServiceMediaUseCase interface {
+	GetServiceMedia(ctx context.Context, serviceId uuid.UUID) ([]*models.ServiceMedia, error)
+	AddServiceMedia(ctx context.Context, cmd *dtos.AddServiceMediaCommand) (*models.ServiceMedia, error)
+	RemoveServiceMedia(ctx context.Context, serviceId uuid.UUID, mediaId uuid.UUID) error
+	ReorderServiceMedia(ctx context.Context, serviceId uuid.UUID, mediaIds []uuid.UUID) ([]*models.ServiceMedia, error)
+	SetServiceMediaCover(ctx context.Context, serviceId uuid.UUID, mediaId uuid.UUID) ([]*models.ServiceMedia, error)
+}
@@ -0,0 +1,105 @@
+package service_media_usecase
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/internal/repository"
+	"Service/internal/usecase"
+	"Service/internal/validation"
+	"Service/pkg/logger"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+type ServiceMediaUseCase struct {
+	mediaRepo    repository.ServiceMediaRepository
+	cloudUseCase usecase.CloudUseCase
+}
+
+func NewServiceMediaUseCase(mediaRepo repository.ServiceMediaRepository, cloudUseCase usecase.CloudUseCase) *ServiceMediaUseCase {
+	return &ServiceMediaUseCase{
+		mediaRepo:    mediaRepo,
+		cloudUseCase: cloudUseCase,
+	}
+}
+
+func (u *ServiceMediaUseCase) GetServiceMedia(ctx context.Context, serviceId uuid.UUID) ([]*models.ServiceMedia, error) {
+	return u.mediaRepo.GetServiceMedia(ctx, serviceId)
+}
+
+// AddServiceMedia stores the object first and removes it again when the
+// gallery row cannot be saved.
+func (u *ServiceMediaUseCase) AddServiceMedia(ctx context.Context, cmd *dtos.AddServiceMediaCommand) (*models.ServiceMedia, error) {
+	media := &models.ServiceMedia{
+		Id:          uuid.New(),
+		ServiceId:   cmd.ServiceId,
+		MediaType:   cmd.MediaType,
+		ContentType: cmd.ContentType,
+		AltText:     cmd.AltText,
+		Cover:       cmd.Cover,
+		CreatedTime: time.Now(),
+	}
+	media.ObjectKey = models.ServiceMediaKey(media.ServiceId, media.Id)
+
+	err := u.cloudUseCase.PutObject(ctx, cmd.Content, media.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.mediaRepo.AddServiceMedia(ctx, media, validation.MaxServiceMedia)
+	if err != nil {
+		u.deleteObject(ctx, media.ObjectKey)
+		return nil, withIds(err, cmd.ServiceId, media.Id)
+	}
+
+	return media, nil
+}
+
+func (u *ServiceMediaUseCase) RemoveServiceMedia(ctx context.Context, serviceId uuid.UUID, mediaId uuid.UUID) error {
+	removed, err := u.mediaRepo.RemoveServiceMedia(ctx, serviceId, mediaId)
+	if err != nil {
+		return withIds(err, serviceId, mediaId)
+	}
+
+	u.deleteObject(ctx, removed.ObjectKey)
+
+	return nil
+}
+
+func (u *ServiceMediaUseCase) ReorderServiceMedia(ctx context.Context, serviceId uuid.UUID, mediaIds []uuid.UUID) ([]*models.ServiceMedia, error) {
+	err := u.mediaRepo.ReorderServiceMedia(ctx, serviceId, mediaIds)
+	if err != nil {
+		return nil, withIds(err, serviceId, uuid.Nil)
+	}
+
+	return u.mediaRepo.GetServiceMedia(ctx, serviceId)
+}
+
+func (u *ServiceMediaUseCase) SetServiceMediaCover(ctx context.Context, serviceId uuid.UUID, mediaId uuid.UUID) ([]*models.ServiceMedia, error) {
+	err := u.mediaRepo.SetServiceMediaCover(ctx, serviceId, mediaId)
+	if err != nil {
+		return nil, withIds(err, serviceId, mediaId)
+	}
+
+	return u.mediaRepo.GetServiceMedia(ctx, serviceId)
+}
+
+func (u *ServiceMediaUseCase) deleteObject(ctx context.Context, key string) {
+	if err := u.cloudUseCase.DeleteObject(ctx, key); err != nil {
+		logger.ErrorLogger.Printf("Failed to delete media object %s: %v", key, err)
+	}
+}
+
+func withIds(err error, serviceId uuid.UUID, mediaId uuid.UUID) error {
+	switch {
+	case errors.Is(err, customErrors.ServiceNotFound):
+		return customErrors.NewResourceError(err, serviceId.String())
+	case errors.Is(err, customErrors.ServiceMediaNotFound):
+		return customErrors.NewResourceError(err, mediaId.String())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"Service/internal/dtos"
+	"Service/internal/models"
+	"context"
+	"github.com/google/uuid"
+)
+
+type ServiceRuleUseCase interface {
+	CreateServiceRule(ctx context.Context, cmd *dtos.CreateServiceRuleCommand) (*models.ServiceRule, error)
+	DeleteServiceRule(ctx context.Context, id uuid.UUID) error
+	GetServiceRules(ctx context.Context, serviceId *uuid.UUID) ([]*models.ServiceRule, error)
+
+	// CheckServiceSet checks a proposed set of abonement services against the
+	// rules without saving anything.
+	CheckServiceSet(ctx context.Context, servicesIds []uuid.UUID) (*models.ServiceSetCheck, error)
+}
@@ -11,13 +11,23 @@ type ServiceUseCase interface {
 	CreateService(ctx context.Context, cmd *dtos.CreateServiceCommand) (*models.Service, error)
 	GetServiceById(ctx context.Context, id uuid.UUID) (*models.Service, error)
 	UpdateService(ctx context.Context, cmd *dtos.UpdateServiceCommand) (*models.Service, error)
-	DeleteServiceById(ctx context.Context, id uuid.UUID) (*models.Service, error)
+	DeleteServiceById(ctx context.Context, cmd *dtos.DeleteServiceCommand) (*models.Service, error)
+	GetServiceBySlug(ctx context.Context, slug string) (*models.Service, bool, error)
 
-	GetServices(ctx context.Context) ([]*models.Service, error)
+	GetServices(ctx context.Context, filter *models.ServiceFilter) ([]*models.Service, error)
 	CreateCoachServices(ctx context.Context, cmd *dtos.CreateCoachServicesCommand) ([]*models.Service, error)
 	CreateAbonemntServices(ctx context.Context, cmd *dtos.CreateAbonementServicesCommand) ([]*models.Service, error)
-	GetAbonementsServices(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]*models.Service, error)
-	GetCoachesServices(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]*models.Service, error)
+	GetAbonementsServices(ctx context.Context, ids []uuid.UUID, filter *models.ServiceFilter, expansion models.BundleExpansion) (map[uuid.UUID][]*models.Service, error)
+	GetCoachesServices(ctx context.Context, ids []uuid.UUID, filter *models.ServiceFilter, expansion models.BundleExpansion) (map[uuid.UUID][]*models.Service, error)
 	UpdateAbonementServices(ctx context.Context, abonementId uuid.UUID, servicesIds []uuid.UUID) ([]*models.Service, error)
 	UpdateCoachServices(ctx context.Context, coachId uuid.UUID, servicesIds []uuid.UUID) ([]*models.Service, error)
+
+	GetBundleComponents(ctx context.Context, bundleId uuid.UUID) ([]*models.Service, error)
+	SetBundleComponents(ctx context.Context, cmd *dtos.SetBundleComponentsCommand) ([]*models.Service, error)
+
+	UpsertServiceTranslation(ctx context.Context, cmd *dtos.UpsertServiceTranslationCommand) (*models.ServiceTranslation, error)
+	DeleteServiceTranslation(ctx context.Context, serviceId uuid.UUID, locale string) error
+	GetServiceTranslations(ctx context.Context, serviceId uuid.UUID) ([]*models.ServiceTranslation, error)
+
+	InvalidatePeerCache(ctx context.Context, coachIds []uuid.UUID, abonementIds []uuid.UUID, all bool)
 }
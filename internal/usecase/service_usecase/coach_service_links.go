@@ -0,0 +1,93 @@
+package service_usecase
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// CreateCoachServiceLink links a single service to the coach together with
+// the attributes of the link.
+func (u *ServiceUseCase) CreateCoachServiceLink(ctx context.Context, cmd *dtos.CreateCoachServiceLinkCommand) (*models.CoachServiceLink, error) {
+	err := u.checkCoachExists(ctx, cmd.CoachId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.checkServicesExist(ctx, []uuid.UUID{cmd.ServiceId})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	link := &models.CoachServiceLink{
+		CoachId:              cmd.CoachId,
+		ServiceId:            cmd.ServiceId,
+		QualificationLevel:   cmd.QualificationLevel,
+		CertificationExpiry:  cmd.CertificationExpiry,
+		PriceOverride:        cmd.PriceOverride,
+		CertificationExpired: models.CertificationExpiredOn(cmd.CertificationExpiry, now.UTC()),
+		UpdatedTime:          now,
+	}
+
+	err = u.serviceRepo.CreateCoachServiceLink(ctx, link)
+	if err != nil {
+		return nil, withLinkIds(err, cmd.CoachId, cmd.ServiceId)
+	}
+
+	return link, nil
+}
+
+func (u *ServiceUseCase) UpdateCoachServiceLink(ctx context.Context, cmd *dtos.UpdateCoachServiceLinkCommand) (*models.CoachServiceLink, error) {
+	now := time.Now()
+	link := &models.CoachServiceLink{
+		CoachId:              cmd.CoachId,
+		ServiceId:            cmd.ServiceId,
+		QualificationLevel:   cmd.QualificationLevel,
+		CertificationExpiry:  cmd.CertificationExpiry,
+		PriceOverride:        cmd.PriceOverride,
+		CertificationExpired: models.CertificationExpiredOn(cmd.CertificationExpiry, now.UTC()),
+		UpdatedTime:          now,
+	}
+
+	err := u.serviceRepo.UpdateCoachServiceLink(ctx, link)
+	if err != nil {
+		return nil, withLinkIds(err, cmd.CoachId, cmd.ServiceId)
+	}
+
+	return link, nil
+}
+
+func (u *ServiceUseCase) GetCoachServiceLink(ctx context.Context, coachId uuid.UUID, serviceId uuid.UUID) (*models.CoachServiceLink, error) {
+	link, err := u.serviceRepo.GetCoachServiceLink(ctx, coachId, serviceId)
+	if err != nil {
+		return nil, withLinkIds(err, coachId, serviceId)
+	}
+
+	return link, nil
+}
+
+func (u *ServiceUseCase) GetCoachServiceLinks(ctx context.Context, coachId uuid.UUID) ([]*models.CoachServiceLink, error) {
+	return u.serviceRepo.GetCoachServiceLinks(ctx, coachId)
+}
+
+func withLinkIds(err error, coachId uuid.UUID, serviceId uuid.UUID) error {
+	var resourceErr *customErrors.ResourceError
+	if errors.As(err, &resourceErr) {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, customErrors.ServiceNotFound):
+		return customErrors.NewResourceError(err, serviceId.String())
+	case errors.Is(err, customErrors.ServiceLinkAlreadyExists),
+		errors.Is(err, customErrors.CoachServiceLinkNotFound):
+		return customErrors.NewResourceError(err, coachId.String()+"/"+serviceId.String())
+	default:
+		return err
+	}
+}
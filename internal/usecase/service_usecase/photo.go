@@ -0,0 +1,41 @@
+package service_usecase
+
+import (
+	"Service/internal/models"
+	"Service/pkg/logger"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+// storePhoto uploads a new version of the service photo and returns its key.
+func (u *ServiceUseCase) storePhoto(ctx context.Context, serviceId uuid.UUID, content []byte) (string, error) {
+	key := models.ServicePhotoKey(serviceId)
+
+	err := u.cloudUseCase.PutObject(ctx, content, key)
+	if err != nil {
+		return "", fmt.Errorf("failed to store service photo: %w", err)
+	}
+
+	return key, nil
+}
+
+// deletePhoto removes a photo no service points at anymore. Failures are
+// only logged, the photo GC picks up what is left behind. Gallery objects are
+// left to the gallery.
+func (u *ServiceUseCase) deletePhoto(ctx context.Context, key string) {
+	if key == "" || models.IsServiceMediaKey(key) {
+		return
+	}
+
+	if err := u.cloudUseCase.DeleteObject(ctx, key); err != nil {
+		logger.ErrorLogger.Printf("Failed to delete photo %s: %v", key, err)
+	}
+}
+
+// discardPhoto removes a photo uploaded for a change that was not saved.
+func (u *ServiceUseCase) discardPhoto(ctx context.Context, key string) {
+	if err := u.cloudUseCase.DeleteObject(ctx, key); err != nil {
+		logger.ErrorLogger.Printf("Failed to discard unsaved photo %s: %v", key, err)
+	}
+}
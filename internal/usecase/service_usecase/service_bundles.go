@@ -0,0 +1,149 @@
+package service_usecase
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+)
+
+func (u *ServiceUseCase) GetBundleComponents(ctx context.Context, bundleId uuid.UUID) ([]*models.Service, error) {
+	_, err := u.serviceRepo.GetServiceById(ctx, bundleId)
+	if err != nil {
+		return nil, withServiceId(err, bundleId)
+	}
+
+	components, err := u.serviceRepo.GetBundleComponents(ctx, bundleId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.localize(ctx, components...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.classify(ctx, components...)
+	if err != nil {
+		return nil, err
+	}
+
+	return components, nil
+}
+
+func (u *ServiceUseCase) SetBundleComponents(ctx context.Context, cmd *dtos.SetBundleComponentsCommand) ([]*models.Service, error) {
+	for _, componentId := range cmd.ComponentIds {
+		if componentId == cmd.BundleId {
+			return nil, withServiceId(customErrors.BundleCycle, cmd.BundleId)
+		}
+	}
+
+	err := u.checkServicesExist(ctx, cmd.ComponentIds)
+	if err != nil {
+		return nil, err
+	}
+
+	violations, err := u.serviceRepo.SetBundleComponents(ctx, cmd.BundleId, cmd.ComponentIds)
+	if err != nil {
+		if errors.Is(err, customErrors.ServiceRulesViolated) {
+			return nil, rulesViolatedError(violations)
+		}
+		return nil, withServiceId(err, cmd.BundleId)
+	}
+
+	return u.GetBundleComponents(ctx, cmd.BundleId)
+}
+
+// expandBundles applies the expansion to the bundles among the services of
+// every owner.
+func (u *ServiceUseCase) expandBundles(
+	ctx context.Context,
+	grouped map[uuid.UUID][]*models.Service,
+	expansion models.BundleExpansion,
+) (map[uuid.UUID][]*models.Service, error) {
+
+	var ids []uuid.UUID
+	for _, group := range grouped {
+		for _, service := range group {
+			ids = append(ids, service.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return grouped, nil
+	}
+
+	if expansion != models.BundleExpansionFlatten {
+		components, err := u.serviceRepo.GetBundlesComponentIds(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range grouped {
+			for _, service := range group {
+				service.ComponentIds = components[service.Id]
+			}
+		}
+
+		return grouped, nil
+	}
+
+	leaves, err := u.serviceRepo.GetBundlesLeafIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(leaves) == 0 {
+		return grouped, nil
+	}
+
+	leafIds := make([]uuid.UUID, 0, len(leaves))
+	for _, bundleLeaves := range leaves {
+		leafIds = append(leafIds, bundleLeaves...)
+	}
+
+	leafServices, err := u.serviceRepo.GetServicesByIds(ctx, leafIds)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[uuid.UUID]*models.Service, len(leafServices))
+	for _, service := range leafServices {
+		byId[service.Id] = service
+	}
+
+	flattened := make(map[uuid.UUID][]*models.Service, len(grouped))
+	for ownerId, group := range grouped {
+		// Services linked directly win over the same service reached
+		// through a bundle.
+		seen := make(map[uuid.UUID]bool, len(group))
+		for _, service := range group {
+			if _, bundle := leaves[service.Id]; !bundle {
+				seen[service.Id] = true
+			}
+		}
+
+		flattened[ownerId] = make([]*models.Service, 0, len(group))
+		for _, service := range group {
+			bundleLeaves, bundle := leaves[service.Id]
+			if !bundle {
+				flattened[ownerId] = append(flattened[ownerId], service)
+				continue
+			}
+
+			for _, leafId := range bundleLeaves {
+				leaf, ok := byId[leafId]
+				if !ok || seen[leafId] {
+					continue
+				}
+				seen[leafId] = true
+
+				component := *leaf
+				component.BundleId = &service.Id
+				flattened[ownerId] = append(flattened[ownerId], &component)
+			}
+		}
+	}
+
+	return flattened, nil
+}
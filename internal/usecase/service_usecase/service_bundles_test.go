@@ -0,0 +1,138 @@
+package service_usecase
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/internal/repository"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"testing"
+)
+
+// bundleRepo answers the calls SetBundleComponents makes before it reads the
+// new components back. Every other method panics through the nil interface.
+type bundleRepo struct {
+	repository.ServiceRepository
+
+	services   map[uuid.UUID]bool
+	violations []*models.ServiceRuleViolation
+	err        error
+	sets       int
+}
+
+func (r *bundleRepo) GetServicesByIds(ctx context.Context, ids []uuid.UUID) ([]*models.Service, error) {
+	var services []*models.Service
+	for _, id := range ids {
+		if r.services[id] {
+			services = append(services, &models.Service{Id: id})
+		}
+	}
+
+	return services, nil
+}
+
+func (r *bundleRepo) SetBundleComponents(ctx context.Context, bundleId uuid.UUID, componentIds []uuid.UUID) ([]*models.ServiceRuleViolation, error) {
+	r.sets++
+	return r.violations, r.err
+}
+
+func TestSetBundleComponentsErrors(t *testing.T) {
+	bundleId, componentId, missingId := uuid.New(), uuid.New(), uuid.New()
+	rule := &models.ServiceRule{Id: uuid.New(), Kind: models.ServiceRuleExcludes, ServiceId: componentId, OtherServiceId: uuid.New()}
+	abonementId := uuid.New()
+
+	tests := []struct {
+		name         string
+		componentIds []uuid.UUID
+		repo         *bundleRepo
+		wantErr      error
+		wantResource string
+		wantRules    []string
+		wantSets     int
+	}{
+		{
+			name:         "bundle as its own component",
+			componentIds: []uuid.UUID{componentId, bundleId},
+			repo:         &bundleRepo{},
+			wantErr:      customErrors.BundleCycle,
+			wantResource: bundleId.String(),
+		},
+		{
+			name:         "unknown component",
+			componentIds: []uuid.UUID{componentId, missingId},
+			repo:         &bundleRepo{},
+			wantErr:      customErrors.ServiceNotFound,
+			wantResource: missingId.String(),
+		},
+		{
+			name:         "cycle through nested bundles",
+			componentIds: []uuid.UUID{componentId},
+			repo:         &bundleRepo{err: customErrors.BundleCycle},
+			wantErr:      customErrors.BundleCycle,
+			wantResource: bundleId.String(),
+			wantSets:     1,
+		},
+		{
+			name:         "bundle removed meanwhile",
+			componentIds: []uuid.UUID{componentId},
+			repo:         &bundleRepo{err: customErrors.ServiceNotFound},
+			wantErr:      customErrors.ServiceNotFound,
+			wantResource: bundleId.String(),
+			wantSets:     1,
+		},
+		{
+			name:         "rules broken in abonements holding the bundle",
+			componentIds: []uuid.UUID{componentId},
+			repo: &bundleRepo{
+				violations: []*models.ServiceRuleViolation{{AbonementId: abonementId, Rule: rule}},
+				err:        customErrors.ServiceRulesViolated,
+			},
+			wantErr:   customErrors.ServiceRulesViolated,
+			wantRules: []string{rule.Id.String()},
+			wantSets:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.repo.services = map[uuid.UUID]bool{bundleId: true, componentId: true}
+			u := &ServiceUseCase{serviceRepo: tt.repo}
+
+			_, err := u.SetBundleComponents(context.Background(), &dtos.SetBundleComponentsCommand{
+				BundleId:     bundleId,
+				ComponentIds: tt.componentIds,
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.repo.sets != tt.wantSets {
+				t.Errorf("components set %d times, want %d", tt.repo.sets, tt.wantSets)
+			}
+
+			if tt.wantResource != "" {
+				var resourceErr *customErrors.ResourceError
+				if !errors.As(err, &resourceErr) || resourceErr.ResourceName != tt.wantResource {
+					t.Errorf("err = %v, want it about %s", err, tt.wantResource)
+				}
+			}
+
+			if tt.wantRules != nil {
+				var preconditionErr *customErrors.PreconditionError
+				if !errors.As(err, &preconditionErr) {
+					t.Fatalf("err = %v, want a precondition error", err)
+				}
+				if len(preconditionErr.Violations) != len(tt.wantRules) {
+					t.Fatalf("got %d violations, want %d", len(preconditionErr.Violations), len(tt.wantRules))
+				}
+				for i, violation := range preconditionErr.Violations {
+					if violation.Subject != tt.wantRules[i] || violation.Type != "SERVICE_RULE_EXCLUDES" {
+						t.Errorf("violation %d = %s %s, want SERVICE_RULE_EXCLUDES %s", i, violation.Type, violation.Subject, tt.wantRules[i])
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package service_usecase
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+)
+
+// classify fills the category and the tags of the services.
+func (u *ServiceUseCase) classify(ctx context.Context, services ...*models.Service) error {
+	if len(services) == 0 {
+		return nil
+	}
+
+	servicesIds := make([]uuid.UUID, 0, len(services))
+	for _, service := range services {
+		servicesIds = append(servicesIds, service.Id)
+	}
+
+	classifications, err := u.serviceRepo.GetServicesClassifications(ctx, servicesIds)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		service.CategoryId = nil
+		service.Tags = []string{}
+
+		if classification, ok := classifications[service.Id]; ok {
+			service.CategoryId = classification.CategoryId
+			service.Tags = classification.Tags
+		}
+	}
+
+	return nil
+}
+
+func (u *ServiceUseCase) classifyGrouped(ctx context.Context, grouped map[uuid.UUID][]*models.Service) error {
+	var services []*models.Service
+	for _, group := range grouped {
+		services = append(services, group...)
+	}
+
+	return u.classify(ctx, services...)
+}
+
+// filterServices keeps the services matching the filter, in their order.
+func (u *ServiceUseCase) filterServices(ctx context.Context, services []*models.Service, filter *models.ServiceFilter) ([]*models.Service, error) {
+	if filter.Empty() {
+		return services, nil
+	}
+
+	matched, err := u.matchServices(ctx, services, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]*models.Service, 0, len(matched))
+	for _, service := range services {
+		if matched[service.Id] {
+			filtered = append(filtered, service)
+		}
+	}
+
+	return filtered, nil
+}
+
+// filterGrouped filters every group. Owners keep their entry even when none
+// of their services match.
+func (u *ServiceUseCase) filterGrouped(
+	ctx context.Context,
+	grouped map[uuid.UUID][]*models.Service,
+	filter *models.ServiceFilter,
+) (map[uuid.UUID][]*models.Service, error) {
+
+	if filter.Empty() {
+		return grouped, nil
+	}
+
+	var services []*models.Service
+	for _, group := range grouped {
+		services = append(services, group...)
+	}
+
+	matched, err := u.matchServices(ctx, services, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make(map[uuid.UUID][]*models.Service, len(grouped))
+	for ownerId, group := range grouped {
+		filtered[ownerId] = []*models.Service{}
+
+		for _, service := range group {
+			if matched[service.Id] {
+				filtered[ownerId] = append(filtered[ownerId], service)
+			}
+		}
+	}
+
+	return filtered, nil
+}
+
+func (u *ServiceUseCase) matchServices(ctx context.Context, services []*models.Service, filter *models.ServiceFilter) (map[uuid.UUID]bool, error) {
+	servicesIds := make([]uuid.UUID, 0, len(services))
+	for _, service := range services {
+		servicesIds = append(servicesIds, service.Id)
+	}
+
+	matched, err := u.serviceRepo.FilterServicesIds(ctx, servicesIds, filter)
+	if err != nil {
+		if errors.Is(err, customErrors.CategoryNotFound) {
+			return nil, customErrors.NewResourceError(err, filter.CategoryId.String())
+		}
+		return nil, err
+	}
+
+	return matched, nil
+}
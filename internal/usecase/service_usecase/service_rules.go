@@ -0,0 +1,83 @@
+package service_usecase
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+func (u *ServiceUseCase) CreateServiceRule(ctx context.Context, cmd *dtos.CreateServiceRuleCommand) (*models.ServiceRule, error) {
+	err := u.checkServicesExist(ctx, []uuid.UUID{cmd.ServiceId, cmd.OtherServiceId})
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &models.ServiceRule{
+		Id:             uuid.New(),
+		Kind:           cmd.Kind,
+		ServiceId:      cmd.ServiceId,
+		OtherServiceId: cmd.OtherServiceId,
+		Description:    cmd.Description,
+		CreatedTime:    time.Now(),
+	}
+
+	err = u.serviceRepo.CreateServiceRule(ctx, rule)
+	if err != nil {
+		if errors.Is(err, customErrors.ServiceRuleAlreadyExists) || errors.Is(err, customErrors.ServiceRuleConflict) {
+			return nil, customErrors.NewResourceError(err, cmd.ServiceId.String()+"/"+cmd.OtherServiceId.String())
+		}
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (u *ServiceUseCase) DeleteServiceRule(ctx context.Context, id uuid.UUID) error {
+	err := u.serviceRepo.DeleteServiceRule(ctx, id)
+	if errors.Is(err, customErrors.ServiceRuleNotFound) {
+		return customErrors.NewResourceError(err, id.String())
+	}
+
+	return err
+}
+
+func (u *ServiceUseCase) GetServiceRules(ctx context.Context, serviceId *uuid.UUID) ([]*models.ServiceRule, error) {
+	return u.serviceRepo.GetServiceRules(ctx, serviceId)
+}
+
+func (u *ServiceUseCase) CheckServiceSet(ctx context.Context, servicesIds []uuid.UUID) (*models.ServiceSetCheck, error) {
+	return u.serviceRepo.CheckServiceSet(ctx, servicesIds)
+}
+
+// rulesViolatedError lists the rules broken in the abonements as
+// precondition violations.
+func rulesViolatedError(violations []*models.ServiceRuleViolation) error {
+	preconditionErr := &customErrors.PreconditionError{Err: customErrors.ServiceRulesViolated}
+	for _, violation := range violations {
+		preconditionErr.Violations = append(preconditionErr.Violations, customErrors.PreconditionViolation{
+			Type:        "SERVICE_RULE_" + strings.ToUpper(violation.Rule.Kind),
+			Subject:     violation.Rule.Id.String(),
+			Description: fmt.Sprintf("abonement %s: %s", violation.AbonementId, ruleDescription(violation.Rule)),
+		})
+	}
+
+	return preconditionErr
+}
+
+func ruleDescription(rule *models.ServiceRule) string {
+	if rule.Description != "" {
+		return rule.Description
+	}
+
+	if rule.Kind == models.ServiceRuleRequires {
+		return fmt.Sprintf("service %s requires service %s", rule.ServiceId, rule.OtherServiceId)
+	}
+
+	return fmt.Sprintf("service %s cannot be combined with service %s", rule.ServiceId, rule.OtherServiceId)
+}
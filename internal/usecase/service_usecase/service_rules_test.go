@@ -0,0 +1,48 @@
+package service_usecase
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"errors"
+	"github.com/google/uuid"
+	"strings"
+	"testing"
+)
+
+func TestRulesViolatedError(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	requires := &models.ServiceRule{Id: uuid.New(), Kind: models.ServiceRuleRequires, ServiceId: uuid.New(), OtherServiceId: uuid.New()}
+	excludes := &models.ServiceRule{Id: uuid.New(), Kind: models.ServiceRuleExcludes, Description: "pool and sauna are sold apart"}
+
+	err := rulesViolatedError([]*models.ServiceRuleViolation{
+		{AbonementId: first, Rule: requires},
+		{AbonementId: second, Rule: excludes},
+	})
+
+	var preconditionErr *customErrors.PreconditionError
+	if !errors.As(err, &preconditionErr) || !errors.Is(err, customErrors.ServiceRulesViolated) {
+		t.Fatalf("err = %v, want a precondition error for violated rules", err)
+	}
+
+	want := []struct {
+		kind        string
+		subject     string
+		abonementId uuid.UUID
+		description string
+	}{
+		{kind: "SERVICE_RULE_REQUIRES", subject: requires.Id.String(), abonementId: first, description: "requires service " + requires.OtherServiceId.String()},
+		{kind: "SERVICE_RULE_EXCLUDES", subject: excludes.Id.String(), abonementId: second, description: excludes.Description},
+	}
+
+	if len(preconditionErr.Violations) != len(want) {
+		t.Fatalf("got %d violations, want %d", len(preconditionErr.Violations), len(want))
+	}
+	for i, violation := range preconditionErr.Violations {
+		if violation.Type != want[i].kind || violation.Subject != want[i].subject {
+			t.Errorf("violation %d = %s %s, want %s %s", i, violation.Type, violation.Subject, want[i].kind, want[i].subject)
+		}
+		if !strings.Contains(violation.Description, want[i].abonementId.String()) || !strings.Contains(violation.Description, want[i].description) {
+			t.Errorf("violation %d description = %q, want the abonement and %q", i, violation.Description, want[i].description)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package service_usecase
+
+import (
+	"Service/internal/dtos"
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/locale"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+)
+
+func (u *ServiceUseCase) UpsertServiceTranslation(ctx context.Context, cmd *dtos.UpsertServiceTranslationCommand) (*models.ServiceTranslation, error) {
+	_, err := u.serviceRepo.GetServiceById(ctx, cmd.ServiceId)
+	if err != nil {
+		return nil, withServiceId(err, cmd.ServiceId)
+	}
+
+	err = u.serviceRepo.UpsertServiceTranslation(ctx, cmd)
+	if err != nil {
+		return nil, withServiceId(err, cmd.ServiceId)
+	}
+
+	translations, err := u.serviceRepo.GetServicesTranslations(ctx, []uuid.UUID{cmd.ServiceId}, []string{cmd.Locale})
+	if err != nil {
+		return nil, err
+	}
+
+	return translations[cmd.ServiceId][cmd.Locale], nil
+}
+
+func (u *ServiceUseCase) DeleteServiceTranslation(ctx context.Context, serviceId uuid.UUID, serviceLocale string) error {
+	err := u.serviceRepo.DeleteServiceTranslation(ctx, serviceId, serviceLocale)
+	if errors.Is(err, customErrors.ServiceTranslationNotFound) {
+		return customErrors.NewResourceError(err, serviceId.String()+"/"+serviceLocale)
+	}
+
+	return err
+}
+
+func (u *ServiceUseCase) GetServiceTranslations(ctx context.Context, serviceId uuid.UUID) ([]*models.ServiceTranslation, error) {
+	_, err := u.serviceRepo.GetServiceById(ctx, serviceId)
+	if err != nil {
+		return nil, withServiceId(err, serviceId)
+	}
+
+	return u.serviceRepo.GetServiceTranslations(ctx, serviceId)
+}
+
+// localize replaces titles with the best translation for the locales stored in
+// ctx, falling back to the default locale. Services without a matching
+// translation keep their base title, which is treated as the default locale.
+func (u *ServiceUseCase) localize(ctx context.Context, services ...*models.Service) error {
+	if len(services) == 0 {
+		return nil
+	}
+
+	preferred := locale.FromContext(ctx)
+	candidates := make([]string, 0, len(preferred)+1)
+	candidates = append(candidates, preferred...)
+	candidates = append(candidates, u.defaultLocale)
+
+	servicesIds := make([]uuid.UUID, 0, len(services))
+	for _, service := range services {
+		servicesIds = append(servicesIds, service.Id)
+	}
+
+	translations, err := u.serviceRepo.GetServicesTranslations(ctx, servicesIds, candidates)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		service.Locale = u.defaultLocale
+
+		for _, candidate := range candidates {
+			translation, ok := translations[service.Id][candidate]
+			if !ok {
+				continue
+			}
+
+			service.Title = translation.Title
+			service.Description = translation.Description
+			service.Locale = candidate
+			break
+		}
+	}
+
+	return nil
+}
+
+func (u *ServiceUseCase) localizeGrouped(ctx context.Context, grouped map[uuid.UUID][]*models.Service) error {
+	var services []*models.Service
+	for _, group := range grouped {
+		services = append(services, group...)
+	}
+
+	return u.localize(ctx, services...)
+}
@@ -3,9 +3,16 @@ package service_usecase
 import (
 	"Service/internal/dtos"
 	customErrors "Service/internal/errors"
+	"Service/internal/events"
 	"Service/internal/models"
 	"Service/internal/repository"
+	"Service/internal/usecase"
+	"Service/pkg/logger"
+	"Service/pkg/slug"
+	"Service/pkg/ttlcache"
 	"context"
+	"errors"
+	"fmt"
 	abonementGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.abonement"
 	coachGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
 	"github.com/google/uuid"
@@ -16,34 +23,78 @@ import (
 
 type ServiceUseCase struct {
 	serviceRepo     repository.ServiceRepository
+	cloudUseCase    usecase.CloudUseCase
 	coachClient     *coachGRPC.CoachClient
 	abonementClient *abonementGRPC.AbonementClient
+	defaultLocale   string
+	eventPublisher  events.Publisher
+
+	peerCacheConfig *models.PeerCacheConfig
+	coachCache      *ttlcache.Cache[uuid.UUID, bool]
+	abonementCache  *ttlcache.Cache[uuid.UUID, bool]
 }
 
 func NewServiceUseCase(
 	serviceRepo repository.ServiceRepository,
+	cloudUseCase usecase.CloudUseCase,
 	coachClient *coachGRPC.CoachClient,
 	abonementClient *abonementGRPC.AbonementClient,
+	defaultLocale string,
+	peerCacheConfig *models.PeerCacheConfig,
+	eventPublisher events.Publisher,
 ) *ServiceUseCase {
 	return &ServiceUseCase{
 		serviceRepo:     serviceRepo,
+		cloudUseCase:    cloudUseCase,
 		coachClient:     coachClient,
 		abonementClient: abonementClient,
+		defaultLocale:   defaultLocale,
+		peerCacheConfig: peerCacheConfig,
+		eventPublisher:  eventPublisher,
+		coachCache:      ttlcache.New[uuid.UUID, bool]("coach_exists", peerCacheConfig.Capacity),
+		abonementCache:  ttlcache.New[uuid.UUID, bool]("abonement_exists", peerCacheConfig.Capacity),
 	}
 }
 
 func (u *ServiceUseCase) CreateService(ctx context.Context, cmd *dtos.CreateServiceCommand) (*models.Service, error) {
 
+	// The id chosen by the caller is kept, the photo key is derived from it.
+	serviceId := cmd.Id
+	if serviceId == uuid.Nil {
+		serviceId = uuid.New()
+	}
+
 	service := &models.Service{
-		Id:          uuid.New(),
-		Title:       cmd.Title,
-		Photo:       cmd.Photo,
-		UpdatedTime: time.Now(),
-		CreatedTime: time.Now(),
+		Id:              serviceId,
+		Title:           cmd.Title,
+		NormalizedTitle: slug.NormalizeTitle(cmd.Title),
+		Photo:           cmd.Photo,
+		UpdatedTime:     time.Now(),
+		CreatedTime:     time.Now(),
+	}
+
+	err := u.checkTitleIsFree(ctx, service.NormalizedTitle, service.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	service.Slug, err = u.freeSlug(ctx, cmd.Title, service.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.PhotoContent != nil {
+		service.Photo, err = u.storePhoto(ctx, service.Id, cmd.PhotoContent)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	err := u.serviceRepo.CreateService(ctx, service)
+	err = u.serviceRepo.CreateService(ctx, service)
 	if err != nil {
+		if cmd.PhotoContent != nil {
+			u.discardPhoto(ctx, service.Photo)
+		}
 		return nil, err
 	}
 
@@ -52,6 +103,16 @@ func (u *ServiceUseCase) CreateService(ctx context.Context, cmd *dtos.CreateServ
 
 func (u *ServiceUseCase) GetServiceById(ctx context.Context, id uuid.UUID) (*models.Service, error) {
 	service, err := u.serviceRepo.GetServiceById(ctx, id)
+	if err != nil {
+		return nil, withServiceId(err, id)
+	}
+
+	err = u.localize(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.classify(ctx, service)
 	if err != nil {
 		return nil, err
 	}
@@ -61,65 +122,210 @@ func (u *ServiceUseCase) GetServiceById(ctx context.Context, id uuid.UUID) (*mod
 
 func (u *ServiceUseCase) UpdateService(ctx context.Context, cmd *dtos.UpdateServiceCommand) (*models.Service, error) {
 
-	err := u.serviceRepo.UpdateService(ctx, cmd)
+	if cmd.Title != "" {
+		current, err := u.serviceRepo.GetServiceById(ctx, cmd.Id)
+		if err != nil {
+			return nil, withServiceId(err, cmd.Id)
+		}
+
+		cmd.NormalizedTitle = slug.NormalizeTitle(cmd.Title)
+
+		err = u.checkTitleIsFree(ctx, cmd.NormalizedTitle, cmd.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		newSlug, err := u.freeSlug(ctx, cmd.Title, cmd.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		if newSlug != current.Slug {
+			cmd.Slug = newSlug
+			cmd.PreviousSlug = current.Slug
+		}
+	}
+
+	if cmd.PhotoContent != nil {
+		photo, err := u.storePhoto(ctx, cmd.Id, cmd.PhotoContent)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Photo = photo
+	}
+
+	previousPhoto, err := u.serviceRepo.UpdateService(ctx, cmd)
 	if err != nil {
-		return nil, err
+		if cmd.PhotoContent != nil {
+			u.discardPhoto(ctx, cmd.Photo)
+		}
+		return nil, withServiceId(err, cmd.Id)
+	}
+
+	if cmd.Photo != "" && previousPhoto != cmd.Photo {
+		u.deletePhoto(ctx, previousPhoto)
+	}
+
+	return u.GetServiceById(ctx, cmd.Id)
+}
+
+// DeleteServiceById deletes the service following the command policy and
+// announces the affected links once the deletion is committed. The photo is
+// removed last, the service is gone whether or not that works.
+func (u *ServiceUseCase) DeleteServiceById(ctx context.Context, cmd *dtos.DeleteServiceCommand) (*models.Service, error) {
+
+	if cmd.Policy == models.DeletePolicyReassign && cmd.ReplacementServiceId == cmd.Id {
+		return nil, customErrors.NewResourceError(customErrors.InvalidReplacementService, cmd.ReplacementServiceId.String())
 	}
 
 	service, err := u.serviceRepo.GetServiceById(ctx, cmd.Id)
 	if err != nil {
-		return nil, err
+		return nil, withServiceId(err, cmd.Id)
 	}
 
+	references, err := u.serviceRepo.DeleteService(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, customErrors.ServiceInUse) && references != nil {
+			err = inUseError(cmd.Id, references)
+		}
+		if errors.Is(err, customErrors.ServiceRulesViolated) && references != nil {
+			return nil, rulesViolatedError(references.BrokenRules)
+		}
+		return nil, withServiceId(err, cmd.Id)
+	}
+
+	if !references.Empty() {
+		u.publishDeletion(ctx, cmd, references)
+	}
+
+	u.deletePhoto(ctx, service.Photo)
+
 	return service, nil
 }
 
-func (u *ServiceUseCase) DeleteServiceById(ctx context.Context, id uuid.UUID) (*models.Service, error) {
+func inUseError(serviceId uuid.UUID, references *models.ServiceReferences) error {
+	preconditionErr := &customErrors.PreconditionError{Err: customErrors.ServiceInUse}
 
-	service, err := u.serviceRepo.GetServiceById(ctx, id)
+	for _, coachId := range references.CoachIds {
+		preconditionErr.Violations = append(preconditionErr.Violations, customErrors.PreconditionViolation{
+			Type:        "COACH_SERVICE",
+			Subject:     coachId.String(),
+			Description: "coach still offers the service",
+		})
+	}
+
+	for _, abonementId := range references.AbonementIds {
+		preconditionErr.Violations = append(preconditionErr.Violations, customErrors.PreconditionViolation{
+			Type:        "ABONEMENT_SERVICE",
+			Subject:     abonementId.String(),
+			Description: "abonement still includes the service",
+		})
+	}
+
+	for _, bundleId := range references.BundleIds {
+		preconditionErr.Violations = append(preconditionErr.Violations, customErrors.PreconditionViolation{
+			Type:        "SERVICE_BUNDLE",
+			Subject:     bundleId.String(),
+			Description: "bundle still contains the service",
+		})
+	}
+
+	if references.Visits > 0 {
+		preconditionErr.Violations = append(preconditionErr.Violations, customErrors.PreconditionViolation{
+			Type:        "SERVICE_VISIT",
+			Subject:     serviceId.String(),
+			Description: fmt.Sprintf("%d visits are recorded against the service", references.Visits),
+		})
+	}
+
+	if references.ActiveBookings > 0 {
+		preconditionErr.Violations = append(preconditionErr.Violations, customErrors.PreconditionViolation{
+			Type:        "SERVICE_BOOKING",
+			Subject:     serviceId.String(),
+			Description: fmt.Sprintf("%d bookings of upcoming slots are still active", references.ActiveBookings),
+		})
+	}
+
+	return preconditionErr
+}
+
+// publishDeletion only logs publishing failures: the deletion is already
+// committed and cannot be undone.
+func (u *ServiceUseCase) publishDeletion(ctx context.Context, cmd *dtos.DeleteServiceCommand, references *models.ServiceReferences) {
+	event := &models.ChangeEvent{
+		Type:         models.ServiceDeletedEvent,
+		ServiceId:    cmd.Id,
+		CoachIds:     references.CoachIds,
+		AbonementIds: references.AbonementIds,
+		OccurredTime: time.Now(),
+	}
+
+	if cmd.Policy == models.DeletePolicyReassign {
+		replacementId := cmd.ReplacementServiceId
+		event.Type = models.ServiceReassignedEvent
+		event.ReplacementServiceId = &replacementId
+	}
+
+	if err := u.eventPublisher.Publish(ctx, event); err != nil {
+		logger.ErrorLogger.Printf("Failed to publish %s event for service %s: %v", event.Type, cmd.Id, err)
+	}
+}
+
+func (u *ServiceUseCase) GetServiceBySlug(ctx context.Context, serviceSlug string) (*models.Service, bool, error) {
+	service, redirected, err := u.serviceRepo.GetServiceBySlug(ctx, serviceSlug)
 	if err != nil {
-		return nil, err
+		if errors.Is(err, customErrors.ServiceNotFound) {
+			return nil, false, customErrors.NewResourceError(err, serviceSlug)
+		}
+
+		return nil, false, err
 	}
 
-	err = u.serviceRepo.DeleteService(ctx, id)
+	err = u.localize(ctx, service)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	return service, nil
+	err = u.classify(ctx, service)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return service, redirected, nil
 }
 
-func (u *ServiceUseCase) GetServices(ctx context.Context) ([]*models.Service, error) {
+func (u *ServiceUseCase) GetServices(ctx context.Context, filter *models.ServiceFilter) ([]*models.Service, error) {
 	services, err := u.serviceRepo.GetServices(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return services, nil
-}
-
-func (u *ServiceUseCase) CreateCoachServices(ctx context.Context, cmd *dtos.CreateCoachServicesCommand) ([]*models.Service, error) {
+	services, err = u.filterServices(ctx, services, filter)
+	if err != nil {
+		return nil, err
+	}
 
-	getCoachByIdRequest := &coachGRPC.GetCoachByIdRequest{Id: cmd.CoachId.String()}
+	err = u.localize(ctx, services...)
+	if err != nil {
+		return nil, err
+	}
 
-	_, err := (*u.coachClient).GetCoachById(ctx, getCoachByIdRequest)
+	err = u.classify(ctx, services...)
 	if err != nil {
+		return nil, err
+	}
 
-		st, ok := status.FromError(err)
+	return services, nil
+}
 
-		if !ok {
-			return nil, nil
-		}
+func (u *ServiceUseCase) CreateCoachServices(ctx context.Context, cmd *dtos.CreateCoachServicesCommand) ([]*models.Service, error) {
 
-		switch st.Code() {
-		case codes.NotFound:
-			return nil, customErrors.CoachNotFound
-		default:
-			return nil, customErrors.InternalCoachServerError
-		}
+	err := u.checkCoachExists(ctx, cmd.CoachId)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = u.serviceRepo.GetServicesByIds(ctx, cmd.ServicesIds)
+	err = u.checkServicesExist(ctx, cmd.ServicesIds)
 	if err != nil {
 		return nil, err
 	}
@@ -139,32 +345,21 @@ func (u *ServiceUseCase) CreateCoachServices(ctx context.Context, cmd *dtos.Crea
 
 func (u *ServiceUseCase) CreateAbonemntServices(ctx context.Context, cmd *dtos.CreateAbonementServicesCommand) ([]*models.Service, error) {
 
-	getAbonementByIdRequest := &abonementGRPC.GetAbonementByIdRequest{Id: cmd.AbonementId.String()}
-
-	_, err := (*u.abonementClient).GetAbonementById(ctx, getAbonementByIdRequest)
+	err := u.checkAbonementExists(ctx, cmd.AbonementId)
 	if err != nil {
-
-		st, ok := status.FromError(err)
-
-		if !ok {
-			return nil, nil
-		}
-
-		switch st.Code() {
-		case codes.NotFound:
-			return nil, customErrors.AbonementNotFound
-		default:
-			return nil, customErrors.InternalAbonementServerError
-		}
+		return nil, err
 	}
 
-	_, err = u.serviceRepo.GetServicesByIds(ctx, cmd.ServicesIds)
+	err = u.checkServicesExist(ctx, cmd.ServicesIds)
 	if err != nil {
 		return nil, err
 	}
 
-	err = u.serviceRepo.CreateAbonementServices(ctx, cmd)
+	violations, err := u.serviceRepo.CreateAbonementServices(ctx, cmd)
 	if err != nil {
+		if errors.Is(err, customErrors.ServiceRulesViolated) {
+			return nil, rulesViolatedError(violations)
+		}
 		return nil, err
 	}
 
@@ -176,43 +371,58 @@ func (u *ServiceUseCase) CreateAbonemntServices(ctx context.Context, cmd *dtos.C
 	return services, nil
 }
 
-func (u *ServiceUseCase) GetAbonementsServices(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]*models.Service, error) {
+func (u *ServiceUseCase) GetAbonementsServices(
+	ctx context.Context,
+	ids []uuid.UUID,
+	filter *models.ServiceFilter,
+	expansion models.BundleExpansion,
+) (map[uuid.UUID][]*models.Service, error) {
+
 	services, err := u.serviceRepo.GetAbonementsServices(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
 
-	return services, nil
-}
+	services, err = u.expandBundles(ctx, services, expansion)
+	if err != nil {
+		return nil, err
+	}
 
-func (u *ServiceUseCase) UpdateAbonementServices(ctx context.Context, abonementId uuid.UUID, servicesIds []uuid.UUID) ([]*models.Service, error) {
+	services, err = u.filterGrouped(ctx, services, filter)
+	if err != nil {
+		return nil, err
+	}
 
-	getAbonementByIdRequest := &abonementGRPC.GetAbonementByIdRequest{Id: abonementId.String()}
+	err = u.localizeGrouped(ctx, services)
+	if err != nil {
+		return nil, err
+	}
 
-	_, err := (*u.abonementClient).GetAbonementById(ctx, getAbonementByIdRequest)
+	err = u.classifyGrouped(ctx, services)
 	if err != nil {
+		return nil, err
+	}
 
-		st, ok := status.FromError(err)
+	return services, nil
+}
 
-		if !ok {
-			return nil, nil
-		}
+func (u *ServiceUseCase) UpdateAbonementServices(ctx context.Context, abonementId uuid.UUID, servicesIds []uuid.UUID) ([]*models.Service, error) {
 
-		switch st.Code() {
-		case codes.NotFound:
-			return nil, customErrors.AbonementNotFound
-		default:
-			return nil, customErrors.InternalAbonementServerError
-		}
+	err := u.checkAbonementExists(ctx, abonementId)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = u.serviceRepo.GetServicesByIds(ctx, servicesIds)
+	err = u.checkServicesExist(ctx, servicesIds)
 	if err != nil {
 		return nil, err
 	}
 
-	err = u.serviceRepo.UpdateAbonementServices(ctx, abonementId, servicesIds)
+	violations, err := u.serviceRepo.UpdateAbonementServices(ctx, abonementId, servicesIds)
 	if err != nil {
+		if errors.Is(err, customErrors.ServiceRulesViolated) {
+			return nil, rulesViolatedError(violations)
+		}
 		return nil, err
 	}
 
@@ -225,26 +435,12 @@ func (u *ServiceUseCase) UpdateAbonementServices(ctx context.Context, abonementI
 }
 
 func (u *ServiceUseCase) UpdateCoachServices(ctx context.Context, coachId uuid.UUID, servicesIds []uuid.UUID) ([]*models.Service, error) {
-	getCoachByIdRequest := &coachGRPC.GetCoachByIdRequest{Id: coachId.String()}
-
-	_, err := (*u.coachClient).GetCoachById(ctx, getCoachByIdRequest)
+	err := u.checkCoachExists(ctx, coachId)
 	if err != nil {
-
-		st, ok := status.FromError(err)
-
-		if !ok {
-			return nil, nil
-		}
-
-		switch st.Code() {
-		case codes.NotFound:
-			return nil, customErrors.CoachNotFound
-		default:
-			return nil, customErrors.InternalCoachServerError
-		}
+		return nil, err
 	}
 
-	_, err = u.serviceRepo.GetServicesByIds(ctx, servicesIds)
+	err = u.checkServicesExist(ctx, servicesIds)
 	if err != nil {
 		return nil, err
 	}
@@ -262,11 +458,190 @@ func (u *ServiceUseCase) UpdateCoachServices(ctx context.Context, coachId uuid.U
 	return services, nil
 }
 
-func (u *ServiceUseCase) GetCoachesServices(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]*models.Service, error) {
+func (u *ServiceUseCase) GetCoachesServices(
+	ctx context.Context,
+	ids []uuid.UUID,
+	filter *models.ServiceFilter,
+	expansion models.BundleExpansion,
+) (map[uuid.UUID][]*models.Service, error) {
+
 	services, err := u.serviceRepo.GetCoachesServices(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
 
+	services, err = u.expandBundles(ctx, services, expansion)
+	if err != nil {
+		return nil, err
+	}
+
+	services, err = u.filterGrouped(ctx, services, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.localizeGrouped(ctx, services)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.classifyGrouped(ctx, services)
+	if err != nil {
+		return nil, err
+	}
+
 	return services, nil
 }
+
+func (u *ServiceUseCase) checkCoachExists(ctx context.Context, coachId uuid.UUID) error {
+	exists, cached := u.coachCache.Get(coachId)
+	if !cached {
+		getCoachByIdRequest := &coachGRPC.GetCoachByIdRequest{Id: coachId.String()}
+
+		_, err := (*u.coachClient).GetCoachById(ctx, getCoachByIdRequest)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return fmt.Errorf("%w: %v", customErrors.InternalCoachServerError, err)
+		}
+
+		exists = err == nil
+		u.coachCache.Set(coachId, exists, u.existenceTTL(exists))
+	}
+
+	if !exists {
+		return customErrors.NewResourceError(customErrors.CoachNotFound, coachId.String())
+	}
+
+	return nil
+}
+
+func (u *ServiceUseCase) checkAbonementExists(ctx context.Context, abonementId uuid.UUID) error {
+	exists, cached := u.abonementCache.Get(abonementId)
+	if !cached {
+		getAbonementByIdRequest := &abonementGRPC.GetAbonementByIdRequest{Id: abonementId.String()}
+
+		_, err := (*u.abonementClient).GetAbonementById(ctx, getAbonementByIdRequest)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return fmt.Errorf("%w: %v", customErrors.InternalAbonementServerError, err)
+		}
+
+		exists = err == nil
+		u.abonementCache.Set(abonementId, exists, u.existenceTTL(exists))
+	}
+
+	if !exists {
+		return customErrors.NewResourceError(customErrors.AbonementNotFound, abonementId.String())
+	}
+
+	return nil
+}
+
+// existenceTTL keeps negative answers for a shorter time, so that a coach or
+// abonement created right after a failed assignment becomes usable quickly.
+func (u *ServiceUseCase) existenceTTL(exists bool) time.Duration {
+	if exists {
+		return u.peerCacheConfig.PositiveTTL
+	}
+
+	return u.peerCacheConfig.NegativeTTL
+}
+
+func (u *ServiceUseCase) InvalidatePeerCache(_ context.Context, coachIds []uuid.UUID, abonementIds []uuid.UUID, all bool) {
+	if all {
+		u.coachCache.Purge()
+		u.abonementCache.Purge()
+		return
+	}
+
+	for _, coachId := range coachIds {
+		u.coachCache.Delete(coachId)
+	}
+
+	for _, abonementId := range abonementIds {
+		u.abonementCache.Delete(abonementId)
+	}
+}
+
+// checkServicesExist reports the first requested id that has no service row.
+func (u *ServiceUseCase) checkServicesExist(ctx context.Context, servicesIds []uuid.UUID) error {
+	if len(servicesIds) == 0 {
+		return nil
+	}
+
+	services, err := u.serviceRepo.GetServicesByIds(ctx, servicesIds)
+	if err != nil {
+		return err
+	}
+
+	found := make(map[uuid.UUID]struct{}, len(services))
+	for _, service := range services {
+		found[service.Id] = struct{}{}
+	}
+
+	for _, serviceId := range servicesIds {
+		if _, ok := found[serviceId]; !ok {
+			return customErrors.NewResourceError(customErrors.ServiceNotFound, serviceId.String())
+		}
+	}
+
+	return nil
+}
+
+func withServiceId(err error, id uuid.UUID) error {
+	var resourceErr *customErrors.ResourceError
+	if errors.As(err, &resourceErr) {
+		return err
+	}
+
+	if errors.Is(err, customErrors.ServiceNotFound) ||
+		errors.Is(err, customErrors.ServiceInUse) ||
+		errors.Is(err, customErrors.BundleCycle) {
+		return customErrors.NewResourceError(err, id.String())
+	}
+
+	return err
+}
+
+// checkTitleIsFree fails with the id of the service that already uses the
+// normalized title, unless that service is the one being saved.
+func (u *ServiceUseCase) checkTitleIsFree(ctx context.Context, normalizedTitle string, serviceId uuid.UUID) error {
+	existing, err := u.serviceRepo.GetServiceByNormalizedTitle(ctx, normalizedTitle)
+	if err != nil {
+		if errors.Is(err, customErrors.ServiceNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	if existing.Id == serviceId {
+		return nil
+	}
+
+	return customErrors.NewResourceError(customErrors.ServiceAlreadyExists, existing.Id.String())
+}
+
+// freeSlug derives a slug from the title and appends a numeric suffix until it
+// finds one that is not used by another service, either as its current slug or
+// as a redirect left after a rename.
+func (u *ServiceUseCase) freeSlug(ctx context.Context, title string, serviceId uuid.UUID) (string, error) {
+	base := slug.Make(title)
+	if base == "" {
+		base = "service"
+	}
+
+	candidate := base
+	for i := 2; ; i++ {
+		owner, _, err := u.serviceRepo.GetServiceBySlug(ctx, candidate)
+		if errors.Is(err, customErrors.ServiceNotFound) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if owner.Id == serviceId {
+			return candidate, nil
+		}
+
+		candidate = fmt.Sprintf("%s-%d", base, i)
+	}
+}
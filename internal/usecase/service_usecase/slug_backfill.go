@@ -0,0 +1,41 @@
+package service_usecase
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/pkg/logger"
+	"Service/pkg/slug"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// BackfillSlugs gives services migrated with a placeholder slug the slug and
+// normalized title the application itself would have generated. It fails when
+// two services normalize to the same title, those have to be renamed first.
+func (u *ServiceUseCase) BackfillSlugs(ctx context.Context) error {
+	services, err := u.serviceRepo.GetServicesWithPlaceholderSlug(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		serviceSlug, err := u.freeSlug(ctx, service.Title, service.Id)
+		if err != nil {
+			return err
+		}
+
+		err = u.serviceRepo.SetServiceSlug(ctx, service.Id, serviceSlug, slug.NormalizeTitle(service.Title))
+		if errors.Is(err, customErrors.ServiceAlreadyExists) {
+			return fmt.Errorf("service %s: title %q is taken by another service: %w", service.Id, service.Title, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(services) > 0 {
+		logger.InfoLogger.Printf("Backfilled slugs of %d services", len(services))
+	}
+
+	return nil
+}
@@ -0,0 +1,671 @@
+package validation
+
+import (
+	customErrors "Service/internal/errors"
+	"Service/internal/models"
+	"Service/pkg/locale"
+	"fmt"
+	"github.com/google/uuid"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MinTitleLength    = 2
+	MaxTitleLength    = 64
+	MaxLinkedServices = 50
+	MaxBatchIds       = 100
+	MaxSlugLength     = 128
+	MaxDescription    = 2000
+	MaxPhotoSize      = 10 << 20
+	MaxServiceMedia   = 30
+	MaxAltText        = 256
+	MaxCategoryName   = 64
+	MaxTagLength      = 32
+	MaxServiceTags    = 20
+	MaxDayIntervals   = 6
+	MaxClosureDays    = 366
+	MaxClosureReason  = 256
+	MaxWindowsRange   = 31 * 24 * time.Hour
+	MinSlotMinutes    = 5
+	MaxSlotCapacity   = 1000
+	MaxPriceOverride  = 100_000_000
+	MaxVisitQuota     = 10_000
+	MaxGuestPasses    = 100
+	MaxEventId        = 128
+	MaxVisitsRange    = 366 * 24 * time.Hour
+	MaxReportRange    = 366 * 24 * time.Hour
+	MaxRuleText       = 256
+)
+
+var photoContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// Validator accumulates field violations so that a request is rejected with
+// every problem at once instead of one at a time.
+type Validator struct {
+	err customErrors.ValidationError
+}
+
+func New() *Validator {
+	return &Validator{}
+}
+
+func (v *Validator) Violation(field, description string) {
+	v.err.Add(field, description)
+}
+
+func (v *Validator) Err() error {
+	if len(v.err.Violations) == 0 {
+		return nil
+	}
+
+	return &customErrors.ValidationError{Violations: v.err.Violations}
+}
+
+// UUID parses a required id. uuid.Nil is returned for invalid input.
+func (v *Validator) UUID(field, value string) uuid.UUID {
+	if value == "" {
+		v.Violation(field, "must not be empty")
+		return uuid.Nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil || id == uuid.Nil {
+		v.Violation(field, "must be a valid UUID")
+		return uuid.Nil
+	}
+
+	return id
+}
+
+// UUIDs parses a list of ids, enforcing the maximum size and rejecting duplicates.
+func (v *Validator) UUIDs(field string, values []string, max int, allowDuplicates bool) []uuid.UUID {
+	if len(values) > max {
+		v.Violation(field, fmt.Sprintf("must contain at most %d items", max))
+	}
+
+	ids := make([]uuid.UUID, 0, len(values))
+	seen := make(map[uuid.UUID]int, len(values))
+
+	for i, value := range values {
+		itemField := fmt.Sprintf("%s[%d]", field, i)
+
+		id := v.UUID(itemField, value)
+		if id == uuid.Nil {
+			continue
+		}
+
+		if first, ok := seen[id]; ok {
+			if !allowDuplicates {
+				v.Violation(itemField, fmt.Sprintf("duplicates %s[%d]", field, first))
+			}
+			continue
+		}
+
+		seen[id] = i
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func (v *Validator) NotEmpty(field string, length int) {
+	if length == 0 {
+		v.Violation(field, "must not be empty")
+	}
+}
+
+// Title checks length and charset of a service title. Letters of any script,
+// digits, spaces and a few punctuation marks are allowed.
+func (v *Validator) Title(field, value string, required bool) string {
+	title := strings.TrimSpace(value)
+
+	if title == "" {
+		if required {
+			v.Violation(field, "must not be empty")
+		}
+		return ""
+	}
+
+	length := utf8.RuneCountInString(title)
+	if length < MinTitleLength || length > MaxTitleLength {
+		v.Violation(field, fmt.Sprintf("must be between %d and %d characters", MinTitleLength, MaxTitleLength))
+	}
+
+	for _, r := range title {
+		if !isTitleRune(r) {
+			v.Violation(field, fmt.Sprintf("contains forbidden character %q", r))
+			break
+		}
+	}
+
+	return title
+}
+
+func (v *Validator) Slug(field, value string) string {
+	if value == "" {
+		v.Violation(field, "must not be empty")
+		return ""
+	}
+
+	if len(value) > MaxSlugLength {
+		v.Violation(field, fmt.Sprintf("must be at most %d characters", MaxSlugLength))
+	}
+
+	for _, r := range value {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-') {
+			v.Violation(field, "must contain only lowercase latin letters, digits and hyphens")
+			break
+		}
+	}
+
+	return value
+}
+
+// Locale validates a BCP 47 tag and returns it in canonical form.
+func (v *Validator) Locale(field, value string, required bool) string {
+	if value == "" {
+		if required {
+			v.Violation(field, "must not be empty")
+		}
+		return ""
+	}
+
+	canonical, err := locale.Canonicalize(value)
+	if err != nil {
+		v.Violation(field, "must be a valid BCP 47 language tag")
+		return ""
+	}
+
+	return canonical
+}
+
+func (v *Validator) Description(field, value string) string {
+	description := strings.TrimSpace(value)
+
+	if utf8.RuneCountInString(description) > MaxDescription {
+		v.Violation(field, fmt.Sprintf("must be at most %d characters", MaxDescription))
+	}
+
+	return description
+}
+
+// PhotoContentType accepts the image types services are shown with.
+func (v *Validator) PhotoContentType(field, value string) string {
+	contentType := strings.ToLower(strings.TrimSpace(value))
+	if !photoContentTypes[contentType] {
+		v.Violation(field, "must be one of image/jpeg, image/png, image/webp")
+	}
+
+	return contentType
+}
+
+func (v *Validator) PhotoSize(field string, size int64) {
+	if size <= 0 || size > MaxPhotoSize {
+		v.Violation(field, fmt.Sprintf("must be between 1 and %d bytes", MaxPhotoSize))
+	}
+}
+
+func (v *Validator) MediaType(field, value string) string {
+	switch value {
+	case "":
+		return models.MediaTypeImage
+	case models.MediaTypeImage, models.MediaTypeVideoPoster:
+		return value
+	default:
+		v.Violation(field, "must be one of image, video_poster")
+		return ""
+	}
+}
+
+func (v *Validator) AltText(field, value string) string {
+	value = strings.TrimSpace(value)
+	if utf8.RuneCountInString(value) > MaxAltText {
+		v.Violation(field, fmt.Sprintf("must be at most %d characters", MaxAltText))
+	}
+
+	return value
+}
+
+// DeletePolicy parses how a service in use is deleted. Restrict is the default.
+func (v *Validator) DeletePolicy(field, value string) models.DeletePolicy {
+	switch policy := models.DeletePolicy(strings.ToLower(strings.TrimSpace(value))); policy {
+	case "":
+		return models.DeletePolicyRestrict
+	case models.DeletePolicyRestrict, models.DeletePolicyCascade, models.DeletePolicyReassign:
+		return policy
+	default:
+		v.Violation(field, "must be one of restrict, cascade, reassign")
+		return models.DeletePolicyRestrict
+	}
+}
+
+// OptionalUUID parses an id that may be left empty. nil is returned for empty
+// or invalid input.
+func (v *Validator) OptionalUUID(field, value string) *uuid.UUID {
+	if value == "" {
+		return nil
+	}
+
+	id := v.UUID(field, value)
+	if id == uuid.Nil {
+		return nil
+	}
+
+	return &id
+}
+
+func (v *Validator) CategoryName(field, value string) string {
+	name := strings.TrimSpace(value)
+
+	if name == "" {
+		v.Violation(field, "must not be empty")
+		return ""
+	}
+
+	if utf8.RuneCountInString(name) > MaxCategoryName {
+		v.Violation(field, fmt.Sprintf("must be at most %d characters", MaxCategoryName))
+	}
+
+	for _, r := range name {
+		if !isTitleRune(r) {
+			v.Violation(field, fmt.Sprintf("contains forbidden character %q", r))
+			break
+		}
+	}
+
+	return name
+}
+
+// Tags lowercases and deduplicates free-form tags. Letters of any script,
+// digits, spaces and hyphens are allowed.
+func (v *Validator) Tags(field string, values []string) []string {
+	if len(values) > MaxServiceTags {
+		v.Violation(field, fmt.Sprintf("must contain at most %d items", MaxServiceTags))
+	}
+
+	tags := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+
+	for i, value := range values {
+		itemField := fmt.Sprintf("%s[%d]", field, i)
+		tag := strings.ToLower(strings.Join(strings.Fields(value), " "))
+
+		if tag == "" {
+			v.Violation(itemField, "must not be empty")
+			continue
+		}
+
+		if utf8.RuneCountInString(tag) > MaxTagLength {
+			v.Violation(itemField, fmt.Sprintf("must be at most %d characters", MaxTagLength))
+			continue
+		}
+
+		if strings.IndexFunc(tag, func(r rune) bool { return !isTagRune(r) }) >= 0 {
+			v.Violation(itemField, "must contain only letters, digits, spaces and hyphens")
+			continue
+		}
+
+		if seen[tag] {
+			continue
+		}
+
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// ServiceFilter builds a list filter from an optional category id and tags.
+func (v *Validator) ServiceFilter(categoryField, categoryId string, tagsField string, tags []string) *models.ServiceFilter {
+	return &models.ServiceFilter{
+		CategoryId: v.OptionalUUID(categoryField, categoryId),
+		Tags:       v.Tags(tagsField, tags),
+	}
+}
+
+func (v *Validator) TimeZone(field, value string) string {
+	if value == "" {
+		v.Violation(field, "must not be empty")
+		return ""
+	}
+
+	if _, err := time.LoadLocation(value); err != nil || value == "Local" {
+		v.Violation(field, "must be an IANA time zone name")
+		return ""
+	}
+
+	return value
+}
+
+func (v *Validator) Weekday(field, value string) time.Weekday {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(value, day.String()) {
+			return day
+		}
+	}
+
+	v.Violation(field, "must be an English weekday name")
+	return time.Sunday
+}
+
+// ClockMinute parses HH:MM into minutes since midnight. 24:00 is only
+// accepted as an end of day.
+func (v *Validator) ClockMinute(field, value string, endOfDay bool) int {
+	clock, err := time.Parse("15:04", value)
+	if err == nil {
+		return clock.Hour()*60 + clock.Minute()
+	}
+
+	if endOfDay && value == "24:00" {
+		return models.MinutesPerDay
+	}
+
+	v.Violation(field, "must be a time of day as HH:MM")
+	return 0
+}
+
+func (v *Validator) OpeningInterval(field, weekday, opens, closes string) models.OpeningInterval {
+	return models.OpeningInterval{
+		Weekday:      v.Weekday(field+".weekday", weekday),
+		OpensMinute:  v.ClockMinute(field+".opens", opens, false),
+		ClosesMinute: v.ClockMinute(field+".closes", closes, true),
+	}
+}
+
+// OpeningIntervals checks that intervals are not empty and that intervals of
+// the same weekday do not overlap.
+func (v *Validator) OpeningIntervals(field string, intervals []models.OpeningInterval) {
+	perDay := make(map[time.Weekday][]models.OpeningInterval)
+
+	for i, interval := range intervals {
+		if interval.OpensMinute >= interval.ClosesMinute {
+			v.Violation(fmt.Sprintf("%s[%d]", field, i), "must close after it opens")
+			continue
+		}
+
+		perDay[interval.Weekday] = append(perDay[interval.Weekday], interval)
+	}
+
+	for day, dayIntervals := range perDay {
+		if len(dayIntervals) > MaxDayIntervals {
+			v.Violation(field, fmt.Sprintf("%s must have at most %d intervals", day, MaxDayIntervals))
+		}
+
+		sort.Slice(dayIntervals, func(i, j int) bool { return dayIntervals[i].OpensMinute < dayIntervals[j].OpensMinute })
+
+		for i := 1; i < len(dayIntervals); i++ {
+			if dayIntervals[i].OpensMinute < dayIntervals[i-1].ClosesMinute {
+				v.Violation(field, fmt.Sprintf("intervals of %s overlap", day))
+				break
+			}
+		}
+	}
+}
+
+func (v *Validator) Date(field, value string) time.Time {
+	date, err := time.Parse(models.DateLayout, value)
+	if err != nil {
+		v.Violation(field, "must be a date as YYYY-MM-DD")
+	}
+
+	return date
+}
+
+// DateRange checks that end is not before start and that the range is at most
+// maxDays long, both days included.
+func (v *Validator) DateRange(field string, start, end time.Time, maxDays int) {
+	if start.IsZero() || end.IsZero() {
+		return
+	}
+
+	if end.Before(start) {
+		v.Violation(field, "must not end before it starts")
+		return
+	}
+
+	if int(end.Sub(start).Hours()/24)+1 > maxDays {
+		v.Violation(field, fmt.Sprintf("must span at most %d days", maxDays))
+	}
+}
+
+// ClosureDates parses an optional date range, defaulting to the year ahead.
+func (v *Validator) ClosureDates(fromField, fromValue, toField, toValue string) (time.Time, time.Time) {
+	from := time.Now().UTC().Truncate(24 * time.Hour)
+	if fromValue != "" {
+		from = v.Date(fromField, fromValue)
+	}
+
+	to := from.AddDate(0, 0, MaxClosureDays-1)
+	if toValue != "" {
+		to = v.Date(toField, toValue)
+	}
+
+	v.DateRange(toField, from, to, MaxClosureDays)
+
+	return from, to
+}
+
+func (v *Validator) ClosureKind(field, value string) string {
+	switch value {
+	case "":
+		return models.ClosureKindHoliday
+	case models.ClosureKindHoliday, models.ClosureKindMaintenance:
+		return value
+	default:
+		v.Violation(field, "must be one of holiday, maintenance")
+		return ""
+	}
+}
+
+func (v *Validator) ClosureReason(field, value string) string {
+	value = strings.TrimSpace(value)
+	if utf8.RuneCountInString(value) > MaxClosureReason {
+		v.Violation(field, fmt.Sprintf("must be at most %d characters", MaxClosureReason))
+	}
+
+	return value
+}
+
+// Timestamp parses an RFC 3339 time. An empty optional value yields fallback.
+func (v *Validator) Timestamp(field, value string, fallback time.Time) time.Time {
+	if value == "" {
+		if fallback.IsZero() {
+			v.Violation(field, "must not be empty")
+		}
+		return fallback
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		v.Violation(field, "must be an RFC 3339 timestamp")
+		return fallback
+	}
+
+	return parsed
+}
+
+// TimeRange checks that to is after from and that the range is at most maxSpan
+// long.
+func (v *Validator) TimeRange(field string, from, to time.Time, maxSpan time.Duration) {
+	if from.IsZero() || to.IsZero() {
+		return
+	}
+
+	if !to.After(from) {
+		v.Violation(field, "must end after it starts")
+		return
+	}
+
+	if to.Sub(from) > maxSpan {
+		v.Violation(field, fmt.Sprintf("must span at most %d days", int(maxSpan.Hours()/24)))
+	}
+}
+
+// SlotMinutes accepts slot lengths that divide a day, so that slots line up
+// the same way every day.
+func (v *Validator) SlotMinutes(field string, value int) int {
+	if value < MinSlotMinutes || value > models.MinutesPerDay || models.MinutesPerDay%value != 0 {
+		v.Violation(field, fmt.Sprintf("must be at least %d and divide %d", MinSlotMinutes, models.MinutesPerDay))
+	}
+
+	return value
+}
+
+func (v *Validator) SlotCapacity(field string, value int) int {
+	if value < 1 || value > MaxSlotCapacity {
+		v.Violation(field, fmt.Sprintf("must be between 1 and %d", MaxSlotCapacity))
+	}
+
+	return value
+}
+
+func (v *Validator) QualificationLevel(field, value string) string {
+	switch value {
+	case "":
+		return models.QualificationInstructor
+	case models.QualificationAssistant, models.QualificationInstructor,
+		models.QualificationSeniorInstructor, models.QualificationMaster:
+		return value
+	default:
+		v.Violation(field, "must be one of assistant, instructor, senior_instructor, master")
+		return ""
+	}
+}
+
+// OptionalDate returns nil for an empty value.
+func (v *Validator) OptionalDate(field, value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+
+	date := v.Date(field, value)
+	return &date
+}
+
+// PriceOverride takes a price in minor currency units, nil when the usual
+// price applies.
+func (v *Validator) PriceOverride(field string, value *int64) *int64 {
+	if value != nil && (*value < 0 || *value > MaxPriceOverride) {
+		v.Violation(field, fmt.Sprintf("must be between 0 and %d", MaxPriceOverride))
+	}
+
+	return value
+}
+
+// VisitQuota returns nil for unlimited visits.
+func (v *Validator) VisitQuota(field string, value *int) *int {
+	if value != nil && (*value < 1 || *value > MaxVisitQuota) {
+		v.Violation(field, fmt.Sprintf("must be between 1 and %d", MaxVisitQuota))
+	}
+
+	return value
+}
+
+func (v *Validator) QuotaPeriod(field, value string) string {
+	switch value {
+	case "":
+		return models.QuotaPeriodMonth
+	case models.QuotaPeriodDay, models.QuotaPeriodWeek, models.QuotaPeriodMonth, models.QuotaPeriodTotal:
+		return value
+	default:
+		v.Violation(field, "must be one of day, week, month, total")
+		return ""
+	}
+}
+
+func (v *Validator) GuestPasses(field string, value int) int {
+	if value < 0 || value > MaxGuestPasses {
+		v.Violation(field, fmt.Sprintf("must be between 0 and %d", MaxGuestPasses))
+	}
+
+	return value
+}
+
+// EventId takes the id of a turnstile event as is, it only has to be unique
+// per turnstile system.
+func (v *Validator) EventId(field, value string) string {
+	if strings.TrimSpace(value) == "" {
+		v.Violation(field, "must not be empty")
+		return value
+	}
+
+	if utf8.RuneCountInString(value) > MaxEventId {
+		v.Violation(field, fmt.Sprintf("must be at most %d characters", MaxEventId))
+	}
+
+	return value
+}
+
+func (v *Validator) ReportName(field, value string) string {
+	switch value {
+	case models.ReportServiceUsage, models.ReportCoverageGaps, models.ReportVisitTrend:
+		return value
+	default:
+		v.Violation(field, "must be one of service_usage, coverage_gaps, visit_trend")
+		return ""
+	}
+}
+
+func (v *Validator) TrendGranularity(field, value string) string {
+	switch value {
+	case "":
+		return models.TrendGranularityDay
+	case models.TrendGranularityDay, models.TrendGranularityWeek, models.TrendGranularityMonth:
+		return value
+	default:
+		v.Violation(field, "must be one of day, week, month")
+		return ""
+	}
+}
+
+func (v *Validator) BundleExpansion(field, value string) models.BundleExpansion {
+	switch expansion := models.BundleExpansion(value); expansion {
+	case "":
+		return models.BundleExpansionBundle
+	case models.BundleExpansionBundle, models.BundleExpansionFlatten:
+		return expansion
+	default:
+		v.Violation(field, "must be bundle or flatten")
+		return ""
+	}
+}
+
+func (v *Validator) ServiceRuleKind(field, value string) string {
+	switch value {
+	case models.ServiceRuleRequires, models.ServiceRuleExcludes:
+		return value
+	default:
+		v.Violation(field, "must be requires or excludes")
+		return ""
+	}
+}
+
+func (v *Validator) RuleDescription(field, value string) string {
+	value = strings.TrimSpace(value)
+	if utf8.RuneCountInString(value) > MaxRuleText {
+		v.Violation(field, fmt.Sprintf("must be at most %d characters", MaxRuleText))
+	}
+
+	return value
+}
+
+func isTagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == ' ' || r == '-'
+}
+
+func isTitleRune(r rune) bool {
+	if unicode.IsLetter(r) || unicode.IsDigit(r) {
+		return true
+	}
+
+	return strings.ContainsRune(" -'&.,()", r)
+}
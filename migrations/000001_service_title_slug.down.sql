@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "service_slug_redirect";
+
+DROP INDEX IF EXISTS service_slug_key;
+DROP INDEX IF EXISTS service_normalized_title_key;
+
+ALTER TABLE "service" DROP COLUMN IF EXISTS slug;
+ALTER TABLE "service" DROP COLUMN IF EXISTS normalized_title;
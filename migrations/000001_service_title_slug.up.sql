@@ -0,0 +1,24 @@
+-- Fails when the table already holds titles that differ only in case or
+-- whitespace; rename those services before applying.
+ALTER TABLE "service" ADD COLUMN normalized_title TEXT;
+ALTER TABLE "service" ADD COLUMN slug TEXT;
+
+-- Placeholders only: SQL cannot reproduce the Cyrillic transliteration and
+-- case folding of pkg/slug, so on startup the application gives every service
+-- whose slug equals its id the canonical slug and normalized title.
+UPDATE "service"
+SET normalized_title = lower(regexp_replace(btrim(title), '\s+', ' ', 'g')),
+    slug             = id::text;
+
+ALTER TABLE "service" ALTER COLUMN normalized_title SET NOT NULL;
+ALTER TABLE "service" ALTER COLUMN slug SET NOT NULL;
+
+CREATE UNIQUE INDEX service_normalized_title_key ON "service" (normalized_title);
+CREATE UNIQUE INDEX service_slug_key ON "service" (slug);
+
+CREATE TABLE "service_slug_redirect"
+(
+    slug         TEXT PRIMARY KEY,
+    service_id   UUID      NOT NULL REFERENCES "service" (id) ON DELETE CASCADE,
+    created_time TIMESTAMP NOT NULL DEFAULT now()
+);
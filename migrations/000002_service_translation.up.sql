@@ -0,0 +1,10 @@
+CREATE TABLE "service_translation"
+(
+    service_id   UUID      NOT NULL REFERENCES "service" (id) ON DELETE CASCADE,
+    locale       TEXT      NOT NULL,
+    title        TEXT      NOT NULL,
+    description  TEXT      NOT NULL DEFAULT '',
+    created_time TIMESTAMP NOT NULL,
+    updated_time TIMESTAMP NOT NULL,
+    PRIMARY KEY (service_id, locale)
+);
@@ -0,0 +1,3 @@
+-- The endpoint and bucket the urls were built from are not known to the
+-- database, so keys are left as they are.
+SELECT 1;
@@ -0,0 +1,5 @@
+-- Photos used to be stored as "<endpoint>/<bucket>/<key>" urls. Only the key
+-- is kept, urls are rendered when a service is read.
+UPDATE "service"
+SET photo = regexp_replace(photo, '^[a-z][a-z0-9+.-]*://[^/]+/[^/]+/', '')
+WHERE photo ~ '^[a-z][a-z0-9+.-]*://';
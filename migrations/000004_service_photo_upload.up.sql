@@ -0,0 +1,12 @@
+CREATE TABLE "service_photo_upload"
+(
+    token        UUID PRIMARY KEY,
+    service_id   UUID      NOT NULL REFERENCES "service" (id) ON DELETE CASCADE,
+    object_key   TEXT      NOT NULL,
+    content_type TEXT      NOT NULL,
+    max_size     BIGINT    NOT NULL,
+    expires_time TIMESTAMP NOT NULL,
+    created_time TIMESTAMP NOT NULL
+);
+
+CREATE INDEX service_photo_upload_expires_time_idx ON "service_photo_upload" (expires_time);
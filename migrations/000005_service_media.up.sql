@@ -0,0 +1,15 @@
+CREATE TABLE "service_media"
+(
+    id           UUID PRIMARY KEY,
+    service_id   UUID      NOT NULL REFERENCES "service" (id) ON DELETE CASCADE,
+    object_key   TEXT      NOT NULL,
+    media_type   TEXT      NOT NULL,
+    content_type TEXT      NOT NULL,
+    alt_text     TEXT      NOT NULL DEFAULT '',
+    position     INTEGER   NOT NULL,
+    is_cover     BOOLEAN   NOT NULL DEFAULT FALSE,
+    created_time TIMESTAMP NOT NULL
+);
+
+CREATE INDEX service_media_service_id_idx ON "service_media" (service_id, position);
+CREATE UNIQUE INDEX service_media_cover_key ON "service_media" (service_id) WHERE is_cover;
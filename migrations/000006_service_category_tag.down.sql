@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "service_tag";
+ALTER TABLE "service" DROP COLUMN IF EXISTS category_id;
+DROP TABLE IF EXISTS "service_category";
@@ -0,0 +1,27 @@
+CREATE TABLE "service_category"
+(
+    id           UUID PRIMARY KEY,
+    parent_id    UUID REFERENCES "service_category" (id) ON DELETE RESTRICT,
+    name         TEXT      NOT NULL,
+    created_time TIMESTAMP NOT NULL,
+    updated_time TIMESTAMP NOT NULL
+);
+
+CREATE INDEX service_category_parent_id_idx ON "service_category" (parent_id);
+-- Sibling names are unique, root categories are siblings of each other.
+CREATE UNIQUE INDEX service_category_sibling_name_key ON "service_category"
+    (COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'::UUID), lower(name));
+
+ALTER TABLE "service"
+    ADD COLUMN category_id UUID REFERENCES "service_category" (id) ON DELETE RESTRICT;
+
+CREATE INDEX service_category_id_idx ON "service" (category_id);
+
+CREATE TABLE "service_tag"
+(
+    service_id UUID NOT NULL REFERENCES "service" (id) ON DELETE CASCADE,
+    tag        TEXT NOT NULL,
+    PRIMARY KEY (service_id, tag)
+);
+
+CREATE INDEX service_tag_tag_idx ON "service_tag" (tag);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "service_closure";
+DROP TABLE IF EXISTS "service_opening_interval";
+DROP TABLE IF EXISTS "service_schedule";
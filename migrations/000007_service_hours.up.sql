@@ -0,0 +1,32 @@
+CREATE TABLE "service_schedule"
+(
+    service_id   UUID PRIMARY KEY REFERENCES "service" (id) ON DELETE CASCADE,
+    time_zone    TEXT      NOT NULL,
+    updated_time TIMESTAMP NOT NULL
+);
+
+-- Minutes are counted from local midnight of the weekday, 0 is Sunday.
+CREATE TABLE "service_opening_interval"
+(
+    service_id    UUID     NOT NULL REFERENCES "service_schedule" (service_id) ON DELETE CASCADE,
+    weekday       SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    opens_minute  SMALLINT NOT NULL CHECK (opens_minute BETWEEN 0 AND 1439),
+    closes_minute SMALLINT NOT NULL CHECK (closes_minute BETWEEN 1 AND 1440),
+    CHECK (opens_minute < closes_minute),
+    PRIMARY KEY (service_id, weekday, opens_minute)
+);
+
+-- Closures cover whole local days, end_date included.
+CREATE TABLE "service_closure"
+(
+    id           UUID PRIMARY KEY,
+    service_id   UUID      NOT NULL REFERENCES "service" (id) ON DELETE CASCADE,
+    start_date   DATE      NOT NULL,
+    end_date     DATE      NOT NULL,
+    kind         TEXT      NOT NULL,
+    reason       TEXT      NOT NULL DEFAULT '',
+    created_time TIMESTAMP NOT NULL,
+    CHECK (start_date <= end_date)
+);
+
+CREATE INDEX service_closure_service_id_idx ON "service_closure" (service_id, end_date);
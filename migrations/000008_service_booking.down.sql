@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "service_booking";
+DROP TABLE IF EXISTS "service_slot";
+DROP TABLE IF EXISTS "service_booking_settings";
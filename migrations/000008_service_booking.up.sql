@@ -0,0 +1,36 @@
+CREATE TABLE "service_booking_settings"
+(
+    service_id   UUID PRIMARY KEY REFERENCES "service" (id) ON DELETE CASCADE,
+    slot_minutes INTEGER   NOT NULL CHECK (slot_minutes > 0),
+    capacity     INTEGER   NOT NULL CHECK (capacity > 0),
+    updated_time TIMESTAMP NOT NULL
+);
+
+-- One row per slot that was ever booked. booked is changed with the bookings
+-- in the same transaction, the row lock taken by the conditional update keeps
+-- concurrent bookings from overbooking.
+CREATE TABLE "service_slot"
+(
+    service_id UUID        NOT NULL REFERENCES "service" (id) ON DELETE CASCADE,
+    slot_start TIMESTAMPTZ NOT NULL,
+    slot_end   TIMESTAMPTZ NOT NULL,
+    capacity   INTEGER     NOT NULL,
+    booked     INTEGER     NOT NULL DEFAULT 0 CHECK (booked >= 0),
+    PRIMARY KEY (service_id, slot_start)
+);
+
+CREATE TABLE "service_booking"
+(
+    id             UUID PRIMARY KEY,
+    service_id     UUID        NOT NULL REFERENCES "service" (id) ON DELETE CASCADE,
+    member_id      UUID        NOT NULL,
+    slot_start     TIMESTAMPTZ NOT NULL,
+    slot_end       TIMESTAMPTZ NOT NULL,
+    status         TEXT        NOT NULL,
+    created_time   TIMESTAMP   NOT NULL,
+    cancelled_time TIMESTAMP
+);
+
+CREATE UNIQUE INDEX service_booking_member_slot_key ON "service_booking" (service_id, slot_start, member_id)
+    WHERE status = 'active';
+CREATE INDEX service_booking_member_id_idx ON "service_booking" (member_id, slot_start);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS coach_service_certification_expiry_idx;
+
+ALTER TABLE "coach_service"
+    DROP COLUMN IF EXISTS updated_time,
+    DROP COLUMN IF EXISTS certification_expired,
+    DROP COLUMN IF EXISTS price_override,
+    DROP COLUMN IF EXISTS certification_expiry,
+    DROP COLUMN IF EXISTS qualification_level;
@@ -0,0 +1,10 @@
+ALTER TABLE "coach_service"
+    ADD COLUMN qualification_level   TEXT      NOT NULL DEFAULT 'instructor'
+        CHECK (qualification_level IN ('assistant', 'instructor', 'senior_instructor', 'master')),
+    ADD COLUMN certification_expiry  DATE,
+    ADD COLUMN price_override        BIGINT CHECK (price_override >= 0),
+    ADD COLUMN certification_expired BOOLEAN   NOT NULL DEFAULT FALSE,
+    ADD COLUMN updated_time          TIMESTAMP NOT NULL DEFAULT now();
+
+CREATE INDEX coach_service_certification_expiry_idx ON "coach_service" (certification_expiry)
+    WHERE NOT certification_expired;
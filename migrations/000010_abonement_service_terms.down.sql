@@ -0,0 +1,6 @@
+ALTER TABLE "abonement_service"
+    DROP COLUMN IF EXISTS updated_time,
+    DROP COLUMN IF EXISTS access_windows,
+    DROP COLUMN IF EXISTS guest_passes,
+    DROP COLUMN IF EXISTS quota_period,
+    DROP COLUMN IF EXISTS visit_quota;
@@ -0,0 +1,9 @@
+ALTER TABLE "abonement_service"
+    ADD COLUMN visit_quota    INTEGER CHECK (visit_quota > 0),
+    ADD COLUMN quota_period   TEXT      NOT NULL DEFAULT 'month'
+        CHECK (quota_period IN ('day', 'week', 'month', 'total')),
+    ADD COLUMN guest_passes   INTEGER   NOT NULL DEFAULT 0 CHECK (guest_passes >= 0),
+    -- [{"weekday": 1, "opens": 360, "closes": 1020}, ...] in the local time of
+    -- the service, empty when the service can be used at any time.
+    ADD COLUMN access_windows JSONB     NOT NULL DEFAULT '[]',
+    ADD COLUMN updated_time   TIMESTAMP NOT NULL DEFAULT now();
@@ -0,0 +1,16 @@
+-- Visits are recorded while the abonement_service row is locked, which
+-- serializes the quota checks of one link. They are history, so deleting a
+-- service has to move or remove them explicitly.
+CREATE TABLE "service_visit"
+(
+    id            UUID PRIMARY KEY,
+    event_id      TEXT        NOT NULL UNIQUE,
+    abonement_id  UUID        NOT NULL,
+    service_id    UUID        NOT NULL REFERENCES "service" (id) ON DELETE RESTRICT,
+    member_id     UUID        NOT NULL,
+    guest         BOOLEAN     NOT NULL DEFAULT FALSE,
+    visited_time  TIMESTAMPTZ NOT NULL,
+    recorded_time TIMESTAMP   NOT NULL
+);
+
+CREATE INDEX service_visit_abonement_idx ON "service_visit" (abonement_id, service_id, visited_time);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS service_visit_visited_time_idx;
@@ -0,0 +1,2 @@
+-- Reports count visits of every service within a time range.
+CREATE INDEX service_visit_visited_time_idx ON "service_visit" (visited_time);
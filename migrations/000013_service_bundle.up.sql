@@ -0,0 +1,11 @@
+-- A service with components is a bundle. Bundles may contain bundles, but
+-- never themselves, directly or through other bundles.
+CREATE TABLE "service_bundle_component"
+(
+    bundle_id    UUID NOT NULL REFERENCES "service" (id) ON DELETE CASCADE,
+    component_id UUID NOT NULL REFERENCES "service" (id),
+    PRIMARY KEY (bundle_id, component_id),
+    CHECK (bundle_id <> component_id)
+);
+
+CREATE INDEX service_bundle_component_component_idx ON "service_bundle_component" (component_id);
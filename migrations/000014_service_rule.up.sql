@@ -0,0 +1,19 @@
+-- A requires rule asks abonements with service_id to include other_service_id
+-- as well; an excludes rule forbids both on one abonement and holds in either
+-- direction, so each pair is stored once.
+CREATE TABLE "service_rule"
+(
+    id               UUID PRIMARY KEY,
+    kind             TEXT      NOT NULL CHECK (kind IN ('requires', 'excludes')),
+    service_id       UUID      NOT NULL REFERENCES "service" (id) ON DELETE CASCADE,
+    other_service_id UUID      NOT NULL REFERENCES "service" (id) ON DELETE CASCADE,
+    description      TEXT      NOT NULL DEFAULT '',
+    created_time     TIMESTAMP NOT NULL,
+    CHECK (service_id <> other_service_id)
+);
+
+CREATE UNIQUE INDEX service_rule_requires_key ON "service_rule" (service_id, other_service_id)
+    WHERE kind = 'requires';
+CREATE UNIQUE INDEX service_rule_excludes_key ON "service_rule" (LEAST(service_id, other_service_id), GREATEST(service_id, other_service_id))
+    WHERE kind = 'excludes';
+CREATE INDEX service_rule_other_service_idx ON "service_rule" (other_service_id);
@@ -0,0 +1,69 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+)
+
+// ServerConfig serves the current certificate and, when the reloader has a CA
+// bundle, requires and verifies client certificates against it (mTLS). The
+// same config backs the gRPC server and the HTTP gateway, so both h2 and
+// http/1.1 are offered.
+func ServerConfig(r *Reloader) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.Certificate()},
+				NextProtos:   []string{"h2", "http/1.1"},
+			}
+
+			if pool := r.CAPool(); pool != nil {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.ClientCAs = pool
+			}
+
+			return cfg, nil
+		},
+	}
+}
+
+// ClientConfig presents the current certificate to the peer, if the reloader
+// has one, and verifies the peer against the reloader's CA bundle, falling
+// back to the system roots.
+// Verification is done by hand so that a rotated CA bundle applies to new
+// connections without rebuilding the dial options.
+func ClientConfig(r *Reloader) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if cert := r.Certificate(); cert != nil {
+				return cert, nil
+			}
+
+			// An empty certificate tells the server none is available.
+			return &tls.Certificate{}, nil
+		},
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("peer presented no certificates")
+			}
+
+			intermediates := x509.NewCertPool()
+			for _, cert := range cs.PeerCertificates[1:] {
+				intermediates.AddCert(cert)
+			}
+
+			_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+				DNSName:       cs.ServerName,
+				Roots:         r.CAPool(),
+				Intermediates: intermediates,
+			})
+
+			return err
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package locale
+
+import (
+	"context"
+	"golang.org/x/text/language"
+)
+
+type contextKey struct{}
+
+// NewContext stores the caller's preferred locales, most preferred first.
+func NewContext(ctx context.Context, preferred []string) context.Context {
+	return context.WithValue(ctx, contextKey{}, preferred)
+}
+
+func FromContext(ctx context.Context) []string {
+	preferred, _ := ctx.Value(contextKey{}).([]string)
+	return preferred
+}
+
+// Canonicalize validates a BCP 47 tag and returns its canonical form, e.g.
+// "EN-us" becomes "en-US".
+func Canonicalize(tag string) (string, error) {
+	parsed, err := language.Parse(tag)
+	if err != nil {
+		return "", err
+	}
+
+	return parsed.String(), nil
+}
+
+// ParseAcceptLanguage turns an Accept-Language value into canonical tags
+// ordered by preference. Every regional tag is followed by its base language
+// so that "ru-RU" still matches a "ru" translation. Malformed input yields nil.
+func ParseAcceptLanguage(header string) []string {
+	if header == "" {
+		return nil
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return nil
+	}
+
+	var preferred []string
+	seen := map[string]bool{}
+	add := func(tag string) {
+		if tag == "" || tag == "und" || seen[tag] {
+			return
+		}
+		seen[tag] = true
+		preferred = append(preferred, tag)
+	}
+
+	for _, tag := range tags {
+		add(tag.String())
+
+		base, confidence := tag.Base()
+		if confidence != language.No {
+			add(base.String())
+		}
+	}
+
+	return preferred
+}
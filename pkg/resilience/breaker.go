@@ -0,0 +1,155 @@
+package resilience
+
+import (
+	"sync"
+	"time"
+)
+
+type State int
+
+const (
+	StateClosed State = iota
+	StateOpen
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// Breaker opens after a number of consecutive failures and rejects calls
+// until the cooldown passes. Then a single probe call is let through: its
+// success closes the breaker, its failure opens it again.
+type Breaker struct {
+	name          string
+	failureLimit  int
+	cooldown      time.Duration
+	onStateChange func(name string, state State)
+
+	// notifyMu is taken before mu is released, so state change callbacks run
+	// in the order of the transitions.
+	notifyMu sync.Mutex
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func NewBreaker(name string, failureLimit int, cooldown time.Duration, onStateChange func(name string, state State)) *Breaker {
+	return &Breaker{
+		name:          name,
+		failureLimit:  failureLimit,
+		cooldown:      cooldown,
+		onStateChange: onStateChange,
+	}
+}
+
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
+
+// Allow reports whether a call may go to the peer right now.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+
+	allowed := true
+	changed := false
+
+	switch b.state {
+	case StateOpen:
+		if time.Since(b.openedAt) < b.cooldown {
+			allowed = false
+			break
+		}
+		changed = b.setState(StateHalfOpen)
+		b.probing = true
+	case StateHalfOpen:
+		if b.probing {
+			allowed = false
+			break
+		}
+		b.probing = true
+	}
+
+	b.unlock(changed)
+
+	return allowed
+}
+
+func (b *Breaker) Success() {
+	b.mu.Lock()
+
+	b.failures = 0
+	b.probing = false
+	changed := b.setState(StateClosed)
+
+	b.unlock(changed)
+}
+
+func (b *Breaker) Failure() {
+	b.mu.Lock()
+
+	b.failures++
+	b.probing = false
+
+	changed := false
+	if b.state == StateHalfOpen || b.failures >= b.failureLimit {
+		b.openedAt = time.Now()
+		changed = b.setState(StateOpen)
+	}
+
+	b.unlock(changed)
+}
+
+// Release gives back a call that told nothing about the peer, so the probe
+// of a half-open breaker can be sent again.
+func (b *Breaker) Release() {
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
+
+func (b *Breaker) setState(state State) bool {
+	if b.state == state {
+		return false
+	}
+
+	b.state = state
+
+	return true
+}
+
+// unlock releases mu and, after a transition, reports the new state outside
+// of it.
+func (b *Breaker) unlock(changed bool) {
+	if !changed || b.onStateChange == nil {
+		b.mu.Unlock()
+		return
+	}
+
+	state := b.state
+
+	b.notifyMu.Lock()
+	defer b.notifyMu.Unlock()
+
+	b.mu.Unlock()
+	b.onStateChange(b.name, state)
+}
@@ -0,0 +1,130 @@
+package resilience
+
+import (
+	"Service/pkg/logger"
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/rand/v2"
+	"time"
+)
+
+type Policy struct {
+	Timeout     time.Duration
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// UnaryClientInterceptor gives every attempt its own timeout, retries
+// Unavailable and DeadlineExceeded with full-jitter exponential backoff and
+// fails fast while the breaker is open. At least one attempt is always made.
+func UnaryClientInterceptor(policy Policy, breaker *Breaker) grpc.UnaryClientInterceptor {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+
+		var err error
+		for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+			if !breaker.Allow() {
+				recordRejected(breaker.Name())
+				// A retry refused after the failure that opened the breaker
+				// reports that failure, not the breaker.
+				if err != nil {
+					return err
+				}
+				return status.Errorf(codes.Unavailable, "circuit breaker for %s is open", breaker.Name())
+			}
+
+			err = invokeWithTimeout(ctx, policy.Timeout, method, req, reply, cc, invoker, opts...)
+			recordCall(breaker.Name(), status.Code(err))
+
+			// The caller's own deadline or cancellation says nothing about
+			// the peer.
+			if err != nil && ctx.Err() != nil {
+				breaker.Release()
+				return err
+			}
+
+			if !isPeerFailure(err) {
+				breaker.Success()
+				return err
+			}
+
+			breaker.Failure()
+
+			if !isRetryable(err) || attempt == policy.MaxAttempts {
+				break
+			}
+
+			recordRetry(breaker.Name())
+			logger.InfoLogger.Printf("Retrying %s after attempt %d: %v", method, attempt, err)
+
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(backoff(policy, attempt)):
+			}
+		}
+
+		return err
+	}
+}
+
+func invokeWithTimeout(
+	ctx context.Context,
+	timeout time.Duration,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func backoff(policy Policy, attempt int) time.Duration {
+	ceiling := policy.BaseBackoff << (attempt - 1)
+	if ceiling <= 0 || ceiling > policy.MaxBackoff {
+		ceiling = policy.MaxBackoff
+	}
+
+	return time.Duration(rand.Int64N(int64(ceiling) + 1))
+}
+
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// isPeerFailure tells errors that say something about the peer's health from
+// regular answers such as NotFound.
+func isPeerFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,32 @@
+package resilience
+
+import (
+	"expvar"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	breakerStates = expvar.NewMap("peer_breaker_state")
+	peerCalls     = expvar.NewMap("peer_calls")
+	peerRetries   = expvar.NewMap("peer_retries")
+	peerRejected  = expvar.NewMap("peer_rejected")
+)
+
+// PublishState exposes the breaker state of a peer under /debug/vars.
+func PublishState(name string, state State) {
+	value := new(expvar.String)
+	value.Set(state.String())
+	breakerStates.Set(name, value)
+}
+
+func recordCall(name string, code codes.Code) {
+	peerCalls.Add(name+"."+code.String(), 1)
+}
+
+func recordRetry(name string) {
+	peerRetries.Add(name, 1)
+}
+
+func recordRejected(name string) {
+	peerRejected.Add(name, 1)
+}
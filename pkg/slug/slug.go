@@ -0,0 +1,54 @@
+package slug
+
+import (
+	"strings"
+	"unicode"
+)
+
+var cyrillicToLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya",
+}
+
+// Make builds a lowercase URL-safe slug from a title. Cyrillic letters are
+// transliterated, any other run of non-alphanumeric characters becomes a
+// single hyphen.
+func Make(title string) string {
+	var b strings.Builder
+	pendingHyphen := false
+
+	write := func(s string) {
+		if s == "" {
+			return
+		}
+		if pendingHyphen && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		pendingHyphen = false
+		b.WriteString(s)
+	}
+
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			write(string(r))
+		case cyrillicToLatin[r] != "":
+			write(cyrillicToLatin[r])
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			// letters outside of the supported alphabets are dropped
+		default:
+			pendingHyphen = true
+		}
+	}
+
+	return b.String()
+}
+
+// NormalizeTitle folds case and whitespace so that "Gym", " gym " and
+// "GYM" are treated as the same title.
+func NormalizeTitle(title string) string {
+	return strings.ToLower(strings.Join(strings.Fields(title), " "))
+}
@@ -0,0 +1,110 @@
+package ttlcache
+
+import (
+	"container/list"
+	"expvar"
+	"sync"
+	"time"
+)
+
+var stats = expvar.NewMap("ttl_cache")
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// Cache is a size-bounded LRU whose entries also expire after the TTL given
+// on Set. Hits, misses and evictions are published under /debug/vars,
+// prefixed with the cache name.
+type Cache[K comparable, V any] struct {
+	name     string
+	capacity int
+
+	mu      sync.Mutex
+	items   map[K]*list.Element
+	recency *list.List
+}
+
+func New[K comparable, V any](name string, capacity int) *Cache[K, V] {
+	return &Cache[K, V]{
+		name:     name,
+		capacity: capacity,
+		items:    make(map[K]*list.Element, capacity),
+		recency:  list.New(),
+	}
+}
+
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+
+	element, ok := c.items[key]
+	if !ok {
+		stats.Add(c.name+".miss", 1)
+		return zero, false
+	}
+
+	cached := element.Value.(*entry[K, V])
+	if time.Now().After(cached.expiresAt) {
+		c.remove(element)
+		stats.Add(c.name+".expired", 1)
+		return zero, false
+	}
+
+	c.recency.MoveToFront(element)
+	stats.Add(c.name+".hit", 1)
+
+	return cached.value, true
+}
+
+func (c *Cache[K, V]) Set(key K, value V, ttl time.Duration) {
+	if ttl <= 0 || c.capacity <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.items[key]; ok {
+		cached := element.Value.(*entry[K, V])
+		cached.value = value
+		cached.expiresAt = time.Now().Add(ttl)
+		c.recency.MoveToFront(element)
+		return
+	}
+
+	c.items[key] = c.recency.PushFront(&entry[K, V]{key: key, value: value, expiresAt: time.Now().Add(ttl)})
+
+	for c.recency.Len() > c.capacity {
+		c.remove(c.recency.Back())
+		stats.Add(c.name+".eviction", 1)
+	}
+}
+
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.items[key]; ok {
+		c.remove(element)
+		stats.Add(c.name+".invalidation", 1)
+	}
+}
+
+func (c *Cache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(map[K]*list.Element, c.capacity)
+	c.recency.Init()
+	stats.Add(c.name+".purge", 1)
+}
+
+func (c *Cache[K, V]) remove(element *list.Element) {
+	c.recency.Remove(element)
+	delete(c.items, element.Value.(*entry[K, V]).key)
+}